@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// restoreTablesInsertSize is about the size of the INSERT statements
+// copying the restored rows into the target shard.
+const restoreTablesInsertSize = 1024 * 1024
+
+// restoreTablesFromBackup restores tables of a backup into a fresh mysqld,
+// and copies them into the master of the target shard, under new names.
+func restoreTablesFromBackup(ctx context.Context, topoServer *topo.Server, list string) error {
+	restores, err := mysqlctl.ParseTableRestores(list)
+	if err != nil {
+		return err
+	}
+	keyspace, shard := *restoreTablesKeyspace, *restoreTablesShard
+	if keyspace == "" {
+		keyspace = *initKeyspace
+	}
+	if shard == "" {
+		shard = *initShard
+	}
+
+	// Find the target before restoring anything.
+	si, err := topoServer.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return fmt.Errorf("can't read shard %v/%v: %v", keyspace, shard, err)
+	}
+	if topoproto.TabletAliasIsZero(si.MasterAlias) {
+		return fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	ti, err := topoServer.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return fmt.Errorf("can't get master tablet record %v: %v", topoproto.TabletAliasString(si.MasterAlias), err)
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+
+	tabletAlias, mysqld, mycnf, cleanup, err := initMysqld(ctx)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	params := scratchRestoreParams(tabletAlias, mysqld, mycnf, *restoreTablesBackup)
+	for _, tr := range restores {
+		params.Tables = append(params.Tables, tr.Name)
+	}
+	log.Infof("Restoring tables %v from directory %v", strings.Join(params.Tables, ", "), mysqlctl.GetBackupDir(*initKeyspace, *initShard))
+	backupManifest, err := mysqlctl.Restore(ctx, params)
+	switch {
+	case err == mysqlctl.ErrNoBackup || err == mysqlctl.ErrNoCompleteBackup:
+		return fmt.Errorf("no complete backup to restore the tables from: %v", err)
+	case err != nil:
+		return fmt.Errorf("can't restore from backup: %v", err)
+	}
+	log.Infof("Restored the tables as of replication position %v", backupManifest.Position)
+
+	sd, err := mysqld.GetSchema(params.DbName, params.Tables, nil, false)
+	if err != nil {
+		return fmt.Errorf("can't get the schema of the restored tables: %v", err)
+	}
+	for _, tr := range restores {
+		var table *tabletmanagerdatapb.TableDefinition
+		for _, td := range sd.TableDefinitions {
+			if td.Name == tr.Name {
+				table = td
+				break
+			}
+		}
+		if table == nil {
+			return fmt.Errorf("table %v is not in the backup", tr.Name)
+		}
+		if err := copyRestoredTable(ctx, tmc, ti.Tablet, mysqld, params.DbName, table, tr.NewName); err != nil {
+			return fmt.Errorf("can't copy table %v to %v in %v: %v", tr.Name, tr.NewName, topoproto.TabletAliasString(ti.Alias), err)
+		}
+	}
+	log.Infof("Table restore successful.")
+	return nil
+}
+
+// copyRestoredTable creates a restored table in the target database
+// under its new name, and inserts its rows. The inserts are replicated
+// from the target tablet like any other write.
+func copyRestoredTable(ctx context.Context, tmc tmclient.TabletManagerClient, target *topodatapb.Tablet, mysqld *mysqlctl.Mysqld, dbName string, table *tabletmanagerdatapb.TableDefinition, newName string) error {
+	createSQL, err := mysqlctl.RenameTableSchema(table.Schema, table.Name, newName)
+	if err != nil {
+		return err
+	}
+	log.Infof("Creating table %v", newName)
+	if _, err := tmc.ExecuteFetchAsDba(ctx, target, false, []byte(createSQL), 0, false, true /* reloadSchema */); err != nil {
+		return err
+	}
+
+	conn, err := mysqld.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Each batch of streamed rows is copied with one INSERT statement.
+	var prefix string
+	rowCount := 0
+	err = conn.ExecuteStreamFetch(fmt.Sprintf("SELECT * FROM %s.%s", sqlescape.EscapeID(dbName), sqlescape.EscapeID(table.Name)), func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			columns := make([]string, len(qr.Fields))
+			for i, field := range qr.Fields {
+				columns[i] = sqlescape.EscapeID(field.Name)
+			}
+			prefix = fmt.Sprintf("INSERT INTO %s (%s) VALUES ", sqlescape.EscapeID(newName), strings.Join(columns, ", "))
+		}
+		if len(qr.Rows) == 0 {
+			return nil
+		}
+		var buf bytes.Buffer
+		buf.WriteString(prefix)
+		for i, row := range qr.Rows {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteByte('(')
+			for j, value := range row {
+				if j > 0 {
+					buf.WriteString(", ")
+				}
+				value.EncodeSQL(&buf)
+			}
+			buf.WriteByte(')')
+		}
+		if _, err := tmc.ExecuteFetchAsDba(ctx, target, false, buf.Bytes(), 0, false, false); err != nil {
+			return err
+		}
+		rowCount += len(qr.Rows)
+		return nil
+	}, restoreTablesInsertSize)
+	if err != nil {
+		return err
+	}
+	log.Infof("Copied %v rows into table %v", rowCount, newName)
+	return nil
+}
@@ -54,6 +54,17 @@ The command-line parameters to vtbackup specify a policy for when a new backup
 is needed, and when old backups should be removed. If the existing backups
 already satisfy the policy, then vtbackup will do nothing and return success
 immediately.
+
+With -verify_backup, vtbackup instead restores the named backup into its
+mysqld, checks the tables and compares their row counts with the ones recorded
+in the MANIFEST, if any. The result is stored next to the backups of the shard,
+where ListBackups -verification displays it.
+
+With -restore_tables, vtbackup restores the given tables of a backup into its
+mysqld, and copies them into the master of a target shard under new names.
+This recovers dropped tables, or rows of the past, without restoring the whole
+shard. The logical engine only restores the requested tables, the other engines
+restore the whole backup first.
 */
 package main
 
@@ -79,6 +90,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/replicatedbackupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
@@ -113,6 +125,12 @@ var (
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
+	verifyBackupName = flag.String("verify_backup", "", "Instead of taking a backup, restore the backup with this name into a scratch mysqld, check its tables and compare their row counts with the MANIFEST, and record the result next to the backup. Use ListBackups -verification to display the results.")
+
+	restoreTables         = flag.String("restore_tables", "", "Instead of taking a backup, restore these tables of a backup into a scratch mysqld, and copy them into the master of the target shard. This is a comma-separated list of name or name:new_name, the default new name is name_restored. The tables must not exist in the target shard.")
+	restoreTablesBackup   = flag.String("restore_tables_backup", "", "With -restore_tables, the name of the backup to restore the tables from. Defaults to the most recent backup.")
+	restoreTablesKeyspace = flag.String("restore_tables_keyspace", "", "With -restore_tables, the keyspace to copy the tables into. Defaults to -init_keyspace.")
+	restoreTablesShard    = flag.String("restore_tables_shard", "", "With -restore_tables, the shard to copy the tables into. Defaults to -init_shard.")
 
 	// vttablet-like flags
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
@@ -168,6 +186,24 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	// Verify a backup instead of taking one, if requested.
+	if *verifyBackupName != "" {
+		if err := verifyBackup(ctx, *verifyBackupName); err != nil {
+			log.Errorf("Backup verification failed: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
+	// Restore some tables instead of taking a backup, if requested.
+	if *restoreTables != "" {
+		if err := restoreTablesFromBackup(ctx, topoServer, *restoreTables); err != nil {
+			log.Errorf("Table restore failed: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
@@ -189,9 +225,21 @@ func main() {
 		log.Errorf("Couldn't prune old backups: %v", err)
 		exit.Return(1)
 	}
+
+	// Wait for the copies of the backup to the secondary storages before
+	// exiting, if the backup storage is replicated.
+	if rbs, ok := backupStorage.(*replicatedbackupstorage.ReplicatedBackupStorage); ok {
+		if err := rbs.WaitForCopies(ctx); err != nil {
+			log.Errorf("Couldn't copy the backup to the secondary storages: %v", err)
+			exit.Return(1)
+		}
+	}
 }
 
-func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
+// initMysqld starts a fresh mysqld for an imaginary tablet. The returned
+// cleanup function shuts down mysqld and removes its data, and must be
+// called even if initMysqld fails.
+func initMysqld(ctx context.Context) (*topodatapb.TabletAlias, *mysqlctl.Mysqld, *mysqlctl.Mycnf, func(), error) {
 	// This is an imaginary tablet alias. The value doesn't matter for anything,
 	// except that we generate a random UID to ensure the target backup
 	// directory is unique if multiple vtbackup instances are launched for the
@@ -199,7 +247,7 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	// storage location.
 	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
 	if err != nil {
-		return fmt.Errorf("can't generate random tablet UID: %v", err)
+		return nil, nil, nil, func() {}, fmt.Errorf("can't generate random tablet UID: %v", err)
 	}
 	tabletAlias := &topodatapb.TabletAlias{
 		Cell: "vtbackup",
@@ -210,31 +258,41 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	// every invocation of vtbackup starts with a clean slate, and it does not
 	// accumulate garbage (and run out of disk space) if it's restarted.
 	tabletDir := mysqlctl.TabletDir(tabletAlias.Uid)
-	defer func() {
+	removeTabletDir := func() {
 		log.Infof("Removing temporary tablet directory: %v", tabletDir)
 		if err := os.RemoveAll(tabletDir); err != nil {
 			log.Warningf("Failed to remove temporary tablet directory: %v", err)
 		}
-	}()
+	}
 
 	// Start up mysqld as if we are mysqlctld provisioning a fresh tablet.
 	mysqld, mycnf, err := mysqlctl.CreateMysqldAndMycnf(tabletAlias.Uid, *mysqlSocket, int32(*mysqlPort))
 	if err != nil {
-		return fmt.Errorf("failed to initialize mysql config: %v", err)
+		return nil, nil, nil, removeTabletDir, fmt.Errorf("failed to initialize mysql config: %v", err)
 	}
-	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
-	defer initCancel()
-	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
-		return fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
-	}
-	// Shut down mysqld when we're done.
-	defer func() {
+	cleanup := func() {
+		// Shut down mysqld when we're done.
 		// Be careful not to use the original context, because we don't want to
 		// skip shutdown just because we timed out waiting for other things.
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 		mysqld.Shutdown(ctx, mycnf, false)
-	}()
+		removeTabletDir()
+	}
+	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
+	defer initCancel()
+	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
+		return nil, nil, nil, cleanup, fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+	}
+	return tabletAlias, mysqld, mycnf, cleanup, nil
+}
+
+func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
+	tabletAlias, mysqld, mycnf, cleanup, err := initMysqld(ctx)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
 
 	extraEnv := map[string]string{
 		"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias),
@@ -518,10 +576,12 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
 		return nil
 	}
+	// Incremental backups need all their parents to be restored.
+	parents := backupParents(ctx, backups)
 	// We have more than the minimum retention count, so we could afford to
 	// prune some. See if any are beyond the minimum retention time.
 	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
+	for i, backup := range backups {
 		backupTime, err := parseBackupTime(backup.Name())
 		if err != nil {
 			return err
@@ -531,11 +591,19 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
 			break
 		}
+		if child := dependentBackup(backup.Name(), backups[i+1:], parents); child != "" {
+			log.Infof("Keeping old backup %v from %v, since the incremental backup %v depends on it", backup.Name(), backupDir, child)
+			continue
+		}
 		// Remove the backup.
 		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
 		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
 			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
 		}
+		// Its verification, if any, is not needed anymore.
+		if err := backupStorage.RemoveBackup(ctx, mysqlctl.GetBackupVerificationDir(*initKeyspace, *initShard), backup.Name()); err != nil {
+			log.Warningf("Couldn't remove the verification of backup %v: %v", backup.Name(), err)
+		}
 		// We successfully removed one backup. Can we afford to prune any more?
 		numBackups--
 		if numBackups == *minRetentionCount {
@@ -546,6 +614,38 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 	return nil
 }
 
+// backupParents returns the parent of each incremental backup.
+func backupParents(ctx context.Context, backups []backupstorage.BackupHandle) map[string]string {
+	parents := make(map[string]string)
+	for _, backup := range backups {
+		manifest, err := mysqlctl.GetBackupManifest(ctx, backup)
+		if err != nil {
+			// Incomplete backups are not restored, so they don't
+			// need their parents.
+			continue
+		}
+		if manifest.Parent != "" {
+			parents[backup.Name()] = manifest.Parent
+		}
+	}
+	return parents
+}
+
+// dependentBackup returns one of the newer backups which has the backup
+// as an ancestor, or "" if none of them needs it.
+func dependentBackup(name string, newer []backupstorage.BackupHandle, parents map[string]string) string {
+	for _, backup := range newer {
+		// Parents are older than their children, the length check only
+		// protects against loops in corrupted MANIFEST files.
+		for parent, n := parents[backup.Name()], 0; parent != "" && n <= len(parents); parent, n = parents[parent], n+1 {
+			if parent == name {
+				return backup.Name()
+			}
+		}
+	}
+	return ""
+}
+
 func parseBackupTime(name string) (time.Time, error) {
 	// Backup names are formatted as "date.time.tablet-alias".
 	parts := strings.Split(name, ".")
@@ -559,6 +659,69 @@ func parseBackupTime(name string) (time.Time, error) {
 	return backupTime, nil
 }
 
+// scratchRestoreParams returns the parameters to restore a backup of the
+// shard into the mysqld of initMysqld. If name is empty, the most recent
+// backup is restored.
+func scratchRestoreParams(tabletAlias *topodatapb.TabletAlias, mysqld *mysqlctl.Mysqld, mycnf *mysqlctl.Mycnf, name string) mysqlctl.RestoreParams {
+	dbName := *initDbNameOverride
+	if dbName == "" {
+		dbName = fmt.Sprintf("vt_%s", *initKeyspace)
+	}
+	return mysqlctl.RestoreParams{
+		Cnf:         mycnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: *concurrency,
+		HookExtraEnv: map[string]string{
+			"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias),
+		},
+		LocalMetadata:       map[string]string{},
+		DeleteBeforeRestore: true,
+		DbName:              dbName,
+		Keyspace:            *initKeyspace,
+		Shard:               *initShard,
+		BackupName:          name,
+	}
+}
+
+// verifyBackup restores a backup into a fresh mysqld, verifies its tables,
+// and records the verification next to the backup.
+func verifyBackup(ctx context.Context, name string) error {
+	tabletAlias, mysqld, mycnf, cleanup, err := initMysqld(ctx)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Restoring backup %v from directory %v", name, mysqlctl.GetBackupDir(*initKeyspace, *initShard))
+	params := scratchRestoreParams(tabletAlias, mysqld, mycnf, name)
+	var verification *mysqlctl.BackupVerification
+	backupManifest, err := mysqlctl.Restore(ctx, params)
+	switch {
+	case err == mysqlctl.ErrNoBackup || err == mysqlctl.ErrNoCompleteBackup:
+		return fmt.Errorf("no complete backup %v: %v", name, err)
+	case err != nil:
+		log.Errorf("Restore of backup %v failed: %v", name, err)
+		verification = &mysqlctl.BackupVerification{
+			Status:       mysqlctl.BackupVerificationFailed,
+			VerifiedTime: time.Now().UTC().Format(time.RFC3339),
+			Errors:       []string{fmt.Sprintf("restore failed: %v", err)},
+		}
+	default:
+		log.Infof("Verifying the tables of backup %v", name)
+		verification = mysqlctl.VerifyRestoredBackup(ctx, mysqld, backupManifest)
+	}
+
+	if err := mysqlctl.WriteBackupVerification(ctx, *initKeyspace, *initShard, name, verification); err != nil {
+		return fmt.Errorf("can't record the verification of backup %v: %v", name, err)
+	}
+	if verification.Status != mysqlctl.BackupVerificationPassed {
+		return fmt.Errorf("backup %v failed the verification: %v", name, strings.Join(verification.Errors, "; "))
+	}
+	log.Infof("Backup %v passed the verification", name)
+	return nil
+}
+
 func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) (bool, error) {
 	// Look for the most recent, complete backup.
 	backups, err := backupStorage.ListBackups(ctx, backupDir)
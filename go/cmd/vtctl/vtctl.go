@@ -31,6 +31,7 @@ import (
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/exit"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/servenv"
@@ -39,6 +40,8 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/workflow"
 	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var (
@@ -103,6 +106,16 @@ func main() {
 	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
 	installSignalHandlers(cancel)
 
+	// The user running vtctl is the caller recorded in the topo
+	// audit log. Under sudo, it is the user that ran sudo.
+	caller := os.Getenv("SUDO_USER")
+	if caller == "" {
+		caller = os.Getenv("USER")
+	}
+	if caller != "" {
+		ctx = callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: caller})
+	}
+
 	err := vtctl.RunCommand(ctx, wr, args)
 	cancel()
 	switch err {
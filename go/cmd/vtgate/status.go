@@ -42,6 +42,9 @@ func addStatusParts(vtg *vtgate.VTGate) {
 	servenv.AddStatusPart("Gateway Status", gateway.StatusTemplate, func() interface{} {
 		return vtg.GetGatewayCacheStatus()
 	})
+	servenv.AddStatusPart("Gateway Balancer", gateway.BalancerStatusTemplate, func() interface{} {
+		return vtg.GetGatewayBalancerStatus()
+	})
 	servenv.AddStatusPart("Health Check Cache", discovery.HealthCheckTemplate, func() interface{} {
 		return healthCheck.CacheStatus()
 	})
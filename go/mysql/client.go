@@ -235,9 +235,9 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 
 	// Remember a subset of the capabilities, so we can use them
 	// later in the protocol.
-	c.Capabilities = 0
+	c.Capabilities = capabilities & CapabilityClientSessionTrack
 	if !params.DisableClientDeprecateEOF {
-		c.Capabilities = capabilities & (CapabilityClientDeprecateEOF)
+		c.Capabilities |= capabilities & (CapabilityClientDeprecateEOF)
 	}
 
 	// Handle switch to SSL if necessary.
@@ -523,6 +523,8 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// Same for CapabilityClientSessionTrack.
+		c.Capabilities&CapabilityClientSessionTrack |
 		// Pass-through ClientFoundRows flag.
 		CapabilityClientFoundRows&uint32(params.Flags)
 
@@ -572,6 +574,8 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// Same for CapabilityClientSessionTrack.
+		c.Capabilities&CapabilityClientSessionTrack |
 		// Pass-through ClientFoundRows flag.
 		CapabilityClientFoundRows&uint32(params.Flags)
 
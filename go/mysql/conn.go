@@ -95,8 +95,8 @@ type Conn struct {
 	// the client and the server, and currently in use.
 	// It is set during the initial handshake.
 	//
-	// It is only used for CapabilityClientDeprecateEOF
-	// and CapabilityClientFoundRows.
+	// It is only used for CapabilityClientDeprecateEOF,
+	// CapabilityClientFoundRows and CapabilityClientSessionTrack.
 	Capabilities uint32
 
 	// CharacterSet is the character set used by the other side of the
@@ -120,6 +120,11 @@ type Conn struct {
 	// through the 'USE' statement, which will bypass this variable.
 	schemaName string
 
+	// schemaChanged is set on server-side connections when
+	// schemaName was changed by the handler, and the change
+	// has not been reported to the client yet.
+	schemaChanged bool
+
 	// ServerVersion is set during Connect with the server
 	// version.  It is not changed afterwards. It is unused for
 	// server-side connections.
@@ -668,20 +673,7 @@ func (c *Conn) IsClosed() bool {
 // Server -> Client.
 // This method returns a generic error, not a SQLError.
 func (c *Conn) writeOKPacket(affectedRows, lastInsertID uint64, flags uint16, warnings uint16) error {
-	length := 1 + // OKPacket
-		lenEncIntSize(affectedRows) +
-		lenEncIntSize(lastInsertID) +
-		2 + // flags
-		2 // warnings
-	data := c.startEphemeralPacket(length)
-	pos := 0
-	pos = writeByte(data, pos, OKPacket)
-	pos = writeLenEncInt(data, pos, affectedRows)
-	pos = writeLenEncInt(data, pos, lastInsertID)
-	pos = writeUint16(data, pos, flags)
-	_ = writeUint16(data, pos, warnings)
-
-	return c.writeEphemeralPacket()
+	return c.writeOKPacketWithState(OKPacket, affectedRows, lastInsertID, flags, warnings, "")
 }
 
 // writeOKPacketWithEOFHeader writes an OK packet with an EOF header.
@@ -690,22 +682,100 @@ func (c *Conn) writeOKPacket(affectedRows, lastInsertID uint64, flags uint16, wa
 // Server -> Client.
 // This method returns a generic error, not a SQLError.
 func (c *Conn) writeOKPacketWithEOFHeader(affectedRows, lastInsertID uint64, flags uint16, warnings uint16) error {
-	length := 1 + // EOFPacket
+	return c.writeOKPacketWithState(EOFPacket, affectedRows, lastInsertID, flags, warnings, "")
+}
+
+// writeOKPacketWithState writes an OK packet with the provided header.
+// If the client negotiated CapabilityClientSessionTrack, the packet
+// also carries the session state changes: the provided gtids, and
+// the current schema if it was changed since the last OK packet.
+// Packets with an EOF header end a result set, and clients only tell
+// them apart from rows by their short length: the session state
+// changes are kept for the next OK packet instead.
+// Server -> Client.
+// This method returns a generic error, not a SQLError.
+func (c *Conn) writeOKPacketWithState(header byte, affectedRows, lastInsertID uint64, flags uint16, warnings uint16, gtids string) error {
+	var stateInfo []byte
+	if c.Capabilities&CapabilityClientSessionTrack != 0 && header == OKPacket {
+		stateInfo = c.sessionStateInfo(gtids)
+		if len(stateInfo) > 0 {
+			flags |= ServerSessionStateChanged
+		}
+	}
+
+	length := 1 + // OKPacket or EOFPacket
 		lenEncIntSize(affectedRows) +
 		lenEncIntSize(lastInsertID) +
 		2 + // flags
 		2 // warnings
+	if c.Capabilities&CapabilityClientSessionTrack != 0 {
+		length += lenEncStringSize("") // info
+		if len(stateInfo) > 0 {
+			length += lenEncIntSize(uint64(len(stateInfo))) + len(stateInfo)
+		}
+	}
 	data := c.startEphemeralPacket(length)
 	pos := 0
-	pos = writeByte(data, pos, EOFPacket)
+	pos = writeByte(data, pos, header)
 	pos = writeLenEncInt(data, pos, affectedRows)
 	pos = writeLenEncInt(data, pos, lastInsertID)
 	pos = writeUint16(data, pos, flags)
-	_ = writeUint16(data, pos, warnings)
+	pos = writeUint16(data, pos, warnings)
+	if c.Capabilities&CapabilityClientSessionTrack != 0 {
+		pos = writeLenEncString(data, pos, "")
+		if len(stateInfo) > 0 {
+			pos = writeLenEncInt(data, pos, uint64(len(stateInfo)))
+			_ = writeEOFString(data, pos, string(stateInfo))
+		}
+	}
 
 	return c.writeEphemeralPacket()
 }
 
+// sessionStateInfo returns the encoded session state changes to
+// report to the client, and clears the pending schema change.
+// It returns nil if there is nothing to report.
+func (c *Conn) sessionStateInfo(gtids string) []byte {
+	var info []byte
+	if c.schemaChanged {
+		c.schemaChanged = false
+		data := make([]byte, lenEncStringSize(c.schemaName))
+		writeLenEncString(data, 0, c.schemaName)
+		info = appendSessionStateChange(info, SessionTrackSchema, data)
+	}
+	if gtids != "" {
+		// The GTIDs are prefixed by an encoding specification
+		// byte. The only defined value is 0, for a string.
+		data := make([]byte, 1+lenEncStringSize(gtids))
+		writeLenEncString(data, 1, gtids)
+		info = appendSessionStateChange(info, SessionTrackGtids, data)
+	}
+	return info
+}
+
+// appendSessionStateChange appends one session state change entry,
+// made of its type and its length-encoded data.
+func appendSessionStateChange(info []byte, changeType byte, data []byte) []byte {
+	entry := make([]byte, 1+lenEncIntSize(uint64(len(data))))
+	pos := writeByte(entry, 0, changeType)
+	writeLenEncInt(entry, pos, uint64(len(data)))
+	info = append(info, entry...)
+	return append(info, data...)
+}
+
+// SetSchemaName changes the default database of a server-side
+// connection. Handlers call it when they process a statement that
+// changes the current schema (like USE). If the client negotiated
+// CapabilityClientSessionTrack, the change is reported to it in the
+// next OK packet.
+func (c *Conn) SetSchemaName(schemaName string) {
+	if c.schemaName == schemaName {
+		return
+	}
+	c.schemaName = schemaName
+	c.schemaChanged = true
+}
+
 // writeErrorPacket writes an error packet.
 // Server -> Client.
 // This method returns a generic error, not a SQLError.
@@ -778,7 +848,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	case ComInitDB:
 		db := c.parseComInitDB(data)
 		c.recycleReadPacket()
-		c.schemaName = db
+		c.SetSchemaName(db)
 		handler.ComInitDB(c, db)
 		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
 			log.Errorf("Error writing ComInitDB result to %s: %v", c, err)
@@ -989,7 +1059,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 					if len(qr.Fields) == 0 {
 						sendFinished = true
 						// We should not send any more packets after this.
-						return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, 0)
+						return c.writeOKPacketWithState(OKPacket, qr.RowsAffected, qr.InsertID, c.StatusFlags, 0, qr.SessionStateChanges)
 					}
 					if err := c.writeFields(qr); err != nil {
 						return err
@@ -1155,7 +1225,7 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) error {
 				// We should not send any more packets after this, but make sure
 				// to extract the affected rows and last insert id from the result
 				// struct here since clients expect it.
-				return c.writeOKPacket(qr.RowsAffected, qr.InsertID, flag, handler.WarningCount(c))
+				return c.writeOKPacketWithState(OKPacket, qr.RowsAffected, qr.InsertID, flag, handler.WarningCount(c), qr.SessionStateChanges)
 			}
 			if err := c.writeFields(qr); err != nil {
 				return err
@@ -1239,35 +1309,98 @@ func parseEOFPacket(data []byte) (warnings uint16, more bool, err error) {
 	return warnings, (statusFlags & ServerMoreResultsExists) != 0, nil
 }
 
-func parseOKPacket(data []byte) (uint64, uint64, uint16, uint16, error) {
+// okPacket contains the parsed fields of an OK packet.
+type okPacket struct {
+	affectedRows uint64
+	lastInsertID uint64
+	statusFlags  uint16
+	warnings     uint16
+
+	// gtids is the value of the SESSION_TRACK_GTIDS session state
+	// change, if the server sent one.
+	gtids string
+}
+
+// parseOKPacket parses an OK packet. If CapabilityClientSessionTrack
+// was negotiated, it also parses the session state changes: the GTIDs
+// are returned, and a schema change updates the connection's schema name.
+func (c *Conn) parseOKPacket(data []byte) (*okPacket, error) {
 	// We already read the type.
 	pos := 1
+	packetOK := &okPacket{}
 
+	var ok bool
 	// Affected rows.
-	affectedRows, pos, ok := readLenEncInt(data, pos)
+	packetOK.affectedRows, pos, ok = readLenEncInt(data, pos)
 	if !ok {
-		return 0, 0, 0, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet affectedRows: %v", data)
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet affectedRows: %v", data)
 	}
 
 	// Last Insert ID.
-	lastInsertID, pos, ok := readLenEncInt(data, pos)
+	packetOK.lastInsertID, pos, ok = readLenEncInt(data, pos)
 	if !ok {
-		return 0, 0, 0, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet lastInsertID: %v", data)
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet lastInsertID: %v", data)
 	}
 
 	// Status flags.
-	statusFlags, pos, ok := readUint16(data, pos)
+	packetOK.statusFlags, pos, ok = readUint16(data, pos)
 	if !ok {
-		return 0, 0, 0, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet statusFlags: %v", data)
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet statusFlags: %v", data)
 	}
 
 	// Warnings.
-	warnings, _, ok := readUint16(data, pos)
+	packetOK.warnings, pos, ok = readUint16(data, pos)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet warnings: %v", data)
+	}
+
+	if c.Capabilities&CapabilityClientSessionTrack == 0 || pos == len(data) {
+		// The rest is human readable info, which we ignore.
+		return packetOK, nil
+	}
+
+	// Info, which we ignore.
+	pos, ok = skipLenEncString(data, pos)
 	if !ok {
-		return 0, 0, 0, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet warnings: %v", data)
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet info: %v", data)
+	}
+	if packetOK.statusFlags&ServerSessionStateChanged == 0 {
+		return packetOK, nil
+	}
+
+	stateInfo, _, ok := readLenEncStringAsBytes(data, pos)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet session state info: %v", data)
+	}
+	for spos := 0; spos < len(stateInfo); {
+		changeType, npos, ok := readByte(stateInfo, spos)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet session state type: %v", data)
+		}
+		changeData, npos, ok := readLenEncStringAsBytes(stateInfo, npos)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet session state data: %v", data)
+		}
+		spos = npos
+
+		switch changeType {
+		case SessionTrackSchema:
+			schemaName, _, ok := readLenEncString(changeData, 0)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet schema change: %v", data)
+			}
+			c.schemaName = schemaName
+		case SessionTrackGtids:
+			// Skip the encoding specification byte.
+			gtids, _, ok := readLenEncString(changeData, 1)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid OK packet GTIDs: %v", data)
+			}
+			packetOK.gtids = gtids
+		}
 	}
 
-	return affectedRows, lastInsertID, statusFlags, warnings, nil
+	return packetOK, nil
 }
 
 // isErrorPacket determines whether or not the packet is an error packet. Mostly here for
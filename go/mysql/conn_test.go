@@ -212,9 +212,9 @@ func TestBasicPackets(t *testing.T) {
 	if err != nil || len(data) == 0 || data[0] != OKPacket {
 		t.Fatalf("cConn.ReadPacket - OKPacket failed: %v %v", data, err)
 	}
-	affectedRows, lastInsertID, statusFlags, warnings, err := parseOKPacket(data)
-	if err != nil || affectedRows != 12 || lastInsertID != 34 || statusFlags != 56 || warnings != 78 {
-		t.Errorf("parseOKPacket returned unexpected data: %v %v %v %v %v", affectedRows, lastInsertID, statusFlags, warnings, err)
+	packetOK, err := cConn.parseOKPacket(data)
+	if err != nil || packetOK.affectedRows != 12 || packetOK.lastInsertID != 34 || packetOK.statusFlags != 56 || packetOK.warnings != 78 {
+		t.Errorf("parseOKPacket returned unexpected data: %+v %v", packetOK, err)
 	}
 
 	// Write OK packet with EOF header, read it, compare.
@@ -225,9 +225,9 @@ func TestBasicPackets(t *testing.T) {
 	if err != nil || len(data) == 0 || !isEOFPacket(data) {
 		t.Fatalf("cConn.ReadPacket - OKPacket with EOF header failed: %v %v", data, err)
 	}
-	affectedRows, lastInsertID, statusFlags, warnings, err = parseOKPacket(data)
-	if err != nil || affectedRows != 12 || lastInsertID != 34 || statusFlags != 56 || warnings != 78 {
-		t.Errorf("parseOKPacket returned unexpected data: %v %v %v %v %v", affectedRows, lastInsertID, statusFlags, warnings, err)
+	packetOK, err = cConn.parseOKPacket(data)
+	if err != nil || packetOK.affectedRows != 12 || packetOK.lastInsertID != 34 || packetOK.statusFlags != 56 || packetOK.warnings != 78 {
+		t.Errorf("parseOKPacket returned unexpected data: %+v %v", packetOK, err)
 	}
 
 	// Write error packet, read it, compare.
@@ -266,6 +266,70 @@ func TestBasicPackets(t *testing.T) {
 	}
 }
 
+func TestOKPacketSessionTrack(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities = CapabilityClientSessionTrack
+	cConn.Capabilities = CapabilityClientSessionTrack
+
+	// Without changes, the status flag is not set.
+	if err := sConn.writeOKPacket(1, 2, 3, 4); err != nil {
+		t.Fatalf("writeOKPacket failed: %v", err)
+	}
+	data, err := cConn.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != OKPacket {
+		t.Fatalf("cConn.ReadPacket - OKPacket failed: %v %v", data, err)
+	}
+	packetOK, err := cConn.parseOKPacket(data)
+	if err != nil || packetOK.affectedRows != 1 || packetOK.lastInsertID != 2 || packetOK.statusFlags != 3 || packetOK.warnings != 4 || packetOK.gtids != "" {
+		t.Errorf("parseOKPacket returned unexpected data: %+v %v", packetOK, err)
+	}
+
+	// A schema change and GTIDs are both reported, once.
+	sConn.SetSchemaName("ks")
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:23"
+	if err := sConn.writeOKPacketWithState(OKPacket, 1, 0, 0, 0, gtid); err != nil {
+		t.Fatalf("writeOKPacketWithState failed: %v", err)
+	}
+	data, err = cConn.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != OKPacket {
+		t.Fatalf("cConn.ReadPacket - OKPacket failed: %v %v", data, err)
+	}
+	packetOK, err = cConn.parseOKPacket(data)
+	if err != nil || packetOK.statusFlags != ServerSessionStateChanged || packetOK.gtids != gtid {
+		t.Errorf("parseOKPacket returned unexpected data: %+v %v", packetOK, err)
+	}
+	if cConn.schemaName != "ks" {
+		t.Errorf("client schema name: %v, want ks", cConn.schemaName)
+	}
+	if sConn.schemaChanged {
+		t.Errorf("schema change was not cleared after being reported")
+	}
+
+	// Without the capability, nothing is sent.
+	sConn.Capabilities = 0
+	cConn.Capabilities = 0
+	sConn.SetSchemaName("ks2")
+	if err := sConn.writeOKPacketWithState(OKPacket, 1, 0, 0, 0, gtid); err != nil {
+		t.Fatalf("writeOKPacketWithState failed: %v", err)
+	}
+	data, err = cConn.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != OKPacket {
+		t.Fatalf("cConn.ReadPacket - OKPacket failed: %v %v", data, err)
+	}
+	packetOK, err = cConn.parseOKPacket(data)
+	if err != nil || packetOK.statusFlags != 0 || packetOK.gtids != "" {
+		t.Errorf("parseOKPacket returned unexpected data: %+v %v", packetOK, err)
+	}
+	if cConn.schemaName != "ks" {
+		t.Errorf("client schema name: %v, want ks", cConn.schemaName)
+	}
+}
+
 // Mostly a sanity check.
 func TestEOFOrLengthEncodedIntFuzz(t *testing.T) {
 	for i := 0; i < 100; i++ {
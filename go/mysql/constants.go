@@ -125,10 +125,10 @@ const (
 	// Announces support for expired password extension.
 	// Not yet supported.
 
-	// CLIENT_SESSION_TRACK 1 << 23
+	// CapabilityClientSessionTrack is CLIENT_SESSION_TRACK.
 	// Can set SERVER_SESSION_STATE_CHANGED in the Status Flags
 	// and send session-state change data after a OK packet.
-	// Not yet supported.
+	CapabilityClientSessionTrack = 1 << 23
 
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
@@ -499,6 +499,29 @@ const (
 
 	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS
 	ServerMoreResultsExists = 0x0008
+
+	// ServerSessionStateChanged is SERVER_SESSION_STATE_CHANGED.
+	// Set when the OK packet contains session state change information.
+	ServerSessionStateChanged = 0x4000
+)
+
+// Session state change types, used when CapabilityClientSessionTrack
+// is negotiated.
+// Originally found in include/mysql/mysql_com.h (enum_session_state_type)
+const (
+	// SessionTrackSystemVariables is SESSION_TRACK_SYSTEM_VARIABLES.
+	SessionTrackSystemVariables = 0x00
+
+	// SessionTrackSchema is SESSION_TRACK_SCHEMA.
+	// The current schema was changed.
+	SessionTrackSchema = 0x01
+
+	// SessionTrackStateChange is SESSION_TRACK_STATE_CHANGE.
+	SessionTrackStateChange = 0x02
+
+	// SessionTrackGtids is SESSION_TRACK_GTIDS.
+	// The data contains the GTIDs tracked by session_track_gtids.
+	SessionTrackGtids = 0x03
 )
 
 // A few interesting character set values.
@@ -123,6 +123,34 @@ func parseMysql56GTIDSet(s string) (GTIDSet, error) {
 	return set, nil
 }
 
+// ParseMysql56GTIDSet parses a MySQL 5.6 GTID set, in the format
+// used by MySQL for @@gtid_executed and session tracking.
+func ParseMysql56GTIDSet(s string) (Mysql56GTIDSet, error) {
+	set, err := parseMysql56GTIDSet(s)
+	if err != nil {
+		return nil, err
+	}
+	return set.(Mysql56GTIDSet), nil
+}
+
+// mergeIntervals sorts the intervals, and merges the ones that
+// overlap or are adjacent.
+func mergeIntervals(intervals []interval) []interval {
+	sort.Sort(intervalList(intervals))
+	merged := make([]interval, 0, len(intervals))
+	for _, iv := range intervals {
+		count := len(merged)
+		if count != 0 && iv.start <= merged[count-1].end+1 {
+			if iv.end > merged[count-1].end {
+				merged[count-1].end = iv.end
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
 // Mysql56GTIDSet implements GTIDSet for MySQL 5.6.
 type Mysql56GTIDSet map[SID][]interval
 
@@ -334,6 +362,78 @@ func (set Mysql56GTIDSet) AddGTID(gtid GTID) GTIDSet {
 	return newSet
 }
 
+// Difference returns the transactions of the set that are not in
+// the other set.
+func (set Mysql56GTIDSet) Difference(other Mysql56GTIDSet) Mysql56GTIDSet {
+	result := make(Mysql56GTIDSet)
+	for sid, intervals := range set {
+		otherIntervals := other[sid]
+		var newIntervals []interval
+		for _, iv := range intervals {
+			// Remove the parts of the interval that are in the
+			// other set. Intervals are sorted, so the other
+			// intervals are visited in order.
+			for _, oiv := range otherIntervals {
+				if oiv.end < iv.start || oiv.start > iv.end {
+					continue
+				}
+				if oiv.start > iv.start {
+					newIntervals = append(newIntervals, interval{start: iv.start, end: oiv.start - 1})
+				}
+				iv.start = oiv.end + 1
+				if iv.start > iv.end {
+					break
+				}
+			}
+			if iv.start <= iv.end {
+				newIntervals = append(newIntervals, iv)
+			}
+		}
+		if len(newIntervals) > 0 {
+			result[sid] = newIntervals
+		}
+	}
+	return result
+}
+
+// Union returns the transactions that are in the set or in the
+// other set.
+func (set Mysql56GTIDSet) Union(other Mysql56GTIDSet) Mysql56GTIDSet {
+	result := make(Mysql56GTIDSet, len(set))
+	for sid, intervals := range set {
+		result[sid] = append([]interval(nil), intervals...)
+	}
+	for sid, intervals := range other {
+		result[sid] = mergeIntervals(append(result[sid], intervals...))
+	}
+	return result
+}
+
+// Count returns the number of transactions of the set, without
+// listing them.
+func (set Mysql56GTIDSet) Count() int64 {
+	var count int64
+	for _, intervals := range set {
+		for _, iv := range intervals {
+			count += iv.end - iv.start + 1
+		}
+	}
+	return count
+}
+
+// GTIDs returns the transactions of the set, sorted by SID and sequence.
+func (set Mysql56GTIDSet) GTIDs() []Mysql56GTID {
+	var result []Mysql56GTID
+	for _, sid := range set.SIDs() {
+		for _, iv := range set[sid] {
+			for sequence := iv.start; sequence <= iv.end; sequence++ {
+				result = append(result, Mysql56GTID{Server: sid, Sequence: sequence})
+			}
+		}
+	}
+	return result
+}
+
 // SIDBlock returns the binary encoding of a MySQL 5.6 GTID set as expected
 // by internal commands that refer to an "SID block".
 //
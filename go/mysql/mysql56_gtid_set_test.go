@@ -417,6 +417,104 @@ func TestMysql56GTIDSetAddGTID(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetDifference(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+	sid3 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{20, 30}, {35, 40}, {42, 45}},
+		sid2: []interval{{1, 5}, {50, 50}, {60, 70}},
+		sid3: []interval{{1, 10}},
+	}
+	other := Mysql56GTIDSet{
+		sid1: []interval{{1, 21}, {25, 26}, {29, 36}, {42, 45}},
+		sid2: []interval{{1, 5}, {50, 50}, {60, 70}},
+		sid3: []interval{{5, 5}, {20, 30}},
+	}
+	want := Mysql56GTIDSet{
+		sid1: []interval{{22, 24}, {27, 28}, {37, 40}},
+		sid3: []interval{{1, 4}, {6, 10}},
+	}
+	if got := set.Difference(other); !got.Equal(want) {
+		t.Errorf("Difference() = %#v, want %#v", got, want)
+	}
+	if got := set.Difference(set); len(got) != 0 {
+		t.Errorf("Difference(self) = %#v, want empty", got)
+	}
+	if got := set.Difference(Mysql56GTIDSet{}); !got.Equal(set) {
+		t.Errorf("Difference(empty) = %#v, want %#v", got, set)
+	}
+}
+
+func TestMysql56GTIDSetUnion(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+	sid3 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{1, 5}, {20, 30}},
+		sid2: []interval{{7, 7}},
+	}
+	other := Mysql56GTIDSet{
+		sid1: []interval{{4, 8}, {10, 12}, {31, 35}},
+		sid3: []interval{{1, 1}},
+	}
+	want := Mysql56GTIDSet{
+		sid1: []interval{{1, 8}, {10, 12}, {20, 35}},
+		sid2: []interval{{7, 7}},
+		sid3: []interval{{1, 1}},
+	}
+	if got := set.Union(other); !got.Equal(want) {
+		t.Errorf("Union() = %#v, want %#v", got, want)
+	}
+	if got := other.Union(set); !got.Equal(want) {
+		t.Errorf("Union() = %#v, want %#v", got, want)
+	}
+	if got := set.Union(Mysql56GTIDSet{}); !got.Equal(set) {
+		t.Errorf("Union(empty) = %#v, want %#v", got, set)
+	}
+	// The sets are not modified.
+	if len(set[sid1]) != 2 || set[sid1][0].end != 5 || len(other[sid1]) != 3 {
+		t.Errorf("Union() modified its inputs: %#v, %#v", set, other)
+	}
+}
+
+func TestMysql56GTIDSetCount(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{1, 2}, {5, 5}},
+		sid2: []interval{{1, 1000000000000}},
+	}
+	if got, want := set.Count(), int64(1000000000003); got != want {
+		t.Errorf("Count() = %v, want %v", got, want)
+	}
+	if got := (Mysql56GTIDSet{}).Count(); got != 0 {
+		t.Errorf("Count(empty) = %v, want 0", got)
+	}
+}
+
+func TestMysql56GTIDSetGTIDs(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	set := Mysql56GTIDSet{
+		sid2: []interval{{7, 7}},
+		sid1: []interval{{1, 2}, {5, 5}},
+	}
+	want := []Mysql56GTID{
+		{Server: sid1, Sequence: 1},
+		{Server: sid1, Sequence: 2},
+		{Server: sid1, Sequence: 5},
+		{Server: sid2, Sequence: 7},
+	}
+	if got := set.GTIDs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GTIDs() = %v, want %v", got, want)
+	}
+}
+
 func TestMysql56GTIDSetSIDBlock(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
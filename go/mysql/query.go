@@ -343,17 +343,18 @@ func (c *Conn) ExecuteFetchWithWarningCount(query string, maxrows int, wantfield
 // ReadQueryResult gets the result from the last written query.
 func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.Result, more bool, warnings uint16, err error) {
 	// Get the result.
-	affectedRows, lastInsertID, colNumber, more, warnings, err := c.readComQueryResponse()
+	colNumber, packetOK, err := c.readComQueryResponse()
 	if err != nil {
 		return nil, false, 0, err
 	}
 
-	if colNumber == 0 {
+	if packetOK != nil {
 		// OK packet, means no results. Just use the numbers.
 		return &sqltypes.Result{
-			RowsAffected: affectedRows,
-			InsertID:     lastInsertID,
-		}, more, warnings, nil
+			RowsAffected:        packetOK.affectedRows,
+			InsertID:            packetOK.lastInsertID,
+			SessionStateChanges: packetOK.gtids,
+		}, (packetOK.statusFlags & ServerMoreResultsExists) != 0, packetOK.warnings, nil
 	}
 
 	fields := make([]querypb.Field, colNumber)
@@ -421,12 +422,12 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 					return nil, false, 0, err
 				}
 			} else {
-				var statusFlags uint16
-				_, _, statusFlags, warnings, err = parseOKPacket(data)
+				packetOK, err := c.parseOKPacket(data)
 				if err != nil {
 					return nil, false, 0, err
 				}
-				more = (statusFlags & ServerMoreResultsExists) != 0
+				warnings = packetOK.warnings
+				more = (packetOK.statusFlags & ServerMoreResultsExists) != 0
 			}
 			return result, more, warnings, nil
 
@@ -470,35 +471,38 @@ func (c *Conn) drainResults() error {
 	}
 }
 
-func (c *Conn) readComQueryResponse() (affectedRows uint64, lastInsertID uint64, status int, more bool, warnings uint16, err error) {
+// readComQueryResponse reads the first packet of a COM_QUERY response.
+// If it is an OK packet, it is returned. Otherwise, the number of
+// columns of the result set is returned.
+func (c *Conn) readComQueryResponse() (colNumber int, packetOK *okPacket, err error) {
 	data, err := c.readEphemeralPacket()
 	if err != nil {
-		return 0, 0, 0, false, 0, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		return 0, nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 	}
 	defer c.recycleReadPacket()
 	if len(data) == 0 {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
+		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
 	}
 
 	switch data[0] {
 	case OKPacket:
-		affectedRows, lastInsertID, status, warnings, err := parseOKPacket(data)
-		return affectedRows, lastInsertID, 0, (status & ServerMoreResultsExists) != 0, warnings, err
+		packetOK, err := c.parseOKPacket(data)
+		return 0, packetOK, err
 	case ErrPacket:
 		// Error
-		return 0, 0, 0, false, 0, ParseErrorPacket(data)
+		return 0, nil, ParseErrorPacket(data)
 	case 0xfb:
 		// Local infile
-		return 0, 0, 0, false, 0, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "not implemented")
+		return 0, nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "not implemented")
 	}
 	n, pos, ok := readLenEncInt(data, 0)
 	if !ok {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
+		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
 	}
 	if pos != len(data) {
-		return 0, 0, 0, false, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
+		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
 	}
-	return 0, 0, int(n), false, 0, nil
+	return int(n), nil, nil
 }
 
 //
@@ -457,8 +457,11 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		log.Warningf("Slow connection from %s: %v", c, connectTime)
 	}
 
-	// Set initial db name.
+	// Set initial db name. The client already knows the schema it
+	// connected with, so there is no change to report to it, even if
+	// the handler normalized the name.
 	l.handler.ComInitDB(c, c.schemaName)
+	c.schemaChanged = false
 
 	for {
 		err := c.handleNextCommand(l.handler)
@@ -500,7 +503,8 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 		CapabilityClientPluginAuth |
 		CapabilityClientPluginAuthLenencClientData |
 		CapabilityClientDeprecateEOF |
-		CapabilityClientConnAttr
+		CapabilityClientConnAttr |
+		CapabilityClientSessionTrack
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
@@ -607,7 +611,7 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 	// later in the protocol. If we re-received the handshake packet
 	// after SSL negotiation, do not overwrite capabilities.
 	if firstTime {
-		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows)
+		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows | CapabilityClientSessionTrack)
 	}
 
 	// set connection capability for executing multi statements
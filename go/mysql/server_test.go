@@ -114,6 +114,10 @@ func (th *testHandler) ConnectionClosed(c *Conn) {
 }
 
 func (th *testHandler) ComInitDB(c *Conn, schemaName string) {
+	// Strip the tablet type, like vtgate does.
+	if i := strings.Index(schemaName, "@"); i != -1 {
+		c.SetSchemaName(schemaName[:i])
+	}
 }
 
 func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error {
@@ -494,6 +498,52 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+func TestInitialSchemaNotReported(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:   host,
+		Port:   port,
+		Uname:  "user1",
+		Pass:   "password1",
+		DbName: "ks@replica",
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if c.Capabilities&CapabilityClientSessionTrack == 0 {
+		t.Fatalf("session tracking was not negotiated: %x", c.Capabilities)
+	}
+
+	// The schema name the handler set during the handshake must
+	// not be reported as a change.
+	if _, err := c.ExecuteFetch("insert", 10, false); err != nil {
+		t.Fatal(err)
+	}
+	if c.schemaName != "ks@replica" {
+		t.Errorf("client schema name: %v, want ks@replica", c.schemaName)
+	}
+	if got := th.LastConn().schemaName; got != "ks" {
+		t.Errorf("server schema name: %v, want ks", got)
+	}
+}
+
 func TestConnCounts(t *testing.T) {
 	th := &testHandler{}
 
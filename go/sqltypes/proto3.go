@@ -87,11 +87,12 @@ func ResultToProto3(qr *Result) *querypb.QueryResult {
 		return nil
 	}
 	return &querypb.QueryResult{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertId:     qr.InsertID,
-		Rows:         RowsToProto3(qr.Rows),
-		Extras:       qr.Extras,
+		Fields:              qr.Fields,
+		RowsAffected:        qr.RowsAffected,
+		InsertId:            qr.InsertID,
+		Rows:                RowsToProto3(qr.Rows),
+		Extras:              qr.Extras,
+		SessionStateChanges: qr.SessionStateChanges,
 	}
 }
 
@@ -102,11 +103,12 @@ func Proto3ToResult(qr *querypb.QueryResult) *Result {
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(qr.Fields, qr.Rows),
-		Extras:       qr.Extras,
+		Fields:              qr.Fields,
+		RowsAffected:        qr.RowsAffected,
+		InsertID:            qr.InsertId,
+		Rows:                proto3ToRows(qr.Fields, qr.Rows),
+		Extras:              qr.Extras,
+		SessionStateChanges: qr.SessionStateChanges,
 	}
 }
 
@@ -118,11 +120,12 @@ func CustomProto3ToResult(fields []*querypb.Field, qr *querypb.QueryResult) *Res
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(fields, qr.Rows),
-		Extras:       qr.Extras,
+		Fields:              qr.Fields,
+		RowsAffected:        qr.RowsAffected,
+		InsertID:            qr.InsertId,
+		Rows:                proto3ToRows(fields, qr.Rows),
+		Extras:              qr.Extras,
+		SessionStateChanges: qr.SessionStateChanges,
 	}
 }
 
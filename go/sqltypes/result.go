@@ -25,11 +25,12 @@ import (
 
 // Result represents a query result.
 type Result struct {
-	Fields       []*querypb.Field      `json:"fields"`
-	RowsAffected uint64                `json:"rows_affected"`
-	InsertID     uint64                `json:"insert_id"`
-	Rows         [][]Value             `json:"rows"`
-	Extras       *querypb.ResultExtras `json:"extras"`
+	Fields              []*querypb.Field      `json:"fields"`
+	RowsAffected        uint64                `json:"rows_affected"`
+	InsertID            uint64                `json:"insert_id"`
+	Rows                [][]Value             `json:"rows"`
+	Extras              *querypb.ResultExtras `json:"extras"`
+	SessionStateChanges string                `json:"session_state_changes"`
 }
 
 // ResultStream is an interface for receiving Result. It is used for
@@ -56,8 +57,9 @@ func (result *Result) Repair(fields []*querypb.Field) {
 // Copy creates a deep copy of Result.
 func (result *Result) Copy() *Result {
 	out := &Result{
-		InsertID:     result.InsertID,
-		RowsAffected: result.RowsAffected,
+		InsertID:            result.InsertID,
+		RowsAffected:        result.RowsAffected,
+		SessionStateChanges: result.SessionStateChanges,
 	}
 	if result.Fields != nil {
 		fieldsp := make([]*querypb.Field, len(result.Fields))
@@ -106,8 +108,9 @@ func (result *Result) Truncate(l int) *Result {
 	}
 
 	out := &Result{
-		InsertID:     result.InsertID,
-		RowsAffected: result.RowsAffected,
+		InsertID:            result.InsertID,
+		RowsAffected:        result.RowsAffected,
+		SessionStateChanges: result.SessionStateChanges,
 	}
 	if result.Fields != nil {
 		out.Fields = result.Fields[:l]
@@ -158,12 +161,13 @@ func (result *Result) Equal(other *Result) bool {
 		return false
 	}
 
-	// Compare Fields, RowsAffected, InsertID, Rows, Extras.
+	// Compare Fields, RowsAffected, InsertID, Rows, Extras, SessionStateChanges.
 	return FieldsEqual(result.Fields, other.Fields) &&
 		result.RowsAffected == other.RowsAffected &&
 		result.InsertID == other.InsertID &&
 		reflect.DeepEqual(result.Rows, other.Rows) &&
-		proto.Equal(result.Extras, other.Extras)
+		proto.Equal(result.Extras, other.Extras) &&
+		result.SessionStateChanges == other.SessionStateChanges
 }
 
 // ResultsEqual compares two arrays of Result.
@@ -232,6 +236,14 @@ func (result *Result) StripMetadata(incl querypb.ExecuteOptions_IncludedFields)
 // to another result.Note currently it doesn't handle cases like
 // if two results have different fields.We will enhance this function.
 func (result *Result) AppendResult(src *Result) {
+	if src.SessionStateChanges != "" {
+		// Session state changes come from different shards,
+		// they are accumulated.
+		if result.SessionStateChanges != "" {
+			result.SessionStateChanges += ","
+		}
+		result.SessionStateChanges += src.SessionStateChanges
+	}
 	if src.RowsAffected == 0 && len(src.Fields) == 0 {
 		return
 	}
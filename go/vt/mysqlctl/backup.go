@@ -106,6 +106,14 @@ func Backup(ctx context.Context, params BackupParams) error {
 	if err != nil {
 		return vterrors.Wrap(err, "StartBackup failed")
 	}
+	ebh, err := maybeEncryptBackup(ctx, bh)
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort backup")
+		}
+		return vterrors.Wrap(err, "cannot encrypt backup")
+	}
+	bh = ebh
 
 	be, err := GetBackupEngine()
 	if err != nil {
@@ -287,6 +295,13 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 	if err != nil {
 		return nil, err
 	}
+	bm, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if bh, err = maybeDecryptBackup(ctx, bh, bm.Encryption); err != nil {
+		return nil, err
+	}
 
 	re, err := GetRestoreEngine(ctx, bh)
 	if err != nil {
@@ -317,7 +332,9 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "mysql_upgrade failed")
 	}
 
-	// Add backupTime and restorePosition to LocalMetadata
+	// Add backupTime and restorePosition to LocalMetadata. A point in
+	// time restore records its position again once the binlogs are
+	// replayed.
 	params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
 	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
 
@@ -341,6 +358,19 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	if params.isPointInTimeRestore() {
+		params.Logger.Infof("Restore: replaying the archived binlogs")
+		pos, err := applyArchivedBinlogs(ctx, params, bs, manifest.Position)
+		if err != nil {
+			return nil, err
+		}
+		params.Logger.Infof("Restore: restored to position %v", pos)
+		params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(pos)
+		if err := PopulateMetadataTables(params.Mysqld, map[string]string{"RestorePosition": params.LocalMetadata["RestorePosition"]}, params.DbName); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
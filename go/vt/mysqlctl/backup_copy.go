@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file copies backups between two backup storages, for instance
+// to keep a copy of the backups in another region. The files are
+// copied as they are stored, so encrypted backups stay encrypted.
+// The verifications of the backups are copied the same way.
+
+// completionFiles are the files written last in a backup storage
+// handle: a backup or a binlog archive is complete once it has its
+// MANIFEST, and a verification once it has its VERIFICATION.
+var completionFiles = map[string]bool{
+	backupManifestFileName:     true,
+	backupVerificationFileName: true,
+}
+
+// isCompleteBackup returns true if the files of a backup include
+// its completion file.
+func isCompleteBackup(files []string) bool {
+	for _, file := range files {
+		if completionFiles[file] {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyBackup copies the files of a complete backup into another storage,
+// under the same directory and name. The completion file is copied last,
+// so an interrupted copy is not complete, and is skipped by the restores.
+// The copy is limited by the backup rate.
+func CopyBackup(ctx context.Context, bh backupstorage.BackupHandle, dst backupstorage.BackupStorage) (finalErr error) {
+	files, err := bh.ListFiles(ctx)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot list the files of backup %v/%v", bh.Directory(), bh.Name())
+	}
+	if !isCompleteBackup(files) {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v/%v has no %v or %v, it is not complete", bh.Directory(), bh.Name(), backupManifestFileName, backupVerificationFileName)
+	}
+
+	dbh, err := dst.StartBackup(ctx, bh.Directory(), bh.Name())
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot start the copy of backup %v/%v", bh.Directory(), bh.Name())
+	}
+	defer func() {
+		if finalErr != nil {
+			if err := dbh.AbortBackup(ctx); err != nil {
+				finalErr = vterrors.Wrapf(finalErr, "cannot abort the copy: %v, after error", err)
+			}
+		}
+	}()
+	var last []string
+	for _, file := range files {
+		if completionFiles[file] {
+			last = append(last, file)
+			continue
+		}
+		if err := copyBackupFile(ctx, bh, dbh, file); err != nil {
+			return err
+		}
+	}
+	for _, file := range last {
+		if err := copyBackupFile(ctx, bh, dbh, file); err != nil {
+			return err
+		}
+	}
+	return dbh.EndBackup(ctx)
+}
+
+func copyBackupFile(ctx context.Context, bh, dbh backupstorage.BackupHandle, file string) error {
+	source, err := bh.ReadFile(ctx, file)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot read %v of backup %v/%v", file, bh.Directory(), bh.Name())
+	}
+	defer source.Close()
+	dest, err := dbh.AddFile(ctx, file, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to the copy of backup %v/%v", file, bh.Directory(), bh.Name())
+	}
+	if _, err := io.Copy(dest, NewThrottledReader(ctx, source)); err != nil {
+		dest.Close()
+		return vterrors.Wrapf(err, "cannot copy %v of backup %v/%v", file, bh.Directory(), bh.Name())
+	}
+	if err := dest.Close(); err != nil {
+		return vterrors.Wrapf(err, "cannot close %v of the copy of backup %v/%v", file, bh.Directory(), bh.Name())
+	}
+	return nil
+}
+
+// SyncBackups copies the complete backups of a directory from one storage
+// into another. If names is empty, all the complete backups missing from
+// the destination are copied, else only the named ones, which must be
+// complete. The incomplete copies in the destination are removed and
+// copied again. It returns the names of the copied backups.
+func SyncBackups(ctx context.Context, src, dst backupstorage.BackupStorage, dir string, names []string, logger logutil.Logger) ([]string, error) {
+	srcHandles, err := src.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot list the source backups")
+	}
+	dstHandles, err := dst.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot list the destination backups")
+	}
+	dstByName := make(map[string]backupstorage.BackupHandle, len(dstHandles))
+	for _, bh := range dstHandles {
+		dstByName[bh.Name()] = bh
+	}
+
+	var toCopy []backupstorage.BackupHandle
+	if len(names) == 0 {
+		for _, bh := range srcHandles {
+			files, err := bh.ListFiles(ctx)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "cannot list the files of backup %v/%v", dir, bh.Name())
+			}
+			if isCompleteBackup(files) {
+				toCopy = append(toCopy, bh)
+			}
+		}
+	} else {
+		srcByName := make(map[string]backupstorage.BackupHandle, len(srcHandles))
+		for _, bh := range srcHandles {
+			srcByName[bh.Name()] = bh
+		}
+		for _, name := range names {
+			bh, ok := srcByName[name]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backup %v/%v in the source", dir, name)
+			}
+			toCopy = append(toCopy, bh)
+		}
+	}
+
+	var copied []string
+	for _, bh := range toCopy {
+		if dbh, ok := dstByName[bh.Name()]; ok {
+			files, err := dbh.ListFiles(ctx)
+			if err != nil {
+				return copied, vterrors.Wrapf(err, "cannot list the files of the copy of backup %v/%v", dir, bh.Name())
+			}
+			if isCompleteBackup(files) {
+				continue
+			}
+			logger.Infof("Removing the incomplete copy of backup %v/%v", dir, bh.Name())
+			if err := dst.RemoveBackup(ctx, dir, bh.Name()); err != nil {
+				return copied, vterrors.Wrapf(err, "cannot remove the incomplete copy of backup %v/%v", dir, bh.Name())
+			}
+		}
+		logger.Infof("Copying backup %v/%v", dir, bh.Name())
+		if err := CopyBackup(ctx, bh, dst); err != nil {
+			return copied, err
+		}
+		copied = append(copied, bh.Name())
+	}
+	return copied, nil
+}
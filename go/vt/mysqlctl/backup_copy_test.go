@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func writeTestBackup(t *testing.T, bs backupstorage.BackupStorage, dir, name string, files ...string) {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	for _, file := range files {
+		wc, err := bh.AddFile(ctx, file, backupstorage.FileSizeUnknown)
+		if err != nil {
+			t.Fatalf("AddFile failed: %v", err)
+		}
+		if _, err := wc.Write([]byte(name + "/" + file)); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		if err := wc.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+}
+
+func TestSyncBackups(t *testing.T) {
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	dir := "keyspace/shard"
+	srcRoot, err := ioutil.TempDir("", "synctest-src")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(srcRoot)
+	dstRoot, err := ioutil.TempDir("", "synctest-dst")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dstRoot)
+	src := filebackupstorage.NewFileBackupStorage(srcRoot)
+	dst := filebackupstorage.NewFileBackupStorage(dstRoot)
+
+	writeTestBackup(t, src, dir, "backup1", "0", "1", backupManifestFileName)
+	writeTestBackup(t, src, dir, "backup2", "0", backupManifestFileName)
+	writeTestBackup(t, src, dir, "incomplete", "0")
+	// An incomplete copy of backup2 in the destination.
+	writeTestBackup(t, dst, dir, "backup2", "0")
+
+	// A named incomplete backup is not copied.
+	if _, err := SyncBackups(ctx, src, dst, dir, []string{"incomplete"}, logger); err == nil {
+		t.Errorf("SyncBackups copied an incomplete backup")
+	}
+	if _, err := SyncBackups(ctx, src, dst, dir, []string{"unknown"}, logger); err == nil {
+		t.Errorf("SyncBackups copied an unknown backup")
+	}
+
+	copied, err := SyncBackups(ctx, src, dst, dir, []string{"backup1"}, logger)
+	if err != nil || !reflect.DeepEqual(copied, []string{"backup1"}) {
+		t.Fatalf("SyncBackups(backup1) returned %v %v", copied, err)
+	}
+	copied, err = SyncBackups(ctx, src, dst, dir, nil, logger)
+	if err != nil || !reflect.DeepEqual(copied, []string{"backup2"}) {
+		t.Fatalf("SyncBackups returned %v %v", copied, err)
+	}
+	copied, err = SyncBackups(ctx, src, dst, dir, nil, logger)
+	if err != nil || len(copied) != 0 {
+		t.Fatalf("second SyncBackups returned %v %v", copied, err)
+	}
+
+	for _, file := range []string{"backup1/0", "backup1/1", "backup1/MANIFEST", "backup2/0", "backup2/MANIFEST"} {
+		data, err := ioutil.ReadFile(path.Join(dstRoot, dir, file))
+		if err != nil || string(data) != file {
+			t.Errorf("copied file %v = %q %v", file, data, err)
+		}
+	}
+	if _, err := os.Stat(path.Join(dstRoot, dir, "incomplete")); !os.IsNotExist(err) {
+		t.Errorf("the incomplete backup was copied: %v", err)
+	}
+}
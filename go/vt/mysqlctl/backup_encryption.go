@@ -0,0 +1,427 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file encrypts the files of the backups with AES-256-GCM.
+//
+// Each backup has its own random data key, that encrypts all its files.
+// The data key is itself encrypted by a BackupKeyProvider, and stored
+// in the MANIFEST with the id of the key that encrypted it. The MANIFEST
+// is not encrypted.
+//
+// The files are encrypted in chunks, so they can be streamed:
+// - the file starts with a random 8 bytes nonce prefix.
+// - each chunk of encryptionChunkSize bytes is sealed with the nonce
+//   prefix followed by the index of the chunk. The last chunk (that
+//   can be empty) is authenticated as such, so truncated files are
+//   detected.
+
+var (
+	backupEncryptionKeyProvider = flag.String("backup_encryption_key_provider", "", "if set, the backup files are encrypted with a key of this provider (file is the only built-in provider). Restores always use the provider recorded in the MANIFEST.")
+	backupEncryptionKeyFile     = flag.String("backup_encryption_key_file", "", "for the file backup encryption key provider, the file with the keys: one '<key id> <hex encoded 32 bytes key>' per line. The last key encrypts the new backups, the previous ones are kept to restore the older backups.")
+)
+
+const (
+	// encryptionChunkSize is the size of the encrypted chunks of the files.
+	encryptionChunkSize = 64 * 1024
+	// encryptionNoncePrefixSize is the size of the random nonce
+	// prefix of each file.
+	encryptionNoncePrefixSize = 8
+	// dataKeySize is the size of the data keys (AES-256).
+	dataKeySize = 32
+)
+
+// BackupEncryption describes the encryption of a backup, in its MANIFEST.
+type BackupEncryption struct {
+	// KeyProvider is the name of the BackupKeyProvider of the key.
+	KeyProvider string
+
+	// KeyID is the id of the key that encrypted DataKey.
+	KeyID string
+
+	// DataKey is the key that encrypts the files of the backup,
+	// encrypted by the KeyID key.
+	DataKey []byte
+}
+
+// BackupKeyProvider encrypts the data keys of the backups.
+type BackupKeyProvider interface {
+	// EncryptKey encrypts a data key with the current key of the
+	// provider, and returns the id of that key.
+	EncryptKey(ctx context.Context, dataKey []byte) (keyID string, encrypted []byte, err error)
+
+	// DecryptKey decrypts a data key encrypted with the given key.
+	DecryptKey(ctx context.Context, keyID string, encrypted []byte) ([]byte, error)
+}
+
+// BackupKeyProviderMap contains the registered implementations for
+// BackupKeyProvider.
+var BackupKeyProviderMap = make(map[string]BackupKeyProvider)
+
+func getBackupKeyProvider(name string) (BackupKeyProvider, error) {
+	kp, ok := BackupKeyProviderMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown BackupKeyProvider implementation %q", name)
+	}
+	return kp, nil
+}
+
+// maybeEncryptBackup returns a BackupHandle that encrypts the files
+// added to bh with a new data key, if -backup_encryption_key_provider
+// is set. Otherwise it returns bh.
+func maybeEncryptBackup(ctx context.Context, bh backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
+	if *backupEncryptionKeyProvider == "" {
+		return bh, nil
+	}
+	kp, err := getBackupKeyProvider(*backupEncryptionKeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	keyID, encrypted, err := kp.EncryptKey(ctx, dataKey)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot encrypt the data key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupHandle{
+		BackupHandle: bh,
+		aead:         aead,
+		encryption: &BackupEncryption{
+			KeyProvider: *backupEncryptionKeyProvider,
+			KeyID:       keyID,
+			DataKey:     encrypted,
+		},
+	}, nil
+}
+
+// maybeDecryptBackup returns a BackupHandle that decrypts the files
+// read from bh, if the backup is encrypted. Otherwise it returns bh.
+func maybeDecryptBackup(ctx context.Context, bh backupstorage.BackupHandle, encryption *BackupEncryption) (backupstorage.BackupHandle, error) {
+	if encryption == nil {
+		return bh, nil
+	}
+	kp, err := getBackupKeyProvider(encryption.KeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := kp.DecryptKey(ctx, encryption.KeyID, encryption.DataKey)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot decrypt the data key of backup %v", bh.Name())
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupHandle{
+		BackupHandle: bh,
+		aead:         aead,
+		encryption:   encryption,
+	}, nil
+}
+
+// GetBackupEncryption returns the encryption of the backup, to record in
+// its MANIFEST, or nil if it is not encrypted.
+func GetBackupEncryption(bh backupstorage.BackupHandle) *BackupEncryption {
+	if ebh, ok := bh.(*encryptedBackupHandle); ok {
+		return ebh.encryption
+	}
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptedBackupHandle encrypts the files added to a BackupHandle,
+// and decrypts the files read from it, except the MANIFEST.
+type encryptedBackupHandle struct {
+	backupstorage.BackupHandle
+	aead       cipher.AEAD
+	encryption *BackupEncryption
+}
+
+// AddFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	wc, err := ebh.BackupHandle.AddFile(ctx, filename, filesize)
+	if err != nil || filename == backupManifestFileName {
+		return wc, err
+	}
+	prefix := make([]byte, encryptionNoncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		wc.Close()
+		return nil, err
+	}
+	if _, err := wc.Write(prefix); err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return &encryptingWriter{
+		wc:     wc,
+		aead:   ebh.aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := ebh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil || filename == backupManifestFileName {
+		return rc, err
+	}
+	prefix := make([]byte, encryptionNoncePrefixSize)
+	if _, err := io.ReadFull(rc, prefix); err != nil {
+		rc.Close()
+		return nil, vterrors.Wrapf(err, "cannot read the header of encrypted file %v", filename)
+	}
+	return &decryptingReader{
+		rc:     rc,
+		source: bufio.NewReaderSize(rc, encryptionChunkSize+ebh.aead.Overhead()+1),
+		aead:   ebh.aead,
+		prefix: prefix,
+	}, nil
+}
+
+// chunkNonce returns the nonce of a chunk of a file.
+func chunkNonce(aead cipher.AEAD, prefix []byte, index uint32) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(prefix):], index)
+	return nonce
+}
+
+// chunkAdditionalData authenticates whether a chunk is the last one.
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encryptingWriter encrypts a file in chunks.
+type encryptingWriter struct {
+	wc     io.WriteCloser
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+}
+
+// Write is part of the io.Writer interface.
+func (w *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// The buffer is only sealed when more data comes, so the
+		// last chunk is sealed as such by Close.
+		if len(w.buf) == encryptionChunkSize {
+			if err := w.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := encryptionChunkSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (w *encryptingWriter) seal(last bool) error {
+	sealed := w.aead.Seal(nil, chunkNonce(w.aead, w.prefix, w.index), w.buf, chunkAdditionalData(last))
+	w.index++
+	w.buf = w.buf[:0]
+	_, err := w.wc.Write(sealed)
+	return err
+}
+
+// Close is part of the io.Closer interface.
+func (w *encryptingWriter) Close() error {
+	if err := w.seal(true); err != nil {
+		w.wc.Close()
+		return err
+	}
+	return w.wc.Close()
+}
+
+// decryptingReader decrypts a file encrypted by encryptingWriter.
+type decryptingReader struct {
+	rc     io.ReadCloser
+	source *bufio.Reader
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+	done   bool
+}
+
+// Read is part of the io.Reader interface.
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) open() error {
+	sealed := make([]byte, encryptionChunkSize+r.aead.Overhead())
+	n, err := io.ReadFull(r.source, sealed)
+	switch err {
+	case nil:
+		// A full chunk: it is the last one if nothing follows.
+		if _, err := r.source.Peek(1); err == io.EOF {
+			r.done = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		r.done = true
+	case io.EOF:
+		return vterrors.New(vtrpc.Code_DATA_LOSS, "encrypted file is truncated")
+	default:
+		return err
+	}
+	buf, err := r.aead.Open(nil, chunkNonce(r.aead, r.prefix, r.index), sealed[:n], chunkAdditionalData(r.done))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot decrypt chunk %v", r.index)
+	}
+	r.index++
+	r.buf = buf
+	return nil
+}
+
+// Close is part of the io.Closer interface.
+func (r *decryptingReader) Close() error {
+	return r.rc.Close()
+}
+
+// fileKeyProvider is the BackupKeyProvider with the keys in the
+// -backup_encryption_key_file file. The file is read each time, so
+// new keys can be added without restarting.
+type fileKeyProvider struct{}
+
+// readKeys returns the keys of the file by id, and the id of the last one.
+func (fileKeyProvider) readKeys() (map[string][]byte, string, error) {
+	if *backupEncryptionKeyFile == "" {
+		return nil, "", fmt.Errorf("-backup_encryption_key_file is not set")
+	}
+	data, err := ioutil.ReadFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, "", err
+	}
+	keys := make(map[string][]byte)
+	last := ""
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, "", fmt.Errorf("%v:%v: expected '<key id> <hex encoded key>'", *backupEncryptionKeyFile, i+1)
+		}
+		key, err := hex.DecodeString(fields[1])
+		if err != nil || len(key) != dataKeySize {
+			return nil, "", fmt.Errorf("%v:%v: the key must be %v hex encoded bytes", *backupEncryptionKeyFile, i+1, dataKeySize)
+		}
+		keys[fields[0]] = key
+		last = fields[0]
+	}
+	if last == "" {
+		return nil, "", fmt.Errorf("no key in %v", *backupEncryptionKeyFile)
+	}
+	return keys, last, nil
+}
+
+// EncryptKey is part of the BackupKeyProvider interface.
+func (kp fileKeyProvider) EncryptKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	keys, keyID, err := kp.readKeys()
+	if err != nil {
+		return "", nil, err
+	}
+	aead, err := newAEAD(keys[keyID])
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return keyID, aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+// DecryptKey is part of the BackupKeyProvider interface.
+func (kp fileKeyProvider) DecryptKey(ctx context.Context, keyID string, encrypted []byte) ([]byte, error) {
+	keys, _, err := kp.readKeys()
+	if err != nil {
+		return nil, err
+	}
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("no key %v in %v", keyID, *backupEncryptionKeyFile)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted data key is too short")
+	}
+	return aead.Open(nil, encrypted[:aead.NonceSize()], encrypted[aead.NonceSize():], nil)
+}
+
+func init() {
+	BackupKeyProviderMap["file"] = fileKeyProvider{}
+}
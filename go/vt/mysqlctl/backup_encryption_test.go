@@ -0,0 +1,247 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const (
+	encryptionTestKey1 = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	encryptionTestKey2 = "1f1e1d1c1b1a191817161514131211100f0e0d0c0b0a09080706050403020100"
+)
+
+// setupEncryptionTest uses a file backup storage and key file
+// in a temporary directory, and returns the directory.
+func setupEncryptionTest(t *testing.T) string {
+	t.Helper()
+	root, err := ioutil.TempDir("", "backupencryptiontest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*backupEncryptionKeyProvider = "file"
+	*backupEncryptionKeyFile = path.Join(root, "keys")
+	writeEncryptionTestKeys(t, "key1 "+encryptionTestKey1)
+	return root
+}
+
+func cleanupEncryptionTest(root string) {
+	*backupstorage.BackupStorageImplementation = ""
+	*backupEncryptionKeyProvider = ""
+	*backupEncryptionKeyFile = ""
+	os.RemoveAll(root)
+}
+
+func writeEncryptionTestKeys(t *testing.T, lines ...string) {
+	t.Helper()
+	if err := ioutil.WriteFile(*backupEncryptionKeyFile, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// encryptionTestFiles returns files of sizes around the chunk size.
+func encryptionTestFiles() map[string][]byte {
+	files := make(map[string][]byte)
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, encryptionChunkSize + 1, 3*encryptionChunkSize + 17} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i % 251)
+		}
+		files[fmt.Sprintf("file-%v", size)] = data
+	}
+	return files
+}
+
+// writeEncryptedBackup writes an encrypted backup with the files,
+// and a plain MANIFEST with the encryption.
+func writeEncryptedBackup(t *testing.T, name string, files map[string][]byte) *BackupEncryption {
+	t.Helper()
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bh, err := bs.StartBackup(ctx, "ks/0", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bh, err = maybeEncryptBackup(ctx, bh); err != nil {
+		t.Fatal(err)
+	}
+	for filename, data := range files {
+		wc, err := bh.AddFile(ctx, filename, int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Write in several calls, not aligned with the chunks.
+		for len(data) > 0 {
+			n := 1000
+			if n > len(data) {
+				n = len(data)
+			}
+			if _, err := wc.Write(data[:n]); err != nil {
+				t.Fatal(err)
+			}
+			data = data[n:]
+		}
+		if err := wc.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wc.Write([]byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return GetBackupEncryption(bh)
+}
+
+func readEncryptedBackupFile(t *testing.T, name, filename string, encryption *BackupEncryption) ([]byte, error) {
+	t.Helper()
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		bh, err := maybeDecryptBackup(ctx, bh, encryption)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := bh.ReadFile(ctx, filename)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	t.Fatalf("no backup %v", name)
+	return nil, nil
+}
+
+func TestBackupEncryption(t *testing.T) {
+	root := setupEncryptionTest(t)
+	defer cleanupEncryptionTest(root)
+
+	files := encryptionTestFiles()
+	encryption := writeEncryptedBackup(t, "backup1", files)
+	if encryption == nil || encryption.KeyProvider != "file" || encryption.KeyID != "key1" {
+		t.Fatalf("GetBackupEncryption() = %+v, want key1 of the file provider", encryption)
+	}
+	for filename, want := range files {
+		got, err := readEncryptedBackupFile(t, "backup1", filename, encryption)
+		if err != nil {
+			t.Errorf("cannot read %v: %v", filename, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%v was not decrypted correctly", filename)
+		}
+	}
+
+	// The files are encrypted, but not the MANIFEST.
+	stored, err := ioutil.ReadFile(path.Join(root, "backups", "ks/0", "backup1", "file-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != encryptionNoncePrefixSize+1+16 {
+		t.Errorf("encrypted file-1 has %v bytes, want %v", len(stored), encryptionNoncePrefixSize+1+16)
+	}
+	if got, err := readEncryptedBackupFile(t, "backup1", backupManifestFileName, encryption); err != nil || string(got) != "{}" {
+		t.Errorf("MANIFEST = %q, %v, want {}", got, err)
+	}
+
+	// A new key is used for the new backups, the old ones can
+	// still be restored.
+	writeEncryptionTestKeys(t, "key1 "+encryptionTestKey1, "key2 "+encryptionTestKey2)
+	encryption2 := writeEncryptedBackup(t, "backup2", files)
+	if encryption2.KeyID != "key2" || bytes.Equal(encryption2.DataKey, encryption.DataKey) {
+		t.Errorf("GetBackupEncryption() = %+v, want a new data key encrypted with key2", encryption2)
+	}
+	if _, err := readEncryptedBackupFile(t, "backup1", "file-1", encryption); err != nil {
+		t.Errorf("cannot read backup1 after the key rotation: %v", err)
+	}
+
+	// Without the key, the backup cannot be restored.
+	writeEncryptionTestKeys(t, "key2 "+encryptionTestKey2)
+	if _, err := readEncryptedBackupFile(t, "backup1", "file-1", encryption); err == nil || !strings.Contains(err.Error(), "no key key1") {
+		t.Errorf("readEncryptedBackupFile() without the key = %v, want no key key1", err)
+	}
+}
+
+func TestBackupEncryptionTampering(t *testing.T) {
+	root := setupEncryptionTest(t)
+	defer cleanupEncryptionTest(root)
+
+	filename := fmt.Sprintf("file-%v", 3*encryptionChunkSize+17)
+	files := map[string][]byte{filename: encryptionTestFiles()[filename]}
+	encryption := writeEncryptedBackup(t, "backup1", files)
+	storedPath := path.Join(root, "backups", "ks/0", "backup1", filename)
+	stored, err := ioutil.ReadFile(storedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := []struct {
+		desc string
+		data []byte
+	}{{
+		desc: "modified",
+		data: append(append([]byte{}, stored[:100]...), append([]byte{stored[100] ^ 1}, stored[101:]...)...),
+	}, {
+		desc: "truncated after a chunk",
+		data: stored[:encryptionNoncePrefixSize+2*(encryptionChunkSize+16)],
+	}, {
+		desc: "truncated in a chunk",
+		data: stored[:len(stored)-5],
+	}}
+	for _, tcase := range table {
+		if err := ioutil.WriteFile(storedPath, tcase.data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := readEncryptedBackupFile(t, "backup1", filename, encryption); err == nil {
+			t.Errorf("%v: reading the file should have failed", tcase.desc)
+		}
+	}
+}
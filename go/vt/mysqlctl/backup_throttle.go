@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// This file throttles the backups and restores: the data read and
+// written by all the backups and restores of the process share one
+// byte rate limit, which can be changed while they run.
+
+// backupRateBurst is the most bytes read or written at once when the
+// rate is limited.
+const backupRateBurst = 256 * 1024
+
+var (
+	// backupMaxRate is the initial rate limit of the backups and restores.
+	backupMaxRate = flag.Int64("backup_max_rate", 0, "if set, the backups and restores read and write at most this many bytes per second in total, to limit their impact on the disks and the network of the serving tablets. 0 means no limit. It can be changed at runtime with the SetBackupMaxRate command.")
+
+	backupLimiterOnce sync.Once
+	backupLimiter     *rate.Limiter
+)
+
+func getBackupLimiter() *rate.Limiter {
+	backupLimiterOnce.Do(func() {
+		backupLimiter = rate.NewLimiter(backupRateLimit(*backupMaxRate), backupRateBurst)
+	})
+	return backupLimiter
+}
+
+func backupRateLimit(maxRate int64) rate.Limit {
+	if maxRate <= 0 {
+		return rate.Inf
+	}
+	return rate.Limit(maxRate)
+}
+
+// BackupMaxRate returns the current rate limit of the backups and
+// restores, in bytes per second. 0 means no limit.
+func BackupMaxRate() int64 {
+	limit := getBackupLimiter().Limit()
+	if limit == rate.Inf {
+		return 0
+	}
+	return int64(limit)
+}
+
+// SetBackupMaxRate changes the rate limit of the backups and restores,
+// including the running ones. 0 means no limit.
+func SetBackupMaxRate(maxRate int64) {
+	getBackupLimiter().SetLimit(backupRateLimit(maxRate))
+}
+
+// throttledReader is a reader limited by the backup rate.
+type throttledReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// NewThrottledReader returns a reader limited by the backup rate.
+func NewThrottledReader(ctx context.Context, r io.Reader) io.Reader {
+	return &throttledReader{ctx: ctx, r: r}
+}
+
+func (tr *throttledReader) Read(p []byte) (int, error) {
+	if len(p) > backupRateBurst {
+		p = p[:backupRateBurst]
+	}
+	n, err := tr.r.Read(p)
+	if n > 0 {
+		if waitErr := getBackupLimiter().WaitN(tr.ctx, n); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+	return n, err
+}
+
+// throttledWriter is a writer limited by the backup rate.
+type throttledWriter struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+// NewThrottledWriter returns a writer limited by the backup rate.
+func NewThrottledWriter(ctx context.Context, w io.Writer) io.Writer {
+	return &throttledWriter{ctx: ctx, w: w}
+}
+
+func (tw *throttledWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > backupRateBurst {
+			chunk = chunk[:backupRateBurst]
+		}
+		if err := getBackupLimiter().WaitN(tw.ctx, len(chunk)); err != nil {
+			return written, err
+		}
+		n, err := tw.w.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[len(chunk):]
+	}
+	return written, nil
+}
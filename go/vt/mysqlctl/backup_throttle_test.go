@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestBackupMaxRate(t *testing.T) {
+	defer SetBackupMaxRate(BackupMaxRate())
+
+	SetBackupMaxRate(1024)
+	if got := BackupMaxRate(); got != 1024 {
+		t.Errorf("BackupMaxRate() = %v, want 1024", got)
+	}
+	SetBackupMaxRate(0)
+	if got := BackupMaxRate(); got != 0 {
+		t.Errorf("BackupMaxRate() = %v, want 0", got)
+	}
+}
+
+func TestThrottledReaderWriter(t *testing.T) {
+	defer SetBackupMaxRate(BackupMaxRate())
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("0123456789"), 3*backupRateBurst/10)
+
+	// Without limit, the data goes through unchanged.
+	SetBackupMaxRate(0)
+	got, err := ioutil.ReadAll(NewThrottledReader(ctx, bytes.NewReader(data)))
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("throttled read got %v bytes, %v, want %v bytes", len(got), err, len(data))
+	}
+	var buf bytes.Buffer
+	if n, err := NewThrottledWriter(ctx, &buf).Write(data); err != nil || n != len(data) || !bytes.Equal(buf.Bytes(), data) {
+		t.Fatalf("throttled write got %v, %v, want %v", n, err, len(data))
+	}
+
+	// With a limit, the writes wait for the limiter, and stop with
+	// the context.
+	SetBackupMaxRate(backupRateBurst)
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	buf.Reset()
+	start := time.Now()
+	n, err := NewThrottledWriter(ctx, &buf).Write(data)
+	if err == nil {
+		t.Errorf("throttled write of %v bytes at %v bytes per second didn't time out", len(data), backupRateBurst)
+	}
+	if n > 2*backupRateBurst {
+		t.Errorf("throttled write wrote %v bytes, want at most %v", n, 2*backupRateBurst)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("throttled write took %v", elapsed)
+	}
+}
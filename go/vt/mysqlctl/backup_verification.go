@@ -0,0 +1,256 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file verifies that backups can be restored: a backup is restored
+// into a scratch mysqld, its tables are checked, and their row counts
+// are compared with the ones recorded in the MANIFEST at backup time.
+// The result is stored in the BackupStorage, in a directory next to
+// the backups of the shard.
+
+const (
+	// backupVerificationFileName is the file holding the verification.
+	backupVerificationFileName = "VERIFICATION"
+
+	// BackupVerificationPassed is the status of a restorable backup.
+	BackupVerificationPassed = "passed"
+
+	// BackupVerificationFailed is the status of a backup with problems.
+	BackupVerificationFailed = "failed"
+)
+
+var (
+	// backupRecordRowCounts makes the backup record the row counts of the tables.
+	backupRecordRowCounts = flag.Bool("backup_record_row_counts", false, "if set, the builtin backup engine records the row count of each table in the MANIFEST, to be compared when the backup is verified. Counting the rows reads all the tables before the backup. The backups of a master don't record them, as it may still take writes.")
+)
+
+// BackupVerification is the result of the verification of a backup.
+type BackupVerification struct {
+	// Status is BackupVerificationPassed or BackupVerificationFailed.
+	Status string
+
+	// VerifiedTime is when the backup was verified (RFC 3339 format, UTC).
+	VerifiedTime string
+
+	// Errors are the problems found, if the verification failed.
+	Errors []string `json:",omitempty"`
+}
+
+// GetBackupVerificationDir returns the BackupStorage directory holding
+// the verifications of the backups of a shard. The verification of a
+// backup has the name of the backup in this directory.
+func GetBackupVerificationDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v-verifications", keyspace, shard)
+}
+
+// userTables returns the user tables of mysqld, as (db, table) pairs.
+// The Vitess tables in _vt are not user tables, they are updated
+// by the restore itself.
+func userTables(ctx context.Context, mysqld MysqlDaemon) ([][2]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SELECT table_schema, table_name FROM information_schema.tables WHERE table_type = 'BASE TABLE' AND table_schema NOT IN ('mysql', 'information_schema', 'performance_schema', 'sys', '_vt') ORDER BY table_schema, table_name")
+	if err != nil {
+		return nil, err
+	}
+	tables := make([][2]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		tables = append(tables, [2]string{row[0].ToString(), row[1].ToString()})
+	}
+	return tables, nil
+}
+
+func tableID(table [2]string) string {
+	return sqlescape.EscapeID(table[0]) + "." + sqlescape.EscapeID(table[1])
+}
+
+// countTableRows returns the row count of each user table, by db.table.
+func countTableRows(ctx context.Context, mysqld MysqlDaemon) (map[string]int64, error) {
+	tables, err := userTables(ctx, mysqld)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list the tables")
+	}
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		qr, err := mysqld.FetchSuperQuery(ctx, "SELECT COUNT(*) FROM "+tableID(table))
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't count the rows of %v.%v", table[0], table[1])
+		}
+		if len(qr.Rows) != 1 {
+			return nil, fmt.Errorf("unexpected result counting the rows of %v.%v: %v", table[0], table[1], qr.Rows)
+		}
+		count, err := sqltypes.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return nil, err
+		}
+		counts[table[0]+"."+table[1]] = count
+	}
+	return counts, nil
+}
+
+// VerifyRestoredBackup verifies the tables of a backup restored into
+// a running mysqld: each table is checked with CHECK TABLE, and its row
+// count is compared with the one recorded in the MANIFEST, if any.
+func VerifyRestoredBackup(ctx context.Context, mysqld MysqlDaemon, bm *BackupManifest) *BackupVerification {
+	var errors []string
+	tables, err := userTables(ctx, mysqld)
+	if err != nil {
+		errors = append(errors, fmt.Sprintf("can't list the tables: %v", err))
+	}
+	found := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		name := table[0] + "." + table[1]
+		found[name] = true
+
+		qr, err := mysqld.FetchSuperQuery(ctx, "CHECK TABLE "+tableID(table))
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("can't check %v: %v", name, err))
+			continue
+		}
+		for _, row := range namedRows(qr) {
+			if row["Msg_type"] == "error" || (row["Msg_type"] == "status" && row["Msg_text"] != "OK") {
+				errors = append(errors, fmt.Sprintf("CHECK TABLE %v: %v %v", name, row["Msg_type"], row["Msg_text"]))
+			}
+		}
+
+		want, ok := bm.RowCounts[name]
+		if !ok {
+			continue
+		}
+		qr, err = mysqld.FetchSuperQuery(ctx, "SELECT COUNT(*) FROM "+tableID(table))
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("can't count the rows of %v: %v", name, err))
+			continue
+		}
+		if len(qr.Rows) != 1 {
+			errors = append(errors, fmt.Sprintf("unexpected result counting the rows of %v: %v", name, qr.Rows))
+			continue
+		}
+		got, err := sqltypes.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("can't count the rows of %v: %v", name, err))
+			continue
+		}
+		if got != want {
+			errors = append(errors, fmt.Sprintf("%v has %v rows, the MANIFEST has %v", name, got, want))
+		}
+	}
+	for name := range bm.RowCounts {
+		if !found[name] {
+			errors = append(errors, fmt.Sprintf("%v is missing", name))
+		}
+	}
+
+	v := &BackupVerification{
+		Status:       BackupVerificationPassed,
+		VerifiedTime: time.Now().UTC().Format(time.RFC3339),
+		Errors:       errors,
+	}
+	if len(errors) > 0 {
+		v.Status = BackupVerificationFailed
+	}
+	return v
+}
+
+// WriteBackupVerification records the verification of a backup in the
+// BackupStorage, replacing any previous verification of the backup.
+func WriteBackupVerification(ctx context.Context, keyspace, shard, name string, v *BackupVerification) (finalErr error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	dir := GetBackupVerificationDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, bh := range bhs {
+		if bh.Name() == name {
+			if err := bs.RemoveBackup(ctx, dir, name); err != nil {
+				return vterrors.Wrapf(err, "can't remove the previous verification of %v", name)
+			}
+		}
+	}
+
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	defer func() {
+		if finalErr != nil {
+			bh.AbortBackup(ctx)
+			return
+		}
+		finalErr = bh.EndBackup(ctx)
+	}()
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupVerificationFileName)
+	}
+	wc, err := bh.AddFile(ctx, backupVerificationFileName, int64(len(data)))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupVerificationFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupVerificationFileName)
+	}
+	return wc.Close()
+}
+
+// GetBackupVerifications returns the verifications of the backups of a
+// shard, by backup name. Backups which were never verified are not in
+// the result.
+func GetBackupVerifications(ctx context.Context, keyspace, shard string) (map[string]*BackupVerification, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, GetBackupVerificationDir(keyspace, shard))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	result := make(map[string]*BackupVerification, len(bhs))
+	for _, bh := range bhs {
+		rc, err := bh.ReadFile(ctx, backupVerificationFileName)
+		if err != nil {
+			// The verification was interrupted.
+			continue
+		}
+		v := &BackupVerification{}
+		err = json.NewDecoder(rc).Decode(v)
+		rc.Close()
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't decode the verification of %v", bh.Name())
+		}
+		result[bh.Name()] = v
+	}
+	return result, nil
+}
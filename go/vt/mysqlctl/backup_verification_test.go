@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// verificationMysqld fakes the tables of a restored backup.
+type verificationMysqld struct {
+	MysqlDaemon
+
+	// rowCounts are the row counts of the tables, by db.table.
+	rowCounts map[string]int64
+	// corrupted are the tables failing CHECK TABLE.
+	corrupted map[string]bool
+}
+
+func (m *verificationMysqld) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if strings.HasPrefix(query, "SELECT table_schema, table_name FROM information_schema.tables") {
+		qr := &sqltypes.Result{Fields: []*querypb.Field{{Name: "table_schema"}, {Name: "table_name"}}}
+		for name := range m.rowCounts {
+			parts := strings.Split(name, ".")
+			qr.Rows = append(qr.Rows, []sqltypes.Value{sqltypes.NewVarChar(parts[0]), sqltypes.NewVarChar(parts[1])})
+		}
+		return qr, nil
+	}
+	for name, count := range m.rowCounts {
+		parts := strings.Split(name, ".")
+		id := fmt.Sprintf("`%v`.`%v`", parts[0], parts[1])
+		switch query {
+		case "SELECT COUNT(*) FROM " + id:
+			return &sqltypes.Result{
+				Fields: []*querypb.Field{{Name: "COUNT(*)"}},
+				Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(count)}},
+			}, nil
+		case "CHECK TABLE " + id:
+			status := "OK"
+			if m.corrupted[name] {
+				status = "Corrupt"
+			}
+			return &sqltypes.Result{
+				Fields: []*querypb.Field{{Name: "Table"}, {Name: "Op"}, {Name: "Msg_type"}, {Name: "Msg_text"}},
+				Rows: [][]sqltypes.Value{
+					{sqltypes.NewVarChar(name), sqltypes.NewVarChar("check"), sqltypes.NewVarChar("status"), sqltypes.NewVarChar(status)},
+				},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query: %v", query)
+}
+
+func TestVerifyRestoredBackup(t *testing.T) {
+	ctx := context.Background()
+	mysqld := &verificationMysqld{
+		rowCounts: map[string]int64{
+			"vt_ks.t1": 10,
+			"vt_ks.t2": 20,
+		},
+	}
+	rowCounts, err := countTableRows(ctx, mysqld)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rowCounts, mysqld.rowCounts) {
+		t.Errorf("countTableRows() = %v, want %v", rowCounts, mysqld.rowCounts)
+	}
+
+	table := []struct {
+		desc       string
+		rowCounts  map[string]int64
+		corrupted  map[string]bool
+		wantErrors []string
+	}{{
+		desc:      "passed",
+		rowCounts: map[string]int64{"vt_ks.t1": 10, "vt_ks.t2": 20},
+	}, {
+		desc:       "missing rows",
+		rowCounts:  map[string]int64{"vt_ks.t1": 10, "vt_ks.t2": 19},
+		wantErrors: []string{"vt_ks.t2 has 19 rows, the MANIFEST has 20"},
+	}, {
+		desc:       "missing table",
+		rowCounts:  map[string]int64{"vt_ks.t1": 10},
+		wantErrors: []string{"vt_ks.t2 is missing"},
+	}, {
+		desc:       "corrupted table",
+		rowCounts:  map[string]int64{"vt_ks.t1": 10, "vt_ks.t2": 20},
+		corrupted:  map[string]bool{"vt_ks.t1": true},
+		wantErrors: []string{"CHECK TABLE vt_ks.t1: status Corrupt"},
+	}}
+	for _, tcase := range table {
+		mysqld.rowCounts = tcase.rowCounts
+		mysqld.corrupted = tcase.corrupted
+		v := VerifyRestoredBackup(ctx, mysqld, &BackupManifest{RowCounts: rowCounts})
+		wantStatus := BackupVerificationPassed
+		if len(tcase.wantErrors) > 0 {
+			wantStatus = BackupVerificationFailed
+		}
+		if v.Status != wantStatus || !reflect.DeepEqual(v.Errors, tcase.wantErrors) {
+			t.Errorf("%v: VerifyRestoredBackup() = %v %v, want %v %v", tcase.desc, v.Status, v.Errors, wantStatus, tcase.wantErrors)
+		}
+	}
+}
+
+func TestBackupVerifications(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupverificationtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	ctx := context.Background()
+	if got, err := GetBackupVerifications(ctx, "ks", "0"); err != nil || len(got) != 0 {
+		t.Errorf("GetBackupVerifications() = %v, %v, want none", got, err)
+	}
+
+	failed := &BackupVerification{
+		Status:       BackupVerificationFailed,
+		VerifiedTime: "2019-10-01T12:00:00Z",
+		Errors:       []string{"vt_ks.t1 is missing"},
+	}
+	passed := &BackupVerification{
+		Status:       BackupVerificationPassed,
+		VerifiedTime: "2019-10-01T13:00:00Z",
+	}
+	if err := WriteBackupVerification(ctx, "ks", "0", "backup1", failed); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteBackupVerification(ctx, "ks", "0", "backup2", failed); err != nil {
+		t.Fatal(err)
+	}
+	// A new verification replaces the previous one.
+	if err := WriteBackupVerification(ctx, "ks", "0", "backup2", passed); err != nil {
+		t.Fatal(err)
+	}
+	got, err := GetBackupVerifications(ctx, "ks", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*BackupVerification{
+		"backup1": failed,
+		"backup2": passed,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetBackupVerifications() = %v, want %v", got, want)
+	}
+}
@@ -38,7 +38,7 @@ import (
 
 var (
 	// BackupEngineImplementation is the implementation to use for BackupEngine
-	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin or xtrabackup). Restores will always be done with whichever engine created a given backup.")
+	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin, xtrabackup or logical). Restores will always be done with whichever engine created a given backup.")
 )
 
 // BackupEngine is the interface to take a backup with a given engine.
@@ -92,6 +92,19 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToTime: if non-zero, restore the most recent backup that finished
+	// before this time, and replay the archived binlogs until this time.
+	RestoreToTime time.Time
+	// RestoreToPos: if non-zero, restore the most recent backup taken at or
+	// before this position, and replay the transactions of this position
+	// from the archived binlogs.
+	RestoreToPos mysql.Position
+	// BackupName: if set, restore this backup instead of finding
+	// the most recent one.
+	BackupName string
+	// Tables: if set, only restore these tables of DbName, if the
+	// engine supports it. The other engines restore all the tables.
+	Tables []string
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -148,14 +161,36 @@ func GetRestoreEngine(ctx context.Context, backup backupstorage.BackupHandle) (R
 // GetBackupManifest returns the common fields of the MANIFEST file for a given backup.
 func GetBackupManifest(ctx context.Context, backup backupstorage.BackupHandle) (*BackupManifest, error) {
 	manifest := &BackupManifest{}
-	if err := getBackupManifestInto(ctx, backup, manifest); err != nil {
+	if err := GetBackupManifestInto(ctx, backup, manifest); err != nil {
 		return nil, err
 	}
 	return manifest, nil
 }
 
-// getBackupManifestInto fetches and decodes a MANIFEST file into the specified object.
-func getBackupManifestInto(ctx context.Context, backup backupstorage.BackupHandle, outManifest interface{}) error {
+// WriteBackupManifest JSON-encodes a MANIFEST and adds it to a backup.
+// Backup engines must write the MANIFEST last, after all the files.
+func WriteBackupManifest(ctx context.Context, bh backupstorage.BackupHandle, manifest interface{}) (finalErr error) {
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return nil
+}
+
+// GetBackupManifestInto fetches and decodes a MANIFEST file into the specified object.
+func GetBackupManifestInto(ctx context.Context, backup backupstorage.BackupHandle, outManifest interface{}) error {
 	file, err := backup.ReadFile(ctx, backupManifestFileName)
 	if err != nil {
 		return vterrors.Wrap(err, "can't read MANIFEST")
@@ -188,11 +223,30 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Encryption is how the files of the backup are encrypted, if they are.
+	Encryption *BackupEncryption
+
+	// CompressionCodec is the name of the codec which compressed the files
+	// of the backup. It is empty for backups taken before the codecs were
+	// recorded, which were compressed with gzip, if at all.
+	CompressionCodec string `json:",omitempty"`
+
+	// Parent is the name of the backup this incremental backup was taken
+	// on top of, in the same directory. It is empty for full backups.
+	// All the ancestors of a backup are needed to restore it.
+	Parent string `json:",omitempty"`
+
+	// RowCounts is the number of rows of each table, by db.table, when
+	// the backup was taken. It is only recorded with -backup_record_row_counts,
+	// and is compared with the restored tables to verify the backup.
+	RowCounts map[string]int64 `json:",omitempty"`
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
 // It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// MANIFEST file. For a point in time restore, the backup must also be
+// before the restore point.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
@@ -202,6 +256,9 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 
 	for index = len(bhs) - 1; index >= 0; index-- {
 		bh = bhs[index]
+		if params.BackupName != "" && bh.Name() != params.BackupName {
+			continue
+		}
 		// Check that the backup MANIFEST exists and can be successfully decoded.
 		bm, err := GetBackupManifest(ctx, bh)
 		if err != nil {
@@ -217,15 +274,23 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
-		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
-			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
-			break
+		if checkBackupTime /* snapshot */ && backupTime.After(params.StartTime) {
+			continue
 		}
+		if !beforeRestorePoint(params, bm) {
+			params.Logger.Infof("Restore: skipping backup %v/%v taken after the restore point", backupDir, bh.Name())
+			continue
+		}
+		params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
+		break
 	}
 	if index < 0 {
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if params.isPointInTimeRestore() {
+			params.Logger.Errorf("No valid backup found before the restore point")
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -235,6 +300,26 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	return bh, nil
 }
 
+// beforeRestorePoint returns true if the backup can be restored for the
+// point in time restore, if any.
+func beforeRestorePoint(params RestoreParams, bm *BackupManifest) bool {
+	if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+		return false
+	}
+	if !params.RestoreToTime.IsZero() {
+		// Some backups don't have a FinishedTime.
+		finished := bm.FinishedTime
+		if finished == "" {
+			finished = bm.BackupTime
+		}
+		finishedTime, err := time.Parse(time.RFC3339, finished)
+		if err != nil || finishedTime.After(params.RestoreToTime) {
+			return false
+		}
+	}
+	return true
+}
+
 func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logger logutil.Logger) error {
 	// shutdown mysqld if it is running
 	logger.Infof("Restore: shutdown mysqld")
@@ -254,8 +339,9 @@ func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logge
 	return nil
 }
 
-// create restore state file
-func createStateFile(cnf *Mycnf) error {
+// CreateStateFile creates the restore state file, which marks a restore
+// in progress until the restore succeeds.
+func CreateStateFile(cnf *Mycnf) error {
 	// if we start writing content to this file:
 	// change RD_ONLY to RDWR
 	// change Create to Open
@@ -22,6 +22,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 
 	"golang.org/x/net/context"
 )
@@ -74,6 +75,10 @@ type BackupHandle interface {
 	// The context is valid for the duration of the reads, until the
 	// ReadCloser is closed.
 	ReadFile(ctx context.Context, filename string) (io.ReadCloser, error)
+
+	// ListFiles returns the names of the files of a backup, sorted.
+	// Only works for read-only backups (created by ListBackups).
+	ListFiles(ctx context.Context) ([]string, error)
 }
 
 // BackupStorage is the interface to the storage system
@@ -106,13 +111,30 @@ type BackupStorage interface {
 // BackupStorageMap contains the registered implementations for BackupStorage
 var BackupStorageMap = make(map[string]BackupStorage)
 
+// BackupStorageFactoryMap contains the registered factories of BackupStorage
+// instances which take a parameter, like the root directory of the file
+// implementation. They are named "<implementation>:<parameter>", so that
+// several instances of one implementation can be used at once.
+var BackupStorageFactoryMap = make(map[string]func(param string) (BackupStorage, error))
+
 // GetBackupStorage returns the current BackupStorage implementation.
 // Should be called after flags have been initialized.
 // When all operations are done, call BackupStorage.Close() to free resources.
 func GetBackupStorage() (BackupStorage, error) {
-	bs, ok := BackupStorageMap[*BackupStorageImplementation]
-	if !ok {
-		return nil, fmt.Errorf("no registered implementation of BackupStorage")
+	return GetBackupStorageByName(*BackupStorageImplementation)
+}
+
+// GetBackupStorageByName returns the BackupStorage with the given name,
+// either a registered implementation, or "<implementation>:<parameter>"
+// for the implementations with a factory.
+func GetBackupStorageByName(name string) (BackupStorage, error) {
+	if bs, ok := BackupStorageMap[name]; ok {
+		return bs, nil
+	}
+	if parts := strings.SplitN(name, ":", 2); len(parts) == 2 {
+		if factory, ok := BackupStorageFactoryMap[parts[0]]; ok {
+			return factory(parts[1])
+		}
 	}
-	return bs, nil
+	return nil, fmt.Errorf("no registered implementation of BackupStorage named %q", name)
 }
@@ -0,0 +1,508 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	vtenv "vitess.io/vitess/go/vt/env"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file archives the binlog files of the masters to the
+// BackupStorage, and replays them on top of a backup to restore
+// a tablet to a point in time.
+//
+// Each archived binlog file is stored as a backup in the
+// GetBinlogArchiveDir directory of the shard, named after the time
+// the file was closed so the archives are sorted in time. The
+// archive contains the (compressed) binlog file, and a MANIFEST
+// with the GTIDs of the file. The archives older than the retention
+// of the archiving master are removed.
+
+const (
+	// binlogArchiveFileName is the name of the binlog file within
+	// an archive.
+	binlogArchiveFileName = "binlog"
+)
+
+// BinlogArchiveManifest is the MANIFEST of an archived binlog file.
+type BinlogArchiveManifest struct {
+	// BinlogFile is the name of the binlog file on the master.
+	BinlogFile string
+
+	// TabletAlias is the master that archived the file.
+	TabletAlias string
+
+	// PreviousPosition is the position of the master before the
+	// first transaction of the file.
+	PreviousPosition mysql.Position
+
+	// Position is the position of the master after the last
+	// transaction of the file.
+	Position mysql.Position
+
+	// EndTime is the time (in RFC 3339 format, UTC) the file was last
+	// written at. All its transactions were committed before.
+	EndTime string
+
+	// Compressed is true if the file is compressed.
+	Compressed bool
+
+	// CompressionCodec is the codec of the compressed file. It is empty
+	// for files archived before the codecs, which were gzipped.
+	CompressionCodec string `json:",omitempty"`
+
+	// Encryption is how the file is encrypted, if it is.
+	Encryption *BackupEncryption
+}
+
+// BinlogArchiveParams is the struct that holds all params passed to
+// ArchiveBinlogs.
+type BinlogArchiveParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Keyspace and Shard are used to infer the directory where
+	// the binlogs are archived.
+	Keyspace string
+	Shard    string
+	// TabletAlias is used to name the archives.
+	TabletAlias string
+	// Retention is how long the archives are kept, from the time
+	// their binlog file was closed. 0 keeps them forever.
+	Retention time.Duration
+}
+
+// GetBinlogArchiveDir returns the directory where the binlogs of the
+// given keyspace/shard are (or will be) archived. It is not within the
+// backup directory of the shard, so the archives are not listed as
+// backups.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v-binlogs", keyspace, shard)
+}
+
+// binlogArchiveName returns the name of the archive of a binlog file.
+// The binlog files are numbered again after a RESET MASTER or a
+// restore, so the name has the time the file was closed to tell
+// apart the files with the same name.
+func binlogArchiveName(endTime time.Time, tabletAlias, binlogFile string) string {
+	return fmt.Sprintf("%v.%v.%v", endTime.UTC().Format(BackupTimestampFormat), tabletAlias, binlogFile)
+}
+
+// binlogArchiveEndTime returns the time the binlog file of an archive
+// was closed, from the name of the archive.
+func binlogArchiveEndTime(name string) (time.Time, error) {
+	// The names are <date>.<time>.<tablet alias>.<binlog file>.
+	parts := strings.SplitN(name, ".", 3)
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("invalid binlog archive name %v", name)
+	}
+	return time.Parse(BackupTimestampFormat, parts[0]+"."+parts[1])
+}
+
+// ArchiveBinlogs archives the binlog files of mysqld that were not
+// archived yet, except the current one that is still written to.
+// It returns the number of archived files.
+func ArchiveBinlogs(ctx context.Context, params BinlogArchiveParams) (int, error) {
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "cannot list the binlog files")
+	}
+	if len(qr.Rows) < 2 {
+		// Only the current binlog file.
+		return 0, nil
+	}
+	masterPos, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return 0, err
+	}
+	if masterPos.GTIDSet == nil {
+		return 0, fmt.Errorf("cannot archive the binlogs without GTIDs")
+	}
+	flavor := masterPos.GTIDSet.Flavor()
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, err
+	}
+	defer bs.Close()
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	archived := make(map[string]bool)
+	for _, bh := range bhs {
+		archived[bh.Name()] = true
+	}
+
+	count := 0
+	previousPos, err := previousGTIDs(ctx, params.Mysqld, flavor, qr.Rows[0][0].ToString())
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(qr.Rows)-1; i++ {
+		binlogFile := qr.Rows[i][0].ToString()
+		pos, err := previousGTIDs(ctx, params.Mysqld, flavor, qr.Rows[i+1][0].ToString())
+		if err != nil {
+			return count, err
+		}
+		fi, err := os.Stat(binlogFilePath(params, binlogFile))
+		if err != nil {
+			return count, err
+		}
+		if !archived[binlogArchiveName(fi.ModTime(), params.TabletAlias, binlogFile)] {
+			if err := archiveBinlogFile(ctx, params, bs, binlogFile, previousPos, pos); err != nil {
+				return count, vterrors.Wrapf(err, "cannot archive binlog file %v", binlogFile)
+			}
+			count++
+		}
+		previousPos = pos
+	}
+
+	if params.Retention > 0 {
+		if err := removeOldBinlogArchives(ctx, params, bs, bhs); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// binlogFilePath returns the path of a binlog file of mysqld.
+func binlogFilePath(params BinlogArchiveParams, binlogFile string) string {
+	return filepath.Join(filepath.Dir(params.Cnf.BinLogPath), binlogFile)
+}
+
+// removeOldBinlogArchives removes the archives of the shard whose
+// binlog file was closed before the retention of params.
+func removeOldBinlogArchives(ctx context.Context, params BinlogArchiveParams, bs backupstorage.BackupStorage, bhs []backupstorage.BackupHandle) error {
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	oldest := time.Now().Add(-params.Retention)
+	for _, bh := range bhs {
+		endTime, err := binlogArchiveEndTime(bh.Name())
+		if err != nil {
+			params.Logger.Warningf("skipping binlog archive %v/%v: %v", dir, bh.Name(), err)
+			continue
+		}
+		if !endTime.Before(oldest) {
+			// The archives are sorted in time.
+			break
+		}
+		params.Logger.Infof("Removing binlog archive %v/%v, older than %v", dir, bh.Name(), params.Retention)
+		if err := bs.RemoveBackup(ctx, dir, bh.Name()); err != nil {
+			return vterrors.Wrapf(err, "cannot remove binlog archive %v/%v", dir, bh.Name())
+		}
+	}
+	return nil
+}
+
+// previousGTIDs returns the GTIDs executed before the binlog file,
+// from its Previous_gtids event.
+func previousGTIDs(ctx context.Context, mysqld MysqlDaemon, flavor, binlogFile string) (mysql.Position, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN '%v' LIMIT 2", binlogFile))
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	named := namedRows(qr)
+	for _, row := range named {
+		if row["Event_type"] == "Previous_gtids" {
+			return mysql.ParsePosition(flavor, strings.Replace(row["Info"], "\n", "", -1))
+		}
+	}
+	return mysql.Position{}, fmt.Errorf("binlog file %v has no Previous_gtids event", binlogFile)
+}
+
+// namedRows returns the rows of the result as maps of the column
+// names to the values.
+func namedRows(qr *sqltypes.Result) []map[string]string {
+	result := make([]map[string]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		named := make(map[string]string, len(row))
+		for i, field := range qr.Fields {
+			named[field.Name] = row[i].ToString()
+		}
+		result = append(result, named)
+	}
+	return result
+}
+
+// archiveBinlogFile archives one binlog file, and its MANIFEST.
+func archiveBinlogFile(ctx context.Context, params BinlogArchiveParams, bs backupstorage.BackupStorage, binlogFile string, previousPos, pos mysql.Position) (finalErr error) {
+	source, err := os.Open(binlogFilePath(params, binlogFile))
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	fi, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	name := binlogArchiveName(fi.ModTime(), params.TabletAlias, binlogFile)
+	params.Logger.Infof("Archiving binlog file %v as %v", binlogFile, name)
+	bh, err := bs.StartBackup(ctx, GetBinlogArchiveDir(params.Keyspace, params.Shard), name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	ebh, err := maybeEncryptBackup(ctx, bh)
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort the archive of %v", binlogFile)
+		}
+		return vterrors.Wrap(err, "cannot encrypt archive")
+	}
+	bh = ebh
+	defer func() {
+		if finalErr != nil {
+			if err := bh.AbortBackup(ctx); err != nil {
+				params.Logger.Errorf2(err, "failed to abort the archive of %v", binlogFile)
+			}
+			return
+		}
+		finalErr = bh.EndBackup(ctx)
+	}()
+
+	if err := writeBinlogArchiveFile(ctx, bh, source, fi.Size()); err != nil {
+		return err
+	}
+
+	manifest := &BinlogArchiveManifest{
+		BinlogFile:       binlogFile,
+		TabletAlias:      params.TabletAlias,
+		PreviousPosition: previousPos,
+		Position:         pos,
+		EndTime:          fi.ModTime().UTC().Format(time.RFC3339),
+		Compressed:       BackupCompressionCodec() != NoCompressionCodec,
+		CompressionCodec: BackupCompressionCodec(),
+		Encryption:       GetBackupEncryption(bh),
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to archive", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return wc.Close()
+}
+
+// writeBinlogArchiveFile copies the binlog file to the archive,
+// compressing it if needed, at most at the backup rate.
+func writeBinlogArchiveFile(ctx context.Context, bh backupstorage.BackupHandle, source io.Reader, size int64) error {
+	wc, err := bh.AddFile(ctx, binlogArchiveFileName, size)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to archive", binlogArchiveFileName)
+	}
+	compressor, err := NewCompressor(BackupCompressionCodec(), wc)
+	if err != nil {
+		wc.Close()
+		return vterrors.Wrap(err, "cannot create compressor")
+	}
+	if _, err := io.Copy(compressor, NewThrottledReader(ctx, source)); err != nil {
+		compressor.Close()
+		wc.Close()
+		return vterrors.Wrap(err, "cannot copy data")
+	}
+	if err := compressor.Close(); err != nil {
+		wc.Close()
+		return vterrors.Wrap(err, "cannot close compressor")
+	}
+	return wc.Close()
+}
+
+// isPointInTimeRestore returns true if the restore replays the
+// archived binlogs.
+func (params RestoreParams) isPointInTimeRestore() bool {
+	return !params.RestoreToTime.IsZero() || !params.RestoreToPos.IsZero()
+}
+
+// applyArchivedBinlogs replays the archived binlogs on top of a backup
+// taken at backupPos, until params.RestoreToTime or params.RestoreToPos.
+// It returns the position of mysqld after the replay.
+func applyArchivedBinlogs(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage, backupPos mysql.Position) (mysql.Position, error) {
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	tmpDir, err := ioutil.TempDir(params.Cnf.TmpDir, "binlogs")
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pos := backupPos
+	reached := false
+	for _, bh := range bhs {
+		if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+			reached = true
+			break
+		}
+		manifest := &BinlogArchiveManifest{}
+		if err := GetBackupManifestInto(ctx, bh, manifest); err != nil {
+			params.Logger.Warningf("Restore: skipping possibly incomplete binlog archive %v/%v: %v", dir, bh.Name(), err)
+			continue
+		}
+		if pos.AtLeast(manifest.Position) {
+			// Already applied.
+			continue
+		}
+		if !pos.AtLeast(manifest.PreviousPosition) {
+			return mysql.Position{}, fmt.Errorf("missing archived binlogs between position %v and binlog archive %v that starts at %v", pos, bh.Name(), manifest.PreviousPosition)
+		}
+		endTime, err := time.Parse(time.RFC3339, manifest.EndTime)
+		if err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "binlog archive %v has an invalid end time", bh.Name())
+		}
+
+		params.Logger.Infof("Restore: applying binlog archive %v", bh.Name())
+		bh, err = maybeDecryptBackup(ctx, bh, manifest.Encryption)
+		if err != nil {
+			return mysql.Position{}, err
+		}
+		binlogFile := filepath.Join(tmpDir, manifest.BinlogFile)
+		if err := readBinlogArchiveFile(ctx, bh, manifest, binlogFile); err != nil {
+			return mysql.Position{}, err
+		}
+		if err := params.Mysqld.ApplyBinlogFile(ctx, binlogFile, params.RestoreToPos, params.RestoreToTime); err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "cannot apply binlog archive %v", bh.Name())
+		}
+		os.Remove(binlogFile)
+		if pos, err = params.Mysqld.MasterPosition(); err != nil {
+			return mysql.Position{}, err
+		}
+
+		if !params.RestoreToTime.IsZero() && !endTime.Before(params.RestoreToTime) {
+			reached = true
+			break
+		}
+	}
+	if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+		reached = true
+	}
+	if !reached {
+		return mysql.Position{}, fmt.Errorf("the archived binlogs end at position %v, before the restore point", pos)
+	}
+	return pos, nil
+}
+
+// readBinlogArchiveFile copies the binlog file of an archive to a
+// local file, uncompressing it if needed, at most at the backup rate.
+func readBinlogArchiveFile(ctx context.Context, bh backupstorage.BackupHandle, manifest *BinlogArchiveManifest, path string) error {
+	source, err := bh.ReadFile(ctx, binlogArchiveFileName)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot read %v from archive %v", binlogArchiveFileName, bh.Name())
+	}
+	defer source.Close()
+	codec := NoCompressionCodec
+	if manifest.Compressed {
+		codec = manifest.CompressionCodec
+		if codec == "" {
+			codec = GzipCompressionCodec
+		}
+	}
+	reader, err := NewDecompressor(codec, source)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open decompressor")
+	}
+	defer reader.Close()
+
+	dst, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(NewThrottledWriter(ctx, dst), reader); err != nil {
+		dst.Close()
+		return vterrors.Wrapf(err, "cannot copy %v", path)
+	}
+	return dst.Close()
+}
+
+// ApplyBinlogFile replays the transactions of a binlog file with
+// mysqlbinlog. If restorePos is set, only its transactions are
+// replayed. If restoreTime is set, the replay stops at the first
+// transaction committed after it.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	name, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	args := []string{binlogFile}
+	if !restorePos.IsZero() {
+		args = append(args, "--include-gtids="+restorePos.GTIDSet.String())
+	}
+	if !restoreTime.IsZero() {
+		// mysqlbinlog uses the local time zone.
+		args = append(args, "--stop-datetime="+restoreTime.Local().Format("2006-01-02 15:04:05"))
+	}
+	ldPaths, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.Dba().MysqlParams()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = ldPaths
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	scriptErr := mysqld.executeMysqlScript(params, stdout)
+	// Closing the pipe stops mysqlbinlog if mysql failed.
+	stdout.Close()
+	waitErr := cmd.Wait()
+	if scriptErr != nil {
+		return scriptErr
+	}
+	if waitErr != nil {
+		return fmt.Errorf("mysqlbinlog: %v, output: %v", waitErr, stderr.String())
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const binlogArchiveTestSID = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+
+// binlogArchiveMysqld fakes the binlog files of a master, and
+// the replay of the binlog files.
+type binlogArchiveMysqld struct {
+	MysqlDaemon
+
+	// binlogs are the names of the binlog files, and previous the
+	// GTIDs executed before each of them.
+	binlogs  []string
+	previous []string
+
+	position mysql.Position
+	applied  []string
+}
+
+func (m *binlogArchiveMysqld) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if query == "SHOW BINARY LOGS" {
+		qr := &sqltypes.Result{Fields: []*querypb.Field{{Name: "Log_name"}, {Name: "File_size"}}}
+		for _, binlog := range m.binlogs {
+			qr.Rows = append(qr.Rows, []sqltypes.Value{sqltypes.NewVarChar(binlog), sqltypes.NewInt64(0)})
+		}
+		return qr, nil
+	}
+	for i, binlog := range m.binlogs {
+		if query == fmt.Sprintf("SHOW BINLOG EVENTS IN '%v' LIMIT 2", binlog) {
+			return &sqltypes.Result{
+				Fields: []*querypb.Field{{Name: "Event_type"}, {Name: "Info"}},
+				Rows: [][]sqltypes.Value{
+					{sqltypes.NewVarChar("Format_desc"), sqltypes.NewVarChar("Server ver: 5.7.26-log, Binlog ver: 4")},
+					{sqltypes.NewVarChar("Previous_gtids"), sqltypes.NewVarChar(m.previous[i])},
+				},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query: %v", query)
+}
+
+func (m *binlogArchiveMysqld) MasterPosition() (mysql.Position, error) {
+	return m.position, nil
+}
+
+func (m *binlogArchiveMysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error {
+	name := filepath.Base(binlogFile)
+	m.applied = append(m.applied, name)
+	for i, binlog := range m.binlogs {
+		if binlog == name && i+1 < len(m.previous) {
+			m.position = binlogArchiveTestPosition(m.previous[i+1])
+		}
+	}
+	return nil
+}
+
+func binlogArchiveTestPosition(gtids string) mysql.Position {
+	pos, err := mysql.ParsePosition("MySQL56", gtids)
+	if err != nil {
+		panic(err)
+	}
+	return pos
+}
+
+func TestArchiveAndApplyBinlogs(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogarchivetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	binlogDir := path.Join(root, "bin-logs")
+	if err := os.MkdirAll(binlogDir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	mysqld := &binlogArchiveMysqld{
+		binlogs: []string{"vt-bin.000001", "vt-bin.000002", "vt-bin.000003", "vt-bin.000004"},
+		previous: []string{
+			"",
+			binlogArchiveTestSID + ":1-10",
+			binlogArchiveTestSID + ":1-20",
+			binlogArchiveTestSID + ":1-30",
+		},
+		position: binlogArchiveTestPosition(binlogArchiveTestSID + ":1-35"),
+	}
+	for i, binlog := range mysqld.binlogs {
+		file := path.Join(binlogDir, binlog)
+		if err := ioutil.WriteFile(file, []byte("binlog data "+binlog), 0644); err != nil {
+			t.Fatal(err)
+		}
+		modTime := time.Date(2019, 10, 1, 12, i, 0, 0, time.UTC)
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	archiveParams := BinlogArchiveParams{
+		Cnf:         &Mycnf{BinLogPath: path.Join(binlogDir, "vt-bin")},
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "cell1-0000000100",
+	}
+	count, err := ArchiveBinlogs(ctx, archiveParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("ArchiveBinlogs() = %v, want 3", count)
+	}
+	// The archived files are not archived again.
+	if count, err = ArchiveBinlogs(ctx, archiveParams); err != nil || count != 0 {
+		t.Errorf("second ArchiveBinlogs() = %v, %v, want 0", count, err)
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bhs, err := bs.ListBackups(ctx, GetBinlogArchiveDir("ks", "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	wantNames := []string{
+		"2019-10-01.120000.cell1-0000000100.vt-bin.000001",
+		"2019-10-01.120100.cell1-0000000100.vt-bin.000002",
+		"2019-10-01.120200.cell1-0000000100.vt-bin.000003",
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("archives = %v, want %v", names, wantNames)
+	}
+
+	table := []struct {
+		desc          string
+		backupPos     string
+		restoreToTime time.Time
+		restoreToPos  string
+		want          []string
+		wantErr       string
+	}{{
+		desc:         "to a position",
+		backupPos:    binlogArchiveTestSID + ":1-5",
+		restoreToPos: binlogArchiveTestSID + ":1-15",
+		want:         []string{"vt-bin.000001", "vt-bin.000002"},
+	}, {
+		desc:          "to a time",
+		backupPos:     binlogArchiveTestSID + ":1-12",
+		restoreToTime: time.Date(2019, 10, 1, 12, 1, 30, 0, time.UTC),
+		want:          []string{"vt-bin.000002", "vt-bin.000003"},
+	}, {
+		desc:         "past the archives",
+		backupPos:    binlogArchiveTestSID + ":1-5",
+		restoreToPos: binlogArchiveTestSID + ":1-32",
+		wantErr:      "before the restore point",
+	}}
+	for _, tcase := range table {
+		mysqld.applied = nil
+		params := RestoreParams{
+			Cnf:           &Mycnf{TmpDir: root},
+			Mysqld:        mysqld,
+			Logger:        logutil.NewConsoleLogger(),
+			Keyspace:      "ks",
+			Shard:         "0",
+			RestoreToTime: tcase.restoreToTime,
+		}
+		if tcase.restoreToPos != "" {
+			params.RestoreToPos = binlogArchiveTestPosition(tcase.restoreToPos)
+		}
+		_, err := applyArchivedBinlogs(ctx, params, bs, binlogArchiveTestPosition(tcase.backupPos))
+		if tcase.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.wantErr) {
+				t.Errorf("%v: applyArchivedBinlogs() = %v, want error %v", tcase.desc, err, tcase.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: applyArchivedBinlogs() failed: %v", tcase.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(mysqld.applied, tcase.want) {
+			t.Errorf("%v: applied %v, want %v", tcase.desc, mysqld.applied, tcase.want)
+		}
+	}
+}
+
+func TestArchiveBinlogsAfterReset(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogarchivetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	binlogDir := path.Join(root, "bin-logs")
+	if err := os.MkdirAll(binlogDir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	writeBinlog := func(name string, modTime time.Time) {
+		file := path.Join(binlogDir, name)
+		if err := ioutil.WriteFile(file, []byte("binlog data "+name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mysqld := &binlogArchiveMysqld{
+		binlogs:  []string{"vt-bin.000001", "vt-bin.000002"},
+		previous: []string{"", binlogArchiveTestSID + ":1-10"},
+		position: binlogArchiveTestPosition(binlogArchiveTestSID + ":1-15"),
+	}
+	oldTime := time.Now().Add(-48 * time.Hour)
+	writeBinlog("vt-bin.000001", oldTime)
+	writeBinlog("vt-bin.000002", oldTime)
+
+	ctx := context.Background()
+	archiveParams := BinlogArchiveParams{
+		Cnf:         &Mycnf{BinLogPath: path.Join(binlogDir, "vt-bin")},
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "cell1-0000000100",
+		Retention:   24 * time.Hour,
+	}
+	if count, err := ArchiveBinlogs(ctx, archiveParams); err != nil || count != 1 {
+		t.Fatalf("ArchiveBinlogs() = %v, %v, want 1", count, err)
+	}
+
+	// After a RESET MASTER, the binlog files have the same names,
+	// but they are archived, and the old archive is removed.
+	newTime := time.Now().Add(-time.Hour)
+	writeBinlog("vt-bin.000001", newTime)
+	writeBinlog("vt-bin.000002", newTime)
+	mysqld.previous = []string{"", binlogArchiveTestSID + ":1-5"}
+	if count, err := ArchiveBinlogs(ctx, archiveParams); err != nil || count != 1 {
+		t.Fatalf("ArchiveBinlogs() after reset = %v, %v, want 1", count, err)
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bhs, err := bs.ListBackups(ctx, GetBinlogArchiveDir("ks", "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	wantNames := []string{binlogArchiveName(newTime, "cell1-0000000100", "vt-bin.000001")}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("archives = %v, want %v", names, wantNames)
+	}
+}
+
+func TestBeforeRestorePoint(t *testing.T) {
+	bm := &BackupManifest{
+		Position:     binlogArchiveTestPosition(binlogArchiveTestSID + ":1-10"),
+		BackupTime:   "2019-10-01T12:00:00Z",
+		FinishedTime: "2019-10-01T12:30:00Z",
+	}
+	table := []struct {
+		params RestoreParams
+		want   bool
+	}{
+		{RestoreParams{}, true},
+		{RestoreParams{RestoreToPos: binlogArchiveTestPosition(binlogArchiveTestSID + ":1-20")}, true},
+		{RestoreParams{RestoreToPos: binlogArchiveTestPosition(binlogArchiveTestSID + ":1-5")}, false},
+		{RestoreParams{RestoreToTime: time.Date(2019, 10, 1, 13, 0, 0, 0, time.UTC)}, true},
+		// The backup started, but didn't finish before.
+		{RestoreParams{RestoreToTime: time.Date(2019, 10, 1, 12, 15, 0, 0, time.UTC)}, false},
+	}
+	for i, tcase := range table {
+		if got := beforeRestorePoint(tcase.params, bm); got != tcase.want {
+			t.Errorf("%v: beforeRestorePoint() = %v, want %v", i, got, tcase.want)
+		}
+	}
+}
@@ -27,7 +27,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -67,7 +66,7 @@ type builtinBackupManifest struct {
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
@@ -88,6 +87,18 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// Size and ModTime are the size and modification time of the
+	// file when it was backed up, to find the unchanged files
+	// for incremental backups.
+	Size    int64
+	ModTime time.Time
+
+	// Backup is the name of the backup storing the file, if the file
+	// didn't change since a previous backup. BackupFile is then the
+	// name of the file in that backup.
+	Backup     string `json:",omitempty"`
+	BackupFile string `json:",omitempty"`
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -128,7 +139,7 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compression: %v", *backupStorageHook, BackupCompressionCodec())
 
 	// Save initial state so we can restore.
 	slaveStartRequired := false
@@ -181,6 +192,20 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 	params.Logger.Infof("using replication position: %v", replicationPosition)
 
+	// Record the row counts, to verify the backup. The rows of a master
+	// are not counted: read-only doesn't stop the writes of the SUPER
+	// users, so the counts could differ from the backed up files.
+	var rowCounts map[string]int64
+	switch {
+	case *backupRecordRowCounts && sourceIsMaster:
+		params.Logger.Infof("not counting the rows of the tables, as the master may still take writes")
+	case *backupRecordRowCounts:
+		params.Logger.Infof("counting the rows of the tables")
+		if rowCounts, err = countTableRows(ctx, params.Mysqld); err != nil {
+			return false, vterrors.Wrap(err, "can't count the rows of the tables")
+		}
+	}
+
 	// shutdown mysqld
 	err = params.Mysqld.Shutdown(ctx, params.Cnf, true)
 	if err != nil {
@@ -188,7 +213,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 
 	// Backup everything, capture the error.
-	backupErr := be.backupFiles(ctx, params, bh, replicationPosition)
+	backupErr := be.backupFiles(ctx, params, bh, replicationPosition, rowCounts)
 	usable := backupErr == nil
 
 	// Try to restart mysqld, use background context in case we timed out the original context
@@ -264,7 +289,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position, rowCounts map[string]int64) (finalErr error) {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -274,6 +299,12 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	// Find the parent backup, for incremental backups.
+	parentName, parent, err := be.findIncrementalParent(ctx, params, bh)
+	if err != nil {
+		return vterrors.Wrap(err, "can't find the parent backup")
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
@@ -293,7 +324,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], name, parentName, parent))
 		}(i)
 	}
 
@@ -321,12 +352,17 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 			Position:     replicationPosition,
 			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
 			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Encryption:   GetBackupEncryption(bh),
+			Parent:       parentName,
+			RowCounts:    rowCounts,
+
+			CompressionCodec: BackupCompressionCodec(),
 		},
 
 		// Builtin-specific fields
 		FileEntries:   fes,
 		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
+		SkipCompress:  BackupCompressionCodec() == NoCompressionCodec,
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -340,7 +376,8 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 }
 
 // backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+// If the file didn't change since the parent backup, it is not stored again.
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name, parentName string, parent *builtinBackupManifest) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -352,6 +389,13 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 	if err != nil {
 		return err
 	}
+	fe.Size = fi.Size()
+	fe.ModTime = fi.ModTime().UTC()
+	if entry, ok := unchangedFileEntry(parentName, parent, fe); ok {
+		params.Logger.Infof("Skipping unchanged file: %v", fe.Name)
+		*fe = *entry
+		return nil
+	}
 
 	params.Logger.Infof("Backing up file: %v", fe.Name)
 	// Open the destination file for writing, and a buffer.
@@ -388,29 +432,23 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pgzip.Writer
-	if *backupStorageCompress {
-		gzip, err = pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
-		if err != nil {
-			return vterrors.Wrap(err, "cannot create gziper")
-		}
-		gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-		writer = gzip
+	// Create the compression pipe.
+	compressor, err := NewCompressor(BackupCompressionCodec(), writer)
+	if err != nil {
+		return vterrors.Wrap(err, "cannot create compressor")
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (compressor,
 	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	_, err = io.Copy(compressor, NewThrottledReader(ctx, source))
 	if err != nil {
+		compressor.Close()
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
-		}
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if err = compressor.Close(); err != nil {
+		return vterrors.Wrap(err, "cannot close compressor")
 	}
 
 	// Close the hook pipe if necessary.
@@ -444,12 +482,12 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 
 	var bm builtinBackupManifest
 
-	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+	if err := GetBackupManifestInto(ctx, bh, &bm); err != nil {
 		return nil, err
 	}
 
 	// mark restore as in progress
-	if err := createStateFile(params.Cnf); err != nil {
+	if err := CreateStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
 
@@ -472,6 +510,14 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+
+	// The files of incremental backups are read from the backups
+	// storing them.
+	handles, err := incrementalBackupHandles(ctx, bh, bm)
+	if err != nil {
+		return err
+	}
+
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -490,8 +536,13 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
+			source := bh
+			if fes[i].Backup != "" {
+				name = fes[i].BackupFile
+				source = handles[fes[i].Backup]
+			}
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, source, &fes[i], bm.TransformHook, ManifestCompressionCodec(&bm.BackupManifest, bm.SkipCompress), name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -502,7 +553,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook, compressionCodec string, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -527,7 +578,7 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}()
 
 	// Create a buffering output.
-	dst := bufio.NewWriterSize(dstFile, 2*1024*1024)
+	dst := bufio.NewWriterSize(NewThrottledWriter(ctx, dstFile), 2*1024*1024)
 
 	// Create hash to write the compressed data to.
 	hasher := newHasher()
@@ -547,24 +598,22 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 		}
 	}
 
-	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
-		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
-		}
-		defer func() {
-			if cerr := gz.Close(); cerr != nil {
-				if finalErr != nil {
-					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
-				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
-				}
-			}
-		}()
-		reader = gz
+	// Create the uncompresser.
+	decompressor, err := NewDecompressor(compressionCodec, reader)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open decompressor")
 	}
+	defer func() {
+		if cerr := decompressor.Close(); cerr != nil {
+			if finalErr != nil {
+				// We already have an error, just log this one.
+				log.Errorf("failed to close decompressor %v: %v", name, cerr)
+			} else {
+				finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
+			}
+		}
+	}()
+	reader = decompressor
 
 	// Copy the data. Will also write to the hasher.
 	if _, err = io.Copy(dst, reader); err != nil {
@@ -593,6 +642,14 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 		return vterrors.Wrap(err, "failed to flush destination buffer")
 	}
 
+	// Keep the modification time of the file when it was backed up,
+	// so the next incremental backup knows it didn't change.
+	if !fe.ModTime.IsZero() {
+		if err := os.Chtimes(dstFile.Name(), fe.ModTime, fe.ModTime); err != nil {
+			return vterrors.Wrap(err, "failed to set the modification time of the destination file")
+		}
+	}
+
 	return nil
 }
 
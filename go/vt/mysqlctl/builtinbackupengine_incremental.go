@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file implements the incremental backups of the builtin engine.
+// An incremental backup only stores the files that changed since its
+// parent backup. The other files are referenced in the MANIFEST by the
+// name of the backup that stores them, and their name in that backup.
+
+var (
+	// incrementalBackup makes the builtin engine take incremental backups.
+	incrementalBackup = flag.Bool("incremental_backup", false, "if set, the builtin backup engine only stores the files that changed since the most recent complete backup, which becomes the parent of the new backup.")
+
+	// incrementalBackupMaxChain limits the number of incremental
+	// backups that need to be chained to a full backup for a restore.
+	incrementalBackupMaxChain = flag.Int("incremental_backup_max_chain", 10, "if incremental_backup is set, a full backup is taken instead when the most recent backup is already chained to this many incremental backups.")
+)
+
+// findIncrementalParent returns the most recent complete backup that
+// can be the parent of the new backup, or nil if a full backup should
+// be taken.
+func (be *BuiltinBackupEngine) findIncrementalParent(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (string, *builtinBackupManifest, error) {
+	if !*incrementalBackup {
+		return "", nil, nil
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return "", nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	manifests := make(map[string]*builtinBackupManifest)
+	var parentName string
+	for _, candidate := range bhs {
+		if candidate.Name() == bh.Name() {
+			continue
+		}
+		bm := &builtinBackupManifest{}
+		if err := GetBackupManifestInto(ctx, candidate, bm); err != nil {
+			// Incomplete backups can't be parents.
+			continue
+		}
+		manifests[candidate.Name()] = bm
+		parentName = candidate.Name()
+	}
+	if parentName == "" {
+		params.Logger.Infof("no complete backup to use as the parent, taking a full backup")
+		return "", nil, nil
+	}
+
+	parent := manifests[parentName]
+	if parent.BackupMethod != "" && parent.BackupMethod != builtinBackupEngineName {
+		params.Logger.Infof("backup %v was taken by the %v engine, taking a full backup", parentName, parent.BackupMethod)
+		return "", nil, nil
+	}
+	if parent.TransformHook != *backupStorageHook || ManifestCompressionCodec(&parent.BackupManifest, parent.SkipCompress) != BackupCompressionCodec() {
+		params.Logger.Infof("backup %v was taken with other hook or compression settings, taking a full backup", parentName)
+		return "", nil, nil
+	}
+	chain := 0
+	for bm := parent; bm.Parent != ""; chain++ {
+		ancestor, ok := manifests[bm.Parent]
+		if !ok {
+			params.Logger.Warningf("backup %v has a missing parent %v, taking a full backup", parentName, bm.Parent)
+			return "", nil, nil
+		}
+		bm = ancestor
+	}
+	if chain >= *incrementalBackupMaxChain {
+		params.Logger.Infof("backup %v is chained to %v incremental backups, taking a full backup", parentName, chain)
+		return "", nil, nil
+	}
+	params.Logger.Infof("taking an incremental backup on top of %v", parentName)
+	return parentName, parent, nil
+}
+
+// unchangedFileEntry returns the entry of the parent backup for the
+// file, if the file didn't change since the parent backup.
+func unchangedFileEntry(parentName string, parent *builtinBackupManifest, fe *FileEntry) (*FileEntry, bool) {
+	if parent == nil {
+		return nil, false
+	}
+	for i, pfe := range parent.FileEntries {
+		if pfe.Base != fe.Base || pfe.Name != fe.Name {
+			continue
+		}
+		if pfe.Size != fe.Size || !pfe.ModTime.Equal(fe.ModTime) {
+			return nil, false
+		}
+		entry := pfe
+		if entry.Backup == "" {
+			// The file is stored in the parent itself.
+			entry.Backup = parentName
+			entry.BackupFile = fmt.Sprintf("%v", i)
+		}
+		return &entry, true
+	}
+	return nil, false
+}
+
+// incrementalBackupHandles returns the handles of the backups storing the
+// files of an incremental backup, ready to read the files.
+func incrementalBackupHandles(ctx context.Context, bh backupstorage.BackupHandle, bm builtinBackupManifest) (map[string]backupstorage.BackupHandle, error) {
+	names := make(map[string]bool)
+	for _, fe := range bm.FileEntries {
+		if fe.Backup != "" {
+			names[fe.Backup] = true
+		}
+	}
+	handles := make(map[string]backupstorage.BackupHandle)
+	if len(names) == 0 {
+		return handles, nil
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, ancestor := range bhs {
+		if !names[ancestor.Name()] {
+			continue
+		}
+		// Each backup of the chain has its own encryption.
+		abm, err := GetBackupManifest(ctx, ancestor)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't read the MANIFEST of parent backup %v", ancestor.Name())
+		}
+		if handles[ancestor.Name()], err = maybeDecryptBackup(ctx, ancestor, abm.Encryption); err != nil {
+			return nil, err
+		}
+	}
+	for name := range names {
+		if _, ok := handles[name]; !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "parent backup %v of %v is missing", name, bh.Name())
+		}
+	}
+	return handles, nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func incrementalTestCnf(t *testing.T, root string) *Mycnf {
+	t.Helper()
+	cnf := &Mycnf{
+		InnodbDataHomeDir:     path.Join(root, "innodb", "data"),
+		InnodbLogGroupHomeDir: path.Join(root, "innodb", "logs"),
+		DataDir:               path.Join(root, "data"),
+	}
+	for _, dir := range []string{cnf.InnodbDataHomeDir, cnf.InnodbLogGroupHomeDir, path.Join(cnf.DataDir, "vt_test")} {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cnf
+}
+
+func writeIncrementalTestFile(t *testing.T, file, data string, modTime time.Time) {
+	t.Helper()
+	if err := ioutil.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// takeIncrementalTestBackup runs the builtin engine on the files, and
+// returns the names of the files stored in the backup.
+func takeIncrementalTestBackup(t *testing.T, cnf *Mycnf, name string) []string {
+	t.Helper()
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bh, err := bs.StartBackup(ctx, "ks/0", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	be := &BuiltinBackupEngine{}
+	params := BackupParams{
+		Cnf:         cnf,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: 2,
+		BackupTime:  time.Now(),
+	}
+	if err := be.backupFiles(ctx, params, bh, binlogArchiveTestPosition(binlogArchiveTestSID+":1-10"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	fis, err := ioutil.ReadDir(path.Join(*filebackupstorage.FileBackupStorageRoot, "ks/0", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored []string
+	for _, fi := range fis {
+		stored = append(stored, fi.Name())
+	}
+	sort.Strings(stored)
+	return stored
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*incrementalBackup = true
+	defer func() {
+		*backupstorage.BackupStorageImplementation = ""
+		*incrementalBackup = false
+	}()
+
+	cnf := incrementalTestCnf(t, path.Join(root, "source"))
+	modTime := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	files := map[string]string{
+		path.Join(cnf.InnodbDataHomeDir, "ibdata1"):         "ibdata1 v1",
+		path.Join(cnf.InnodbLogGroupHomeDir, "ib_logfile0"): "ib_logfile0 v1",
+		path.Join(cnf.DataDir, "vt_test", "t1.ibd"):         "t1 v1",
+		path.Join(cnf.DataDir, "vt_test", "t2.ibd"):         "t2 v1",
+	}
+	for file, data := range files {
+		writeIncrementalTestFile(t, file, data, modTime)
+	}
+
+	// The first backup is a full backup.
+	if stored := takeIncrementalTestBackup(t, cnf, "backup1"); len(stored) != 5 {
+		t.Errorf("full backup stored %v, want the 4 files and the MANIFEST", stored)
+	}
+
+	// Only the changed files are stored in the incremental backups.
+	files[path.Join(cnf.DataDir, "vt_test", "t1.ibd")] = "t1 v2"
+	writeIncrementalTestFile(t, path.Join(cnf.DataDir, "vt_test", "t1.ibd"), "t1 v2", modTime.Add(time.Minute))
+	if stored := takeIncrementalTestBackup(t, cnf, "backup2"); len(stored) != 2 {
+		t.Errorf("first incremental backup stored %v, want 1 file and the MANIFEST", stored)
+	}
+	files[path.Join(cnf.InnodbLogGroupHomeDir, "ib_logfile0")] = "ib_logfile0 v2"
+	writeIncrementalTestFile(t, path.Join(cnf.InnodbLogGroupHomeDir, "ib_logfile0"), "ib_logfile0 v2", modTime.Add(2*time.Minute))
+	if stored := takeIncrementalTestBackup(t, cnf, "backup3"); len(stored) != 2 {
+		t.Errorf("second incremental backup stored %v, want 1 file and the MANIFEST", stored)
+	}
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantParents := []string{"", "backup1", "backup2"}
+	for i, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bm.Parent != wantParents[i] {
+			t.Errorf("parent of %v = %q, want %q", bh.Name(), bm.Parent, wantParents[i])
+		}
+	}
+
+	// The restore of the last backup reads the files from the chain.
+	restoreCnf := incrementalTestCnf(t, path.Join(root, "restore"))
+	var bm builtinBackupManifest
+	if err := GetBackupManifestInto(ctx, bhs[2], &bm); err != nil {
+		t.Fatal(err)
+	}
+	be := &BuiltinBackupEngine{}
+	params := RestoreParams{
+		Cnf:         restoreCnf,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: 2,
+	}
+	if err := be.restoreFiles(ctx, params, bhs[2], bm); err != nil {
+		t.Fatal(err)
+	}
+	for file, want := range files {
+		restored := path.Join(root, "restore", file[len(path.Join(root, "source")):])
+		got, err := ioutil.ReadFile(restored)
+		if err != nil {
+			t.Errorf("cannot read restored file: %v", err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("restored %v = %q, want %q", restored, got, want)
+		}
+	}
+
+	// The restored files keep their modification time, so an
+	// incremental backup of the restored tablet stores none of them.
+	if stored := takeIncrementalTestBackup(t, restoreCnf, "backup4"); len(stored) != 1 {
+		t.Errorf("incremental backup after a restore stored %v, want only the MANIFEST", stored)
+	}
+
+	// Without its parents, the backup can't be restored.
+	if err := bs.RemoveBackup(ctx, "ks/0", "backup1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := be.restoreFiles(ctx, params, bhs[2], bm); err == nil {
+		t.Errorf("restoreFiles() without the full backup should have failed")
+	}
+}
+
+func TestIncrementalBackupMaxChain(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*incrementalBackup = true
+	*incrementalBackupMaxChain = 1
+	defer func() {
+		*backupstorage.BackupStorageImplementation = ""
+		*incrementalBackup = false
+		*incrementalBackupMaxChain = 10
+	}()
+
+	cnf := incrementalTestCnf(t, path.Join(root, "source"))
+	writeIncrementalTestFile(t, path.Join(cnf.DataDir, "vt_test", "t1.ibd"), "t1", time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	want := []int{2, 1, 2, 1}
+	for i, name := range []string{"backup1", "backup2", "backup3", "backup4"} {
+		if stored := takeIncrementalTestBackup(t, cnf, name); len(stored) != want[i] {
+			t.Errorf("%v stored %v, want %v files", name, stored, want[i])
+		}
+	}
+}
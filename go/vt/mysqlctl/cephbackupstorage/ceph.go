@@ -130,6 +130,28 @@ func (bh *CephBackupHandle) ReadFile(ctx context.Context, filename string) (io.R
 	return bh.client.GetObjectWithContext(ctx, bucket, object, minio.GetObjectOptions{})
 }
 
+// ListFiles implements BackupHandle.
+func (bh *CephBackupHandle) ListFiles(ctx context.Context) ([]string, error) {
+	if !bh.readOnly {
+		return nil, fmt.Errorf("ListFiles cannot be called on read-write backup")
+	}
+	// ceph bucket name
+	bucket := alterBucketName(bh.dir)
+	searchPrefix := objName(bh.dir, bh.name, "")
+
+	var files []string
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for object := range bh.client.ListObjects(bucket, searchPrefix, true, doneCh) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		files = append(files, strings.TrimPrefix(object.Key, searchPrefix))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
 // CephBackupStorage implements BackupStorage for Ceph Cloud Storage.
 type CephBackupStorage struct {
 	// client is the instance of the Ceph Cloud Storage Go client.
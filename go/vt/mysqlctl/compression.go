@@ -0,0 +1,262 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/klauspost/pgzip"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file implements the compression codecs of the backup files.
+// The codec is recorded in the MANIFEST, so a backup is restored with
+// the codec it was taken with, whatever the current flags are.
+
+const (
+	// GzipCompressionCodec compresses with gzip, in parallel blocks.
+	GzipCompressionCodec = "gzip"
+
+	// ZstdCompressionCodec compresses with the zstd command.
+	ZstdCompressionCodec = "zstd"
+
+	// Lz4CompressionCodec compresses with the lz4 command.
+	Lz4CompressionCodec = "lz4"
+
+	// NoCompressionCodec stores the files as they are.
+	NoCompressionCodec = "none"
+)
+
+var (
+	// backupStorageCompression is the codec of new backups.
+	backupStorageCompression = flag.String("backup_storage_compression", GzipCompressionCodec, "if backup_storage_compress is true, the codec compressing the backup files: gzip, zstd, lz4 or none. zstd and lz4 run the commands of the same name, which must be installed on the hosts taking and restoring the backups. The codec is recorded in the MANIFEST, restores use the codec of the backup.")
+)
+
+// CompressionCodec compresses and decompresses the backup files.
+type CompressionCodec interface {
+	// NewCompressor returns a writer compressing into w.
+	// Closing it flushes the compressed data, but doesn't close w.
+	NewCompressor(w io.Writer) (io.WriteCloser, error)
+
+	// NewDecompressor returns a reader decompressing r.
+	// Closing it doesn't close r.
+	NewDecompressor(r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressionCodecs is a registry of the compression codecs, by name.
+var CompressionCodecs = map[string]CompressionCodec{
+	GzipCompressionCodec: gzipCodec{},
+	ZstdCompressionCodec: &commandCodec{
+		compress:   []string{"zstd", "-c", "-q", "-T0"},
+		decompress: []string{"zstd", "-d", "-c", "-q"},
+	},
+	Lz4CompressionCodec: &commandCodec{
+		compress:   []string{"lz4", "-c", "-q"},
+		decompress: []string{"lz4", "-d", "-c", "-q"},
+	},
+	NoCompressionCodec: noCodec{},
+}
+
+// BackupCompressionCodec returns the name of the codec of new backups.
+func BackupCompressionCodec() string {
+	if !*backupStorageCompress {
+		return NoCompressionCodec
+	}
+	return *backupStorageCompression
+}
+
+// ManifestCompressionCodec returns the name of the codec of the files
+// of a backup. skipCompress is the SkipCompress field of the manifests
+// which have one.
+func ManifestCompressionCodec(bm *BackupManifest, skipCompress bool) string {
+	switch {
+	case skipCompress:
+		return NoCompressionCodec
+	case bm.CompressionCodec == "":
+		// The backups taken before the codecs were gzipped.
+		return GzipCompressionCodec
+	}
+	return bm.CompressionCodec
+}
+
+// GetCompressionCodec returns the codec with the given name.
+func GetCompressionCodec(name string) (CompressionCodec, error) {
+	codec, ok := CompressionCodecs[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown compression codec %q", name)
+	}
+	return codec, nil
+}
+
+// NewCompressor returns a writer compressing into w with a codec.
+func NewCompressor(name string, w io.Writer) (io.WriteCloser, error) {
+	codec, err := GetCompressionCodec(name)
+	if err != nil {
+		return nil, err
+	}
+	return codec.NewCompressor(w)
+}
+
+// NewDecompressor returns a reader decompressing r with a codec.
+func NewDecompressor(name string, r io.Reader) (io.ReadCloser, error) {
+	codec, err := GetCompressionCodec(name)
+	if err != nil {
+		return nil, err
+	}
+	return codec.NewDecompressor(r)
+}
+
+// gzipCodec compresses with pgzip, tuned by the
+// backup_storage_block_size and backup_storage_number_blocks flags.
+type gzipCodec struct{}
+
+func (gzipCodec) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	gz, err := pgzip.NewWriterLevel(w, pgzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	if err := gz.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+		return nil, err
+	}
+	return gz, nil
+}
+
+func (gzipCodec) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+// noCodec doesn't compress.
+type noCodec struct{}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+type nopReadCloser struct {
+	io.Reader
+}
+
+func (nopReadCloser) Close() error {
+	return nil
+}
+
+func (noCodec) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	return nopWriteCloser{w}, nil
+}
+
+func (noCodec) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	return nopReadCloser{r}, nil
+}
+
+// commandCodec compresses by piping the data through a command,
+// which reads stdin and writes stdout.
+type commandCodec struct {
+	compress   []string
+	decompress []string
+}
+
+func (c *commandCodec) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	cmd := exec.Command(c.compress[0], c.compress[1:]...)
+	cmd.Stdout = w
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start %v", c.compress[0])
+	}
+	return &commandWriter{WriteCloser: stdin, cmd: cmd, stderr: stderr}, nil
+}
+
+func (c *commandCodec) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	cmd := exec.Command(c.decompress[0], c.decompress[1:]...)
+	cmd.Stdin = r
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start %v", c.decompress[0])
+	}
+	return &commandReader{Reader: stdout, cmd: cmd, stderr: stderr}, nil
+}
+
+// commandWriter writes to the stdin of a command.
+type commandWriter struct {
+	io.WriteCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+}
+
+// Close closes stdin, and waits for the command to write the end
+// of its output.
+func (cw *commandWriter) Close() error {
+	err := cw.WriteCloser.Close()
+	if waitErr := cw.cmd.Wait(); waitErr != nil {
+		return commandError(cw.cmd, waitErr, cw.stderr)
+	}
+	return err
+}
+
+// commandReader reads the stdout of a command. At the end of the
+// output, it returns the error of the command, if any, instead of io.EOF.
+type commandReader struct {
+	io.Reader
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+	done   bool
+}
+
+func (cr *commandReader) Read(p []byte) (int, error) {
+	n, err := cr.Reader.Read(p)
+	if err == io.EOF && !cr.done {
+		cr.done = true
+		if waitErr := cr.cmd.Wait(); waitErr != nil {
+			return n, commandError(cr.cmd, waitErr, cr.stderr)
+		}
+	}
+	return n, err
+}
+
+// Close kills the command, if the output wasn't read until the end.
+func (cr *commandReader) Close() error {
+	if !cr.done {
+		cr.done = true
+		cr.cmd.Process.Kill()
+		cr.cmd.Wait()
+	}
+	return nil
+}
+
+func commandError(cmd *exec.Cmd, err error, stderr *bytes.Buffer) error {
+	return fmt.Errorf("%v failed: %v: %v", strings.Join(cmd.Args, " "), err, strings.TrimSpace(stderr.String()))
+}
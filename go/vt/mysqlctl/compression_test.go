@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestCompressionCodecs(t *testing.T) {
+	data := []byte(strings.Repeat("some backup data, compressed and decompressed. ", 10000))
+	for name, codec := range CompressionCodecs {
+		if cc, ok := codec.(*commandCodec); ok {
+			if _, err := exec.LookPath(cc.compress[0]); err != nil {
+				t.Logf("skipping codec %v: %v", name, err)
+				continue
+			}
+		}
+
+		var compressed bytes.Buffer
+		compressor, err := NewCompressor(name, &compressed)
+		if err != nil {
+			t.Fatalf("NewCompressor(%v) failed: %v", name, err)
+		}
+		if _, err := compressor.Write(data); err != nil {
+			t.Fatalf("codec %v: Write failed: %v", name, err)
+		}
+		if err := compressor.Close(); err != nil {
+			t.Fatalf("codec %v: Close failed: %v", name, err)
+		}
+		if name != NoCompressionCodec && compressed.Len() >= len(data) {
+			t.Errorf("codec %v didn't compress: %v bytes out of %v", name, compressed.Len(), len(data))
+		}
+
+		decompressor, err := NewDecompressor(name, &compressed)
+		if err != nil {
+			t.Fatalf("NewDecompressor(%v) failed: %v", name, err)
+		}
+		got, err := ioutil.ReadAll(decompressor)
+		if err != nil {
+			t.Fatalf("codec %v: ReadAll failed: %v", name, err)
+		}
+		if err := decompressor.Close(); err != nil {
+			t.Fatalf("codec %v: Close failed: %v", name, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("codec %v: got %v bytes back, want the %v bytes written", name, len(got), len(data))
+		}
+	}
+}
+
+func TestCommandCodecError(t *testing.T) {
+	if _, err := exec.LookPath("false"); err != nil {
+		t.Skipf("no false command: %v", err)
+	}
+	codec := &commandCodec{
+		compress:   []string{"false"},
+		decompress: []string{"false"},
+	}
+	r, err := codec.NewDecompressor(strings.NewReader("data"))
+	if err != nil {
+		t.Fatalf("NewDecompressor failed: %v", err)
+	}
+	if _, err := ioutil.ReadAll(r); err == nil || !strings.Contains(err.Error(), "false failed") {
+		t.Errorf("ReadAll returned %v, want the command error", err)
+	}
+}
+
+func TestUnknownCompressionCodec(t *testing.T) {
+	if _, err := NewCompressor("rar", &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "unknown compression codec") {
+		t.Errorf("NewCompressor(rar) returned %v", err)
+	}
+	if _, err := NewDecompressor("rar", &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "unknown compression codec") {
+		t.Errorf("NewDecompressor(rar) returned %v", err)
+	}
+}
+
+func TestManifestCompressionCodec(t *testing.T) {
+	testcases := []struct {
+		codec        string
+		skipCompress bool
+		want         string
+	}{
+		{"", false, GzipCompressionCodec},
+		{"", true, NoCompressionCodec},
+		{ZstdCompressionCodec, false, ZstdCompressionCodec},
+		{NoCompressionCodec, true, NoCompressionCodec},
+	}
+	for _, tc := range testcases {
+		bm := &BackupManifest{CompressionCodec: tc.codec}
+		if got := ManifestCompressionCodec(bm, tc.skipCompress); got != tc.want {
+			t.Errorf("ManifestCompressionCodec(%q, %v) = %v, want %v", tc.codec, tc.skipCompress, got, tc.want)
+		}
+	}
+}
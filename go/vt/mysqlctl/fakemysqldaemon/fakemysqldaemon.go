@@ -18,6 +18,7 @@ package fakemysqldaemon
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
@@ -147,6 +149,15 @@ type FakeMysqlDaemon struct {
 	// SemiSyncSlaveEnabled represents the state of rpl_semi_sync_slave_enabled.
 	SemiSyncSlaveEnabled bool
 
+	// AppliedBinlogFiles records the base names of the files
+	// given to ApplyBinlogFile.
+	AppliedBinlogFiles []string
+
+	// BinlogFilePositions is used by ApplyBinlogFile: the
+	// CurrentMasterPosition is set to the position of the applied
+	// file, as if its transactions were replayed.
+	BinlogFilePositions map[string]mysql.Position
+
 	// TimeoutHook is a func that can be called at the beginning of any method to fake a timeout.
 	// all a test needs to do is make it { return context.DeadlineExceeded }
 	TimeoutHook func() error
@@ -481,6 +492,11 @@ func (fmd *FakeMysqlDaemon) GetAllPrivsConnection() (*dbconnpool.DBConnection, e
 	return dbconnpool.NewDBConnection(fmd.db.ConnParams(), stats.NewTimings("", "", ""))
 }
 
+// DbaConnector is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) DbaConnector() dbconfigs.Connector {
+	return fmd.db.ConnParams()
+}
+
 // SetSemiSyncEnabled is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) SetSemiSyncEnabled(master, slave bool) error {
 	fmd.SemiSyncMasterEnabled = master
@@ -493,6 +509,16 @@ func (fmd *FakeMysqlDaemon) SemiSyncEnabled() (master, slave bool) {
 	return fmd.SemiSyncMasterEnabled, fmd.SemiSyncSlaveEnabled
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error {
+	name := filepath.Base(binlogFile)
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, name)
+	if pos, ok := fmd.BinlogFilePositions[name]; ok {
+		fmd.CurrentMasterPosition = pos
+	}
+	return nil
+}
+
 // SemiSyncSlaveStatus is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) SemiSyncSlaveStatus() (bool, error) {
 	// The fake assumes the status worked.
@@ -60,7 +60,7 @@ func (fbh *FileBackupHandle) AddFile(ctx context.Context, filename string, files
 	if fbh.readOnly {
 		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
 	}
-	p := path.Join(*FileBackupStorageRoot, fbh.dir, fbh.name, filename)
+	p := path.Join(fbh.fbs.rootDir(), fbh.dir, fbh.name, filename)
 	return os.Create(p)
 }
 
@@ -85,17 +85,53 @@ func (fbh *FileBackupHandle) ReadFile(ctx context.Context, filename string) (io.
 	if !fbh.readOnly {
 		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
 	}
-	p := path.Join(*FileBackupStorageRoot, fbh.dir, fbh.name, filename)
+	p := path.Join(fbh.fbs.rootDir(), fbh.dir, fbh.name, filename)
 	return os.Open(p)
 }
 
+// ListFiles is part of the BackupHandle interface
+func (fbh *FileBackupHandle) ListFiles(ctx context.Context) ([]string, error) {
+	if !fbh.readOnly {
+		return nil, fmt.Errorf("ListFiles cannot be called on read-write backup")
+	}
+	// ReadDir already sorts the results
+	fi, err := ioutil.ReadDir(path.Join(fbh.fbs.rootDir(), fbh.dir, fbh.name))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(fi))
+	for _, info := range fi {
+		if info.Mode().IsRegular() {
+			result = append(result, info.Name())
+		}
+	}
+	return result, nil
+}
+
 // FileBackupStorage implements BackupStorage for local file system.
-type FileBackupStorage struct{}
+type FileBackupStorage struct {
+	// root is the root directory of the backups. If empty,
+	// the -file_backup_storage_root flag is used.
+	root string
+}
+
+// NewFileBackupStorage returns a FileBackupStorage storing the
+// backups under the given root directory.
+func NewFileBackupStorage(root string) *FileBackupStorage {
+	return &FileBackupStorage{root: root}
+}
+
+func (fbs *FileBackupStorage) rootDir() string {
+	if fbs.root != "" {
+		return fbs.root
+	}
+	return *FileBackupStorageRoot
+}
 
 // ListBackups is part of the BackupStorage interface
 func (fbs *FileBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
 	// ReadDir already sorts the results
-	p := path.Join(*FileBackupStorageRoot, dir)
+	p := path.Join(fbs.rootDir(), dir)
 	fi, err := ioutil.ReadDir(p)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -125,7 +161,7 @@ func (fbs *FileBackupStorage) ListBackups(ctx context.Context, dir string) ([]ba
 // StartBackup is part of the BackupStorage interface
 func (fbs *FileBackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
 	// Make sure the directory exists.
-	p := path.Join(*FileBackupStorageRoot, dir)
+	p := path.Join(fbs.rootDir(), dir)
 	if err := os.MkdirAll(p, os.ModePerm); err != nil {
 		return nil, err
 	}
@@ -146,7 +182,7 @@ func (fbs *FileBackupStorage) StartBackup(ctx context.Context, dir, name string)
 
 // RemoveBackup is part of the BackupStorage interface
 func (fbs *FileBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
-	p := path.Join(*FileBackupStorageRoot, dir, name)
+	p := path.Join(fbs.rootDir(), dir, name)
 	return os.RemoveAll(p)
 }
 
@@ -157,4 +193,10 @@ func (fbs *FileBackupStorage) Close() error {
 
 func init() {
 	backupstorage.BackupStorageMap["file"] = &FileBackupStorage{}
+	backupstorage.BackupStorageFactoryMap["file"] = func(root string) (backupstorage.BackupStorage, error) {
+		if root == "" {
+			return nil, fmt.Errorf("the file backup storage needs a root directory")
+		}
+		return NewFileBackupStorage(root), nil
+	}
 }
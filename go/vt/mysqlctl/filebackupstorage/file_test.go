@@ -20,9 +20,13 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
+	"reflect"
 	"testing"
 
 	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 )
 
 // This file tests the file BackupStorage engine.
@@ -203,4 +207,49 @@ func TestFileContents(t *testing.T) {
 	if err := rc.Close(); err != nil {
 		t.Fatalf("rc.Close failed: %v", err)
 	}
+
+	// and list it
+	if _, err := bh.ListFiles(ctx); err == nil {
+		t.Fatalf("was able to ListFiles of read-write backup")
+	}
+	files, err := bhs[0].ListFiles(ctx)
+	if err != nil || !reflect.DeepEqual(files, []string{filename1}) {
+		t.Fatalf("bhs[0].ListFiles returned wrong result: %v %v", err, files)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	fbs := setupFileBackupStorage(t)
+	defer cleanupFileBackupStorage(fbs)
+	ctx := context.Background()
+
+	// a storage with another root doesn't see the backups
+	// of the default one
+	otherRoot, err := ioutil.TempDir("", "fbstest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(otherRoot)
+	other, err := backupstorage.GetBackupStorageByName("file:" + otherRoot)
+	if err != nil {
+		t.Fatalf("GetBackupStorageByName failed: %v", err)
+	}
+	dir := "keyspace/shard"
+	bh, err := fbs.StartBackup(ctx, dir, "cell-0001-2015-01-14-10-00-00")
+	if err != nil {
+		t.Fatalf("fbs.StartBackup failed: %v", err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("bh.EndBackup failed: %v", err)
+	}
+	if bhs, err := other.ListBackups(ctx, dir); err != nil || len(bhs) != 0 {
+		t.Fatalf("ListBackups on the other root returned wrong result: %v %v", err, bhs)
+	}
+	if _, err := os.Stat(path.Join(otherRoot, dir)); !os.IsNotExist(err) {
+		t.Fatalf("the other root was written to: %v", err)
+	}
+
+	if _, err := backupstorage.GetBackupStorageByName("file:"); err == nil {
+		t.Fatalf("GetBackupStorageByName accepted an empty root")
+	}
 }
@@ -97,6 +97,32 @@ func (bh *GCSBackupHandle) ReadFile(ctx context.Context, filename string) (io.Re
 	return bh.client.Bucket(*bucket).Object(object).NewReader(ctx)
 }
 
+// ListFiles implements BackupHandle.
+func (bh *GCSBackupHandle) ListFiles(ctx context.Context) ([]string, error) {
+	if !bh.readOnly {
+		return nil, fmt.Errorf("ListFiles cannot be called on read-write backup")
+	}
+	searchPrefix := objName(bh.dir, bh.name, "" /* include trailing slash */)
+	query := &storage.Query{
+		Prefix: searchPrefix,
+	}
+
+	var files []string
+	it := bh.client.Bucket(*bucket).Objects(ctx, query)
+	for {
+		obj, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, strings.TrimPrefix(obj.Name, searchPrefix))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
 // GCSBackupStorage implements BackupStorage for Google Cloud Storage.
 type GCSBackupStorage struct {
 	// client is the instance of the Google Cloud Storage Go client.
@@ -0,0 +1,649 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logicalbackup implements the logical BackupEngine. A logical
+// backup holds the schema and the rows of the tables, instead of the
+// InnoDB files, so it can be restored on another MySQL version.
+//
+// The rows are read from a consistent snapshot of all the tables, with
+// vstreamer's SnapshotStreamer, so mysqld keeps running during the backup.
+// The rows of each table are split into compressed files, which are
+// loaded in parallel on restore. The schema is read after the snapshot
+// is taken, so no DDL should run during the backup. The users and grants
+// in the mysql database are not backed up.
+//
+// It is registered as the "logical" engine, use
+// -backup_engine_implementation=logical to take logical backups.
+package logicalbackup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const logicalBackupEngineName = "logical"
+
+var (
+	// fileSize is the size of the rows in each file of the backup.
+	fileSize = flag.Int64("logical_backup_file_size", 64*1024*1024, "the logical backup engine splits the rows of each table into files of about this many bytes, before compression. The files are restored in parallel.")
+
+	// insertSize is the size of the INSERT statements of the restore.
+	insertSize = flag.Int("logical_restore_insert_size", 1024*1024, "the logical backup engine restores the rows with INSERT statements of about this many bytes. It must be less than max_allowed_packet.")
+)
+
+// systemDatabases are not backed up.
+var systemDatabases = map[string]bool{
+	"information_schema": true,
+	"mysql":              true,
+	"performance_schema": true,
+	"sys":                true,
+}
+
+// LogicalBackupEngine encapsulates the logic of the logical engine.
+// It implements the BackupEngine interface.
+type LogicalBackupEngine struct {
+}
+
+// logicalBackupManifest represents the backup. It lists the databases
+// with their tables, and the files with the rows of each table.
+type logicalBackupManifest struct {
+	// BackupManifest is an anonymous embedding of the base manifest struct.
+	mysqlctl.BackupManifest
+
+	// Databases are the backed up databases.
+	Databases []*backupDatabase
+}
+
+// backupDatabase is one database of the backup.
+type backupDatabase struct {
+	Name string
+
+	// Schema is the CREATE DATABASE statement, with {{.DatabaseName}}
+	// instead of the name.
+	Schema string
+
+	Tables []*backupTable
+}
+
+// backupTable is one table or view of the backup.
+type backupTable struct {
+	Name string
+
+	// Type is tmutils.TableBaseTable or tmutils.TableView.
+	Type string
+
+	// Schema is the CREATE statement. The views have {{.DatabaseName}}
+	// instead of the name of their database.
+	Schema string
+
+	// Fields are the fields of the rows.
+	Fields []*querypb.Field `json:",omitempty"`
+
+	// Files are the names of the files with the rows in the backup.
+	Files []string `json:",omitempty"`
+
+	// RowCount is the number of rows in the files.
+	RowCount int64
+}
+
+// ExecuteBackup returns a boolean that indicates if the backup is usable,
+// and an overall error.
+func (be *LogicalBackupEngine) ExecuteBackup(ctx context.Context, params mysqlctl.BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	streams := params.Concurrency
+	if streams < 1 {
+		streams = 1
+	}
+	params.Logger.Infof("taking a snapshot of the tables")
+	ss, err := vstreamer.NewSnapshotStreamer(ctx, params.Mysqld.DbaConnector(), streams)
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't take a snapshot of the tables")
+	}
+	defer ss.Close()
+	replicationPosition, err := mysql.DecodePosition(ss.Gtid())
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't decode the snapshot position")
+	}
+	params.Logger.Infof("using replication position: %v", replicationPosition)
+
+	dbs, err := backupDatabases(ctx, params.Mysqld)
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't get the schema")
+	}
+
+	// Back up the tables with the connections of the snapshot.
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i, db := range dbs {
+		for j, table := range db.Tables {
+			if table.Type != tmutils.TableBaseTable {
+				continue
+			}
+			wg.Add(1)
+			go func(db *backupDatabase, table *backupTable, prefix string) {
+				defer wg.Done()
+				if rec.HasErrors() {
+					return
+				}
+				rec.RecordError(backupTableRows(ctx, params, bh, ss, db, table, prefix))
+			}(db, table, fmt.Sprintf("%v.%v", i, j))
+		}
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return false, rec.Error()
+	}
+
+	bm := &logicalBackupManifest{
+		// Common base fields
+		BackupManifest: mysqlctl.BackupManifest{
+			BackupMethod: logicalBackupEngineName,
+			Position:     replicationPosition,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Encryption:   mysqlctl.GetBackupEncryption(bh),
+
+			CompressionCodec: mysqlctl.BackupCompressionCodec(),
+		},
+
+		// Logical-specific fields
+		Databases: dbs,
+	}
+	if err := mysqlctl.WriteBackupManifest(ctx, bh, bm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// backupDatabases returns the schema of the databases to back up.
+func backupDatabases(ctx context.Context, mysqld mysqlctl.MysqlDaemon) ([]*backupDatabase, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW DATABASES")
+	if err != nil {
+		return nil, err
+	}
+	var dbs []*backupDatabase
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		if systemDatabases[name] {
+			continue
+		}
+		sd, err := mysqld.GetSchema(name, nil, nil, true)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't get the schema of %v", name)
+		}
+		db := &backupDatabase{
+			Name:   name,
+			Schema: sd.DatabaseSchema,
+		}
+		for _, td := range sd.TableDefinitions {
+			db.Tables = append(db.Tables, &backupTable{
+				Name:   td.Name,
+				Type:   td.Type,
+				Schema: td.Schema,
+			})
+		}
+		dbs = append(dbs, db)
+	}
+	return dbs, nil
+}
+
+// backupTableRows backs up the rows of a table into files named
+// prefix.<n>, and records them in the table.
+func backupTableRows(ctx context.Context, params mysqlctl.BackupParams, bh backupstorage.BackupHandle, ss *vstreamer.SnapshotStreamer, db *backupDatabase, table *backupTable, prefix string) (finalErr error) {
+	params.Logger.Infof("Backing up table: %v.%v", db.Name, table.Name)
+	w := &rowFileWriter{
+		bh:     bh,
+		prefix: prefix,
+		table:  table,
+		codec:  mysqlctl.BackupCompressionCodec(),
+	}
+	defer func() {
+		if err := w.closeFile(); finalErr == nil {
+			finalErr = err
+		}
+	}()
+	return ss.StreamTable(ctx, db.Name, table.Name, func(response *binlogdatapb.VStreamResultsResponse) error {
+		for _, field := range response.Fields {
+			table.Fields = append(table.Fields, &querypb.Field{
+				Name: field.Name,
+				Type: field.Type,
+			})
+		}
+		for _, row := range response.Rows {
+			if err := w.writeRow(ctx, row); err != nil {
+				return vterrors.Wrapf(err, "cannot back up %v.%v", db.Name, table.Name)
+			}
+		}
+		return nil
+	})
+}
+
+// rowFileWriter writes the rows of a table into compressed files of
+// about -logical_backup_file_size bytes. Each row is written as its
+// length, as a uvarint, followed by the row as a querypb.Row proto.
+type rowFileWriter struct {
+	bh     backupstorage.BackupHandle
+	prefix string
+	table  *backupTable
+	codec  string
+
+	wc         io.WriteCloser
+	compressor io.WriteCloser
+	size       int64
+}
+
+func (w *rowFileWriter) writeRow(ctx context.Context, row *querypb.Row) error {
+	if w.compressor == nil {
+		name := fmt.Sprintf("%v.%v", w.prefix, len(w.table.Files))
+		wc, err := w.bh.AddFile(ctx, name, backupstorage.FileSizeUnknown)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot add file: %v", name)
+		}
+		compressor, err := mysqlctl.NewCompressor(w.codec, mysqlctl.NewThrottledWriter(ctx, wc))
+		if err != nil {
+			wc.Close()
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		w.wc = wc
+		w.compressor = compressor
+		w.table.Files = append(w.table.Files, name)
+	}
+
+	data, err := proto.Marshal(row)
+	if err != nil {
+		return err
+	}
+	var header [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(header[:], uint64(len(data)))
+	if _, err := w.compressor.Write(header[:n]); err != nil {
+		return err
+	}
+	if _, err := w.compressor.Write(data); err != nil {
+		return err
+	}
+	w.table.RowCount++
+	w.size += int64(n + len(data))
+	if w.size >= *fileSize {
+		return w.closeFile()
+	}
+	return nil
+}
+
+// closeFile closes the current file, if any.
+func (w *rowFileWriter) closeFile() error {
+	if w.compressor == nil {
+		return nil
+	}
+	err := w.compressor.Close()
+	if closeErr := w.wc.Close(); err == nil {
+		err = closeErr
+	}
+	w.compressor = nil
+	w.wc = nil
+	w.size = 0
+	return err
+}
+
+// readRow reads the next row of a file written by rowFileWriter.
+// It returns io.EOF at the end of the file.
+func readRow(r *bufio.Reader) (*querypb.Row, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	row := &querypb.Row{}
+	if err := proto.Unmarshal(data, row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// ExecuteRestore restores from a backup. If the restore is successful
+// we return the position from which replication should start
+// otherwise an error is returned
+func (be *LogicalBackupEngine) ExecuteRestore(ctx context.Context, params mysqlctl.RestoreParams, bh backupstorage.BackupHandle) (*mysqlctl.BackupManifest, error) {
+	var bm logicalBackupManifest
+	if err := mysqlctl.GetBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+
+	dbs := bm.Databases
+	if len(params.Tables) > 0 {
+		var err error
+		if dbs, err = filterTables(bm.Databases, params.DbName, params.Tables); err != nil {
+			return nil, err
+		}
+	}
+
+	// mark restore as in progress
+	if err := mysqlctl.CreateStateFile(params.Cnf); err != nil {
+		return nil, err
+	}
+
+	// The rows are loaded into the running mysqld.
+	params.Logger.Infof("Restore: waiting for mysqld")
+	if err := params.Mysqld.Wait(ctx, params.Cnf); err != nil {
+		return nil, err
+	}
+	if err := restoreSchema(ctx, params, dbs, tmutils.TableBaseTable); err != nil {
+		return nil, vterrors.Wrap(err, "failed to restore the tables")
+	}
+	if err := restoreRows(ctx, params, bh, dbs, mysqlctl.ManifestCompressionCodec(&bm.BackupManifest, false)); err != nil {
+		// don't delete the file here because that is how we detect an interrupted restore
+		return nil, vterrors.Wrap(err, "failed to restore the rows")
+	}
+	if err := restoreSchema(ctx, params, dbs, tmutils.TableView); err != nil {
+		return nil, vterrors.Wrap(err, "failed to restore the views")
+	}
+
+	// The restore is done like for the other engines: mysqld is
+	// restarted for mysql_upgrade.
+	params.Logger.Infof("Restore: shutdown mysqld")
+	if err := params.Mysqld.Shutdown(ctx, params.Cnf, true); err != nil {
+		return nil, err
+	}
+
+	params.Logger.Infof("Restore: returning replication position %v", bm.Position)
+	return &bm.BackupManifest, nil
+}
+
+// filterTables returns the database with only the given tables.
+// The views are not restored, they may select from other tables.
+func filterTables(dbs []*backupDatabase, dbName string, tables []string) ([]*backupDatabase, error) {
+	for _, db := range dbs {
+		if db.Name != dbName {
+			continue
+		}
+		filtered := &backupDatabase{
+			Name:   db.Name,
+			Schema: db.Schema,
+		}
+		for _, name := range tables {
+			var found *backupTable
+			for _, table := range db.Tables {
+				if table.Name == name && table.Type == tmutils.TableBaseTable {
+					found = table
+					break
+				}
+			}
+			if found == nil {
+				return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "table %v.%v is not in the backup", dbName, name)
+			}
+			filtered.Tables = append(filtered.Tables, found)
+		}
+		return []*backupDatabase{filtered}, nil
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "database %v is not in the backup", dbName)
+}
+
+// restoreConnection returns a dba connection which doesn't write the
+// restore to the binlogs: the replication position is the position
+// of the backup. The backed up values are the bytes stored by mysqld,
+// so the connection uses the binary charset to insert them unconverted.
+func restoreConnection(mysqld mysqlctl.MysqlDaemon) (*dbconnpool.DBConnection, error) {
+	conn, err := mysqld.GetDbaConnection()
+	if err != nil {
+		return nil, err
+	}
+	for _, query := range []string{
+		"SET NAMES binary",
+		"SET sql_log_bin = 0",
+		"SET foreign_key_checks = 0",
+		"SET unique_checks = 0",
+	} {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// restoreSchema creates the databases and their tables of a type.
+// Before the tables, the existing databases are replaced.
+// Views are created after the tables and their rows, in the order
+// of their dependencies.
+func restoreSchema(ctx context.Context, params mysqlctl.RestoreParams, dbs []*backupDatabase, tableType string) error {
+	conn, err := restoreConnection(params.Mysqld)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if tableType == tmutils.TableBaseTable {
+		qr, err := conn.ExecuteFetch("SHOW DATABASES", 10000, false)
+		if err != nil {
+			return err
+		}
+		for _, row := range qr.Rows {
+			name := row[0].ToString()
+			if systemDatabases[name] {
+				continue
+			}
+			params.Logger.Infof("Restore: dropping existing database %v", name)
+			if _, err := conn.ExecuteFetch("DROP DATABASE "+sqlescape.EscapeID(name), 0, false); err != nil {
+				return err
+			}
+		}
+		for _, db := range dbs {
+			params.Logger.Infof("Restore: creating database %v", db.Name)
+			if _, err := conn.ExecuteFetch(strings.Replace(db.Schema, "{{.DatabaseName}}", sqlescape.EscapeID(db.Name), -1), 0, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	type entry struct {
+		db    *backupDatabase
+		table *backupTable
+	}
+	var pending []entry
+	for _, db := range dbs {
+		for _, table := range db.Tables {
+			if table.Type == tableType {
+				pending = append(pending, entry{db, table})
+			}
+		}
+	}
+	// A view can only be created after the views it selects from,
+	// so views are retried as long as some others are created.
+	for len(pending) > 0 {
+		var failed []entry
+		var lastErr error
+		for _, e := range pending {
+			if _, err := conn.ExecuteFetch("USE "+sqlescape.EscapeID(e.db.Name), 0, false); err != nil {
+				return err
+			}
+			if _, err := conn.ExecuteFetch(strings.Replace(e.table.Schema, "{{.DatabaseName}}", sqlescape.EscapeID(e.db.Name), -1), 0, false); err != nil {
+				failed = append(failed, e)
+				lastErr = vterrors.Wrapf(err, "cannot create %v.%v", e.db.Name, e.table.Name)
+			}
+		}
+		if len(failed) == len(pending) || tableType != tmutils.TableView {
+			return lastErr
+		}
+		pending = failed
+	}
+	return nil
+}
+
+// restoreRows loads the files of rows with the restore concurrency.
+func restoreRows(ctx context.Context, params mysqlctl.RestoreParams, bh backupstorage.BackupHandle, dbs []*backupDatabase, codec string) error {
+	sema := sync2.NewSemaphore(params.Concurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, db := range dbs {
+		for _, table := range db.Tables {
+			for _, name := range table.Files {
+				wg.Add(1)
+				go func(db *backupDatabase, table *backupTable, name string) {
+					defer wg.Done()
+
+					// Wait until we are ready to go, skip if we already
+					// encountered an error.
+					sema.Acquire()
+					defer sema.Release()
+					if rec.HasErrors() {
+						return
+					}
+
+					params.Logger.Infof("Restoring file %v: %v.%v", name, db.Name, table.Name)
+					if err := restoreFile(ctx, params, bh, db, table, name, codec); err != nil {
+						rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v.%v", name, db.Name, table.Name))
+					}
+				}(db, table, name)
+			}
+		}
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// restoreFile inserts the rows of a file.
+func restoreFile(ctx context.Context, params mysqlctl.RestoreParams, bh backupstorage.BackupHandle, db *backupDatabase, table *backupTable, name, codec string) error {
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open source file for reading")
+	}
+	defer source.Close()
+	decompressor, err := mysqlctl.NewDecompressor(codec, mysqlctl.NewThrottledReader(ctx, source))
+	if err != nil {
+		return vterrors.Wrap(err, "can't open decompressor")
+	}
+	defer decompressor.Close()
+	reader := bufio.NewReader(decompressor)
+
+	conn, err := restoreConnection(params.Mysqld)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	insert := newInsertBuilder(db.Name, table)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		row, err := readRow(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return vterrors.Wrap(err, "can't read row")
+		}
+		insert.add(row)
+		if insert.len() >= *insertSize {
+			if _, err := conn.ExecuteFetch(insert.flush(), 0, false); err != nil {
+				return err
+			}
+		}
+	}
+	if insert.rows > 0 {
+		if _, err := conn.ExecuteFetch(insert.flush(), 0, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertBuilder builds the INSERT statements of the rows of a table.
+type insertBuilder struct {
+	prefix string
+	fields []*querypb.Field
+	buf    bytes.Buffer
+	rows   int
+}
+
+func newInsertBuilder(dbName string, table *backupTable) *insertBuilder {
+	columns := make([]string, len(table.Fields))
+	for i, field := range table.Fields {
+		columns[i] = sqlescape.EscapeID(field.Name)
+	}
+	return &insertBuilder{
+		prefix: fmt.Sprintf("INSERT INTO %s.%s (%s) VALUES ", sqlescape.EscapeID(dbName), sqlescape.EscapeID(table.Name), strings.Join(columns, ", ")),
+		fields: table.Fields,
+	}
+}
+
+func (ib *insertBuilder) add(row *querypb.Row) {
+	if ib.rows == 0 {
+		ib.buf.WriteString(ib.prefix)
+	} else {
+		ib.buf.WriteString(", ")
+	}
+	ib.buf.WriteByte('(')
+	for i, value := range sqltypes.MakeRowTrusted(ib.fields, row) {
+		if i > 0 {
+			ib.buf.WriteString(", ")
+		}
+		value.EncodeSQL(&ib.buf)
+	}
+	ib.buf.WriteByte(')')
+	ib.rows++
+}
+
+func (ib *insertBuilder) len() int {
+	return ib.buf.Len()
+}
+
+// flush returns the INSERT statement of the added rows, and starts
+// the next one.
+func (ib *insertBuilder) flush() string {
+	query := ib.buf.String()
+	ib.buf.Reset()
+	ib.rows = 0
+	return query
+}
+
+// ShouldDrainForBackup satisfies the BackupEngine interface.
+// The backup reads a snapshot of the running mysqld, so the tablet
+// can keep serving.
+func (be *LogicalBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+func init() {
+	mysqlctl.BackupRestoreEngineMap[logicalBackupEngineName] = &LogicalBackupEngine{}
+}
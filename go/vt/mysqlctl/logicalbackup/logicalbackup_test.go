@@ -0,0 +1,270 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalbackup
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestRowFiles(t *testing.T) {
+	root, err := ioutil.TempDir("", "logicalbackuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	// Each file holds 4 rows of 7 bytes (1 byte of length and 6 of proto).
+	defer func(saved int64) { *fileSize = saved }(*fileSize)
+	*fileSize = 28
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bs.Close()
+	bh, err := bs.StartBackup(ctx, "ks/0", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := &backupTable{Name: "t1"}
+	w := &rowFileWriter{
+		bh:     bh,
+		prefix: "0.1",
+		table:  table,
+		codec:  mysqlctl.GzipCompressionCodec,
+	}
+	var want []*querypb.Row
+	for i := 0; i < 10; i++ {
+		row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(int64(100 + i))})
+		want = append(want, row)
+		if err := w.writeRow(ctx, row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.closeFile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	wantFiles := []string{"0.1.0", "0.1.1", "0.1.2"}
+	if !reflect.DeepEqual(table.Files, wantFiles) || table.RowCount != 10 {
+		t.Fatalf("backed up files %v with %v rows, want %v with 10 rows", table.Files, table.RowCount, wantFiles)
+	}
+
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups() = %v, %v, want 1 backup", bhs, err)
+	}
+	var got []*querypb.Row
+	for _, name := range table.Files {
+		rc, err := bhs[0].ReadFile(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gz, err := mysqlctl.NewDecompressor(mysqlctl.GzipCompressionCodec, rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader := bufio.NewReader(gz)
+		for {
+			row, err := readRow(reader)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("readRow(%v) failed: %v", name, err)
+			}
+			got = append(got, row)
+		}
+		gz.Close()
+		rc.Close()
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %v rows, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("row %v = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestInsertBuilder(t *testing.T) {
+	table := &backupTable{
+		Name: "t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "name", Type: sqltypes.VarChar},
+			{Name: "data", Type: sqltypes.VarBinary},
+		},
+	}
+	ib := newInsertBuilder("vt_ks", table)
+	for i, name := range []string{"a", "b'c"} {
+		ib.add(sqltypes.RowToProto3([]sqltypes.Value{
+			sqltypes.NewInt64(int64(i + 1)),
+			sqltypes.NewVarChar(name),
+			sqltypes.NULL,
+		}))
+	}
+	want := "INSERT INTO `vt_ks`.`t1` (`id`, `name`, `data`) VALUES (1, 'a', null), (2, 'b\\'c', null)"
+	if got := ib.flush(); got != want {
+		t.Errorf("flush() = %v, want %v", got, want)
+	}
+	if ib.rows != 0 || ib.len() != 0 {
+		t.Errorf("flush() left %v rows, %v bytes", ib.rows, ib.len())
+	}
+
+	ib.add(sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewVarChar("d"), sqltypes.MakeTrusted(sqltypes.VarBinary, []byte{0, 1})}))
+	want = "INSERT INTO `vt_ks`.`t1` (`id`, `name`, `data`) VALUES (3, 'd', '\\0\x01')"
+	if got := ib.flush(); got != want {
+		t.Errorf("flush() = %q, want %q", got, want)
+	}
+}
+
+// TestRestoreFileNonASCII checks the bytes of non-ASCII values are
+// inserted unchanged, with the binary charset.
+func TestRestoreFileNonASCII(t *testing.T) {
+	root, err := ioutil.TempDir("", "logicalbackuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func() { *backupstorage.BackupStorageImplementation = "" }()
+
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bs.Close()
+	bh, err := bs.StartBackup(ctx, "ks/0", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := &backupTable{
+		Name: "t1",
+		Type: tmutils.TableBaseTable,
+		Fields: []*querypb.Field{
+			{Name: "name", Type: sqltypes.VarChar},
+			{Name: "data", Type: sqltypes.VarBinary},
+		},
+	}
+	w := &rowFileWriter{
+		bh:     bh,
+		prefix: "0.0",
+		table:  table,
+		codec:  mysqlctl.GzipCompressionCodec,
+	}
+	row := sqltypes.RowToProto3([]sqltypes.Value{
+		sqltypes.NewVarChar("h\u00e9llo w\u00f6rld \u2713"),
+		sqltypes.MakeTrusted(sqltypes.VarBinary, []byte{0xe9, 0xff, 0x80}),
+	})
+	if err := w.writeRow(ctx, row); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.closeFile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups() = %v, %v, want 1 backup", bhs, err)
+	}
+
+	sqldb := fakesqldb.New(t).OrderMatters()
+	defer sqldb.Close()
+	for _, query := range []string{
+		"SET NAMES binary",
+		"SET sql_log_bin = 0",
+		"SET foreign_key_checks = 0",
+		"SET unique_checks = 0",
+		"INSERT INTO `vt_ks`.`t1` (`name`, `data`) VALUES ('h\u00e9llo w\u00f6rld \u2713', '\xe9\xff\x80')",
+	} {
+		sqldb.AddExpectedQuery(query, nil)
+	}
+	params := mysqlctl.RestoreParams{
+		Mysqld: fakemysqldaemon.NewFakeMysqlDaemon(sqldb),
+	}
+	bdb := &backupDatabase{Name: "vt_ks", Tables: []*backupTable{table}}
+	if err := restoreFile(ctx, params, bhs[0], bdb, table, table.Files[0], mysqlctl.GzipCompressionCodec); err != nil {
+		t.Fatalf("restoreFile() failed: %v", err)
+	}
+	sqldb.VerifyAllExecutedOrFail()
+}
+
+func TestFilterTables(t *testing.T) {
+	t1 := &backupTable{Name: "t1", Type: tmutils.TableBaseTable}
+	t2 := &backupTable{Name: "t2", Type: tmutils.TableBaseTable}
+	v1 := &backupTable{Name: "v1", Type: tmutils.TableView}
+	dbs := []*backupDatabase{
+		{Name: "vt_ks", Schema: "CREATE DATABASE {{.DatabaseName}}", Tables: []*backupTable{t1, t2, v1}},
+		{Name: "other", Tables: []*backupTable{{Name: "t1", Type: tmutils.TableBaseTable}}},
+	}
+
+	got, err := filterTables(dbs, "vt_ks", []string{"t2", "t1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*backupDatabase{
+		{Name: "vt_ks", Schema: "CREATE DATABASE {{.DatabaseName}}", Tables: []*backupTable{t2, t1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filterTables() = %v, want %v", got, want)
+	}
+
+	for _, tcase := range []struct {
+		dbName string
+		tables []string
+		want   string
+	}{
+		{"vt_ks", []string{"t3"}, "table vt_ks.t3 is not in the backup"},
+		{"vt_ks", []string{"v1"}, "table vt_ks.v1 is not in the backup"},
+		{"vt_other", []string{"t1"}, "database vt_other is not in the backup"},
+	} {
+		if _, err := filterTables(dbs, tcase.dbName, tcase.tables); err == nil || err.Error() != tcase.want {
+			t.Errorf("filterTables(%v, %v) = %v, want %v", tcase.dbName, tcase.tables, err, tcase.want)
+		}
+	}
+}
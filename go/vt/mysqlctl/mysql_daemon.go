@@ -17,10 +17,13 @@ limitations under the License.
 package mysqlctl
 
 import (
+	"time"
+
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 
@@ -49,6 +52,7 @@ type MysqlDaemon interface {
 	SetSemiSyncEnabled(master, slave bool) error
 	SemiSyncEnabled() (master, slave bool)
 	SemiSyncSlaveStatus() (bool, error)
+	ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error
 
 	// reparenting related methods
 	ResetReplication(ctx context.Context) error
@@ -82,6 +86,8 @@ type MysqlDaemon interface {
 	GetDbaConnection() (*dbconnpool.DBConnection, error)
 	// GetAllPrivsConnection returns an allprivs connection (for user with all privileges except SUPER).
 	GetAllPrivsConnection() (*dbconnpool.DBConnection, error)
+	// DbaConnector returns the parameters to open dba connections.
+	DbaConnector() dbconfigs.Connector
 
 	// ExecuteSuperQueryList executes a list of queries, no result
 	ExecuteSuperQueryList(ctx context.Context, queryList []string) error
@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file changes the global variables of a running mysqld, and
+// persists them so they survive the restarts: they are written to the
+// my.cnf, and to an overrides file next to it, which is added to the
+// my.cnf each time it is generated again from the templates.
+
+// mycnfOverridesSuffix is the suffix of the overrides file, added to
+// the path of the my.cnf.
+const mycnfOverridesSuffix = ".overrides"
+
+// mysqlVariableName matches the valid variable names, once normalized.
+var mysqlVariableName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// NormalizeMysqlVariableName returns the name of a MySQL variable as
+// it is listed by SHOW VARIABLES: lower case, with underscores rather
+// than the dashes the option files also accept.
+func NormalizeMysqlVariableName(name string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(name)), "-", "_", -1)
+}
+
+// GetMysqlVariables returns the global variables of mysqld with the
+// given names, or all of them if names is empty.
+func GetMysqlVariables(ctx context.Context, mysqld MysqlDaemon, names []string) (map[string]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW GLOBAL VARIABLES")
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Fields) != 2 {
+		return nil, fmt.Errorf("SHOW GLOBAL VARIABLES returned %d columns, expected 2", len(qr.Fields))
+	}
+	all := make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		all[row[0].ToString()] = row[1].ToString()
+	}
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	result := make(map[string]string, len(names))
+	for _, name := range names {
+		name = NormalizeMysqlVariableName(name)
+		value, ok := all[name]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown MySQL variable %v", name)
+		}
+		result[name] = value
+	}
+	return result, nil
+}
+
+// ApplyMysqlVariables changes global variables of mysqld, and persists
+// them in the my.cnf. The variables which cannot be changed while mysqld
+// runs are only persisted, and only if allowRestart is set, else nothing
+// is changed. It returns the previous values, and the names of the
+// variables which need a restart of mysqld to be applied.
+func ApplyMysqlVariables(ctx context.Context, mysqld MysqlDaemon, cnf *Mycnf, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	if cnf == nil || cnf.path == "" {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the my.cnf of mysqld is not managed by this process, the variables cannot be persisted")
+	}
+	if len(variables) == 0 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no variables to apply")
+	}
+	normalized := make(map[string]string, len(variables))
+	var names []string
+	for name, value := range variables {
+		name = NormalizeMysqlVariableName(name)
+		if !mysqlVariableName.MatchString(name) {
+			return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid MySQL variable name %q", name)
+		}
+		if strings.ContainsAny(value, "\n\r") {
+			return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid value for MySQL variable %v: it contains a new line", name)
+		}
+		if _, ok := normalized[name]; ok {
+			return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "MySQL variable %v is set twice", name)
+		}
+		normalized[name] = value
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	previous, err := GetMysqlVariables(ctx, mysqld, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Setting a variable to its own value tells if it is dynamic,
+	// without changing anything.
+	var dynamic, restartRequired []string
+	for _, name := range names {
+		_, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SET GLOBAL %s = @@GLOBAL.%s", name, name))
+		switch {
+		case err == nil:
+			dynamic = append(dynamic, name)
+		case isReadOnlyVariableError(err):
+			restartRequired = append(restartRequired, name)
+		default:
+			return nil, nil, vterrors.Wrapf(err, "cannot check MySQL variable %v", name)
+		}
+	}
+	if len(restartRequired) > 0 && !allowRestart {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "MySQL variables %v are not dynamic, they can only be changed by a restart of mysqld", strings.Join(restartRequired, ", "))
+	}
+
+	// Apply the dynamic variables, and restore them if one fails.
+	for i, name := range dynamic {
+		if _, err := mysqld.FetchSuperQuery(ctx, setGlobalVariableQuery(name, normalized[name])); err != nil {
+			for _, applied := range dynamic[:i] {
+				if _, restoreErr := mysqld.FetchSuperQuery(ctx, setGlobalVariableQuery(applied, previous[applied])); restoreErr != nil {
+					log.Warningf("Cannot restore MySQL variable %v to %v: %v", applied, previous[applied], restoreErr)
+				}
+			}
+			return nil, nil, vterrors.Wrapf(err, "cannot set MySQL variable %v to %v", name, normalized[name])
+		}
+	}
+
+	if err := persistMysqlVariables(cnf, normalized); err != nil {
+		return nil, nil, vterrors.Wrap(err, "the dynamic MySQL variables are applied, but cannot be persisted")
+	}
+	return previous, restartRequired, nil
+}
+
+func isReadOnlyVariableError(err error) bool {
+	sqlErr, ok := err.(*mysql.SQLError)
+	return ok && sqlErr.Number() == mysql.ERIncorrectGlobalLocalVar
+}
+
+// setGlobalVariableQuery returns the query setting a global variable.
+// The numbers are not quoted, since the numeric variables don't
+// accept strings.
+func setGlobalVariableQuery(name, value string) string {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return fmt.Sprintf("SET GLOBAL %s = %s", name, value)
+	}
+	buf := &bytes.Buffer{}
+	sqltypes.NewVarChar(value).EncodeSQL(buf)
+	return fmt.Sprintf("SET GLOBAL %s = %s", name, buf.String())
+}
+
+// ReadMycnfOverrides returns the variables persisted by
+// ApplyMysqlVariables for a my.cnf.
+func ReadMycnfOverrides(cnf *Mycnf) (map[string]string, error) {
+	data, err := ioutil.ReadFile(cnf.path + mycnfOverridesSuffix)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		name, value, ok := parseMycnfLine(line)
+		if ok {
+			overrides[name] = value
+		}
+	}
+	return overrides, nil
+}
+
+// persistMysqlVariables adds variables to the overrides file,
+// and writes them in the my.cnf.
+func persistMysqlVariables(cnf *Mycnf, variables map[string]string) error {
+	overrides, err := ReadMycnfOverrides(cnf)
+	if err != nil {
+		return err
+	}
+	for name, value := range variables {
+		overrides[name] = value
+	}
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf := &bytes.Buffer{}
+	buf.WriteString("[mysqld]\n")
+	for _, name := range names {
+		fmt.Fprintf(buf, "%s = %s\n", name, overrides[name])
+	}
+	if err := writeFileAtomically(cnf.path+mycnfOverridesSuffix, buf.Bytes()); err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadFile(cnf.path)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(cnf.path, []byte(setMycnfVariables(string(data), variables)))
+}
+
+// applyMycnfOverrides writes the persisted variables in a generated my.cnf.
+func applyMycnfOverrides(cnf *Mycnf, configData string) (string, error) {
+	if cnf.path == "" {
+		return configData, nil
+	}
+	overrides, err := ReadMycnfOverrides(cnf)
+	if err != nil {
+		return "", err
+	}
+	if len(overrides) == 0 {
+		return configData, nil
+	}
+	return setMycnfVariables(configData, overrides), nil
+}
+
+// parseMycnfLine returns the normalized name and the value of an
+// option line of a my.cnf, if it is one.
+func parseMycnfLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' || line[0] == ';' || line[0] == '[' || line[0] == '!' {
+		return "", "", false
+	}
+	parts := strings.SplitN(line, "=", 2)
+	name := NormalizeMysqlVariableName(parts[0])
+	if len(parts) == 1 {
+		return name, "", true
+	}
+	return name, strings.TrimSpace(parts[1]), true
+}
+
+// setMycnfVariables sets variables in the [mysqld] section of a my.cnf:
+// the lines of the variables are replaced, and the variables which
+// have no line are added at the end of the section.
+func setMycnfVariables(content string, variables map[string]string) string {
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	set := make(map[string]bool, len(variables))
+	var result []string
+	inMysqld, foundMysqld := false, false
+	sectionEnd := -1
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			if inMysqld && sectionEnd == -1 {
+				sectionEnd = len(result)
+			}
+			inMysqld = trimmed == "[mysqld]"
+			foundMysqld = foundMysqld || inMysqld
+		} else if inMysqld {
+			if name, _, ok := parseMycnfLine(line); ok {
+				if value, ok := variables[name]; ok {
+					line = fmt.Sprintf("%s = %s", name, value)
+					set[name] = true
+				}
+			}
+		}
+		result = append(result, line)
+	}
+
+	var added []string
+	if !foundMysqld {
+		added = append(added, "[mysqld]")
+	}
+	if sectionEnd == -1 {
+		sectionEnd = len(result)
+	}
+	var missing []string
+	for name := range variables {
+		if !set[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		added = append(added, fmt.Sprintf("%s = %s", name, variables[name]))
+	}
+	result = append(result[:sectionEnd], append(added, result[sectionEnd:]...)...)
+	return strings.Join(result, "\n") + "\n"
+}
+
+// writeFileAtomically replaces a file with a temporary file, so the
+// readers never see a partial file.
+func writeFileAtomically(name string, data []byte) error {
+	f, err := ioutil.TempFile(path.Dir(name), path.Base(name))
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0664); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// variablesMysqlDaemon is a MysqlDaemon which only runs the queries
+// of the variables.
+type variablesMysqlDaemon struct {
+	MysqlDaemon
+	variables map[string]string
+	static    map[string]bool
+	queries   []string
+}
+
+var setGlobalQuery = regexp.MustCompile(`^SET GLOBAL (\w+) = (.*)$`)
+
+func (vmd *variablesMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if query == "SHOW GLOBAL VARIABLES" {
+		qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"))
+		for name, value := range vmd.variables {
+			qr.Rows = append(qr.Rows, []sqltypes.Value{sqltypes.NewVarChar(name), sqltypes.NewVarChar(value)})
+		}
+		return qr, nil
+	}
+	vmd.queries = append(vmd.queries, query)
+	match := setGlobalQuery.FindStringSubmatch(query)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected query %v", query)
+	}
+	name, value := match[1], match[2]
+	if vmd.static[name] {
+		return nil, mysql.NewSQLError(mysql.ERIncorrectGlobalLocalVar, mysql.SSUnknownSQLState, "Variable '%s' is a read only variable", name)
+	}
+	if value != "@@GLOBAL."+name {
+		vmd.variables[name] = strings.Trim(value, "'")
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func TestSetMycnfVariables(t *testing.T) {
+	testcases := []struct {
+		content   string
+		variables map[string]string
+		want      string
+	}{{
+		content:   "[mysqld]\nport = 3306\nmax-connections = 100\n\n[client]\nport = 3306\n",
+		variables: map[string]string{"max_connections": "200", "port": "3307", "long_query_time": "1"},
+		want:      "[mysqld]\nport = 3307\nmax_connections = 200\n\nlong_query_time = 1\n[client]\nport = 3306\n",
+	}, {
+		content:   "[client]\nport = 3306\n[mysqld]\n# comment\nskip-name-resolve\n",
+		variables: map[string]string{"skip_name_resolve": "OFF", "read_only": "ON"},
+		want:      "[client]\nport = 3306\n[mysqld]\n# comment\nskip_name_resolve = OFF\nread_only = ON\n",
+	}, {
+		content:   "[client]\nport = 3306\n",
+		variables: map[string]string{"read_only": "ON"},
+		want:      "[client]\nport = 3306\n[mysqld]\nread_only = ON\n",
+	}}
+	for _, tc := range testcases {
+		if got := setMycnfVariables(tc.content, tc.variables); got != tc.want {
+			t.Errorf("setMycnfVariables(%q, %v) = %q, want %q", tc.content, tc.variables, got, tc.want)
+		}
+	}
+}
+
+func TestSetGlobalVariableQuery(t *testing.T) {
+	testcases := map[string]string{
+		"100":  "SET GLOBAL v = 100",
+		"0.5":  "SET GLOBAL v = 0.5",
+		"ON":   "SET GLOBAL v = 'ON'",
+		"it's": "SET GLOBAL v = 'it\\'s'",
+	}
+	for value, want := range testcases {
+		if got := setGlobalVariableQuery("v", value); got != want {
+			t.Errorf("setGlobalVariableQuery(v, %v) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestApplyMysqlVariables(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "mysqlvariablestest")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	cnf := &Mycnf{path: path.Join(dir, "my.cnf")}
+	if err := ioutil.WriteFile(cnf.path, []byte("[mysqld]\nmax_connections = 100\n"), 0664); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	vmd := &variablesMysqlDaemon{
+		variables: map[string]string{
+			"max_connections":  "100",
+			"long_query_time":  "10.000000",
+			"innodb_log_files": "2",
+		},
+		static: map[string]bool{"innodb_log_files": true},
+	}
+
+	// Unknown and invalid variables are rejected.
+	if _, _, err := ApplyMysqlVariables(ctx, vmd, cnf, map[string]string{"unknown": "1"}, false); err == nil || !strings.Contains(err.Error(), "unknown MySQL variable") {
+		t.Errorf("ApplyMysqlVariables(unknown) returned %v", err)
+	}
+	if _, _, err := ApplyMysqlVariables(ctx, vmd, cnf, map[string]string{"a = 1; b": "1"}, false); err == nil || !strings.Contains(err.Error(), "invalid MySQL variable name") {
+		t.Errorf("ApplyMysqlVariables(invalid) returned %v", err)
+	}
+
+	// Static variables need a restart, without it nothing is changed.
+	_, _, err = ApplyMysqlVariables(ctx, vmd, cnf, map[string]string{"max-connections": "200", "innodb_log_files": "3"}, false)
+	if err == nil || !strings.Contains(err.Error(), "innodb_log_files are not dynamic") {
+		t.Errorf("ApplyMysqlVariables(static) returned %v", err)
+	}
+	if vmd.variables["max_connections"] != "100" {
+		t.Errorf("max_connections was changed to %v", vmd.variables["max_connections"])
+	}
+
+	// With a restart, the dynamic variables are applied, and all are persisted.
+	previous, restartRequired, err := ApplyMysqlVariables(ctx, vmd, cnf, map[string]string{"max-connections": "200", "innodb_log_files": "3", "long_query_time": "1"}, true)
+	if err != nil {
+		t.Fatalf("ApplyMysqlVariables failed: %v", err)
+	}
+	if want := map[string]string{"max_connections": "100", "innodb_log_files": "2", "long_query_time": "10.000000"}; !reflect.DeepEqual(previous, want) {
+		t.Errorf("previous = %v, want %v", previous, want)
+	}
+	if want := []string{"innodb_log_files"}; !reflect.DeepEqual(restartRequired, want) {
+		t.Errorf("restartRequired = %v, want %v", restartRequired, want)
+	}
+	if vmd.variables["max_connections"] != "200" || vmd.variables["long_query_time"] != "1" || vmd.variables["innodb_log_files"] != "2" {
+		t.Errorf("variables = %v", vmd.variables)
+	}
+	data, err := ioutil.ReadFile(cnf.path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if want := "[mysqld]\nmax_connections = 200\ninnodb_log_files = 3\nlong_query_time = 1\n"; string(data) != want {
+		t.Errorf("my.cnf = %q, want %q", data, want)
+	}
+
+	// The variables are added to the regenerated my.cnf.
+	overrides, err := ReadMycnfOverrides(cnf)
+	if err != nil {
+		t.Fatalf("ReadMycnfOverrides failed: %v", err)
+	}
+	if want := map[string]string{"max_connections": "200", "innodb_log_files": "3", "long_query_time": "1"}; !reflect.DeepEqual(overrides, want) {
+		t.Errorf("overrides = %v, want %v", overrides, want)
+	}
+	regenerated, err := applyMycnfOverrides(cnf, "[mysqld]\nport = 3306\nmax_connections = 100\n")
+	if err != nil {
+		t.Fatalf("applyMycnfOverrides failed: %v", err)
+	}
+	if want := "[mysqld]\nport = 3306\nmax_connections = 200\ninnodb_log_files = 3\nlong_query_time = 1\n"; regenerated != want {
+		t.Errorf("regenerated my.cnf = %q, want %q", regenerated, want)
+	}
+
+	// Without a my.cnf, the variables cannot be persisted.
+	if _, _, err := ApplyMysqlVariables(ctx, vmd, nil, map[string]string{"max_connections": "300"}, false); err == nil {
+		t.Errorf("ApplyMysqlVariables without my.cnf succeeded")
+	}
+}
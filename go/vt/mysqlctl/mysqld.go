@@ -795,6 +795,13 @@ func (mysqld *Mysqld) initConfig(cnf *Mycnf, outFile string) error {
 		return err
 	}
 
+	// The variables changed by ApplyMysqlVariables survive the
+	// regenerations of the my.cnf.
+	configData, err = applyMycnfOverrides(cnf, configData)
+	if err != nil {
+		return err
+	}
+
 	return ioutil.WriteFile(outFile, []byte(configData), 0664)
 }
 
@@ -1110,6 +1117,11 @@ func (mysqld *Mysqld) GetAllPrivsConnection() (*dbconnpool.DBConnection, error)
 	return dbconnpool.NewDBConnection(mysqld.dbcfgs.AllPrivsWithDB(), allprivsMysqlStats)
 }
 
+// DbaConnector is part of the MysqlDaemon interface.
+func (mysqld *Mysqld) DbaConnector() dbconfigs.Connector {
+	return mysqld.dbcfgs.Dba()
+}
+
 // Close will close this instance of Mysqld. It will wait for all dba
 // queries to be finished.
 func (mysqld *Mysqld) Close() {
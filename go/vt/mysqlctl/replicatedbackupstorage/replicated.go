@@ -0,0 +1,406 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replicatedbackupstorage implements the BackupStorage interface
+// on top of other implementations: the backups are written to a primary
+// storage, and copied to the secondary storages once they are complete.
+// They are read from whichever storage has them.
+package replicatedbackupstorage
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+var (
+	primaryName     = flag.String("replicated_backup_storage_primary", "", "name of the backup storage the replicated backup storage writes the backups to, for instance file or s3. The implementations taking a parameter are named <implementation>:<parameter>, for instance file:/backups")
+	secondaryNames  = flag.String("replicated_backup_storage_secondaries", "", "comma separated names of the backup storages the replicated backup storage copies the complete backups to")
+	copyTimeout     = flag.Duration("replicated_backup_storage_copy_timeout", 12*time.Hour, "how long the replicated backup storage tries to copy a backup to a secondary storage")
+	replicateCopies = stats.NewCountersWithSingleLabel("ReplicatedBackupCopies", "Copies of the backups to the secondary backup storages, by result", "Result")
+)
+
+// ReplicatedBackupStorage implements BackupStorage on top of a primary
+// and secondary storages.
+type ReplicatedBackupStorage struct {
+	// fromFlags is true if the storages are read from the flags,
+	// the first time they are used.
+	fromFlags bool
+
+	// mu guards all fields below.
+	mu          sync.Mutex
+	primary     backupstorage.BackupStorage
+	secondaries []backupstorage.BackupStorage
+	// names are the names of the primary and secondaries, for logging.
+	names []string
+	// pendingCopies is the number of copies running, and closeRequested
+	// is set if Close was called while they run. The storages are then
+	// closed at the end of the last copy.
+	pendingCopies  int
+	closeRequested bool
+	copiesDone     *sync.Cond
+	// copyErr is the first error of the copies since the last
+	// WaitForCopies.
+	copyErr error
+}
+
+// NewReplicatedBackupStorage returns a ReplicatedBackupStorage writing
+// to primary, and copying the complete backups to the secondaries.
+func NewReplicatedBackupStorage(primary backupstorage.BackupStorage, secondaries ...backupstorage.BackupStorage) *ReplicatedBackupStorage {
+	rbs := &ReplicatedBackupStorage{
+		primary:     primary,
+		secondaries: secondaries,
+		names:       []string{"primary"},
+	}
+	for i := range secondaries {
+		rbs.names = append(rbs.names, fmt.Sprintf("secondary %v", i+1))
+	}
+	rbs.copiesDone = sync.NewCond(&rbs.mu)
+	return rbs
+}
+
+// storages returns the primary and the secondaries.
+func (rbs *ReplicatedBackupStorage) storages() (backupstorage.BackupStorage, []backupstorage.BackupStorage, error) {
+	rbs.mu.Lock()
+	defer rbs.mu.Unlock()
+	if rbs.primary == nil && rbs.fromFlags {
+		if *primaryName == "" {
+			return nil, nil, fmt.Errorf("-replicated_backup_storage_primary is not set")
+		}
+		names := []string{*primaryName}
+		if *secondaryNames != "" {
+			names = append(names, strings.Split(*secondaryNames, ",")...)
+		}
+		var storages []backupstorage.BackupStorage
+		for _, name := range names {
+			if name == "replicated" {
+				return nil, nil, fmt.Errorf("the replicated backup storage cannot replicate itself")
+			}
+			bs, err := backupstorage.GetBackupStorageByName(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			storages = append(storages, bs)
+		}
+		rbs.primary, rbs.secondaries, rbs.names = storages[0], storages[1:], names
+	}
+	return rbs.primary, rbs.secondaries, nil
+}
+
+// ListBackups is part of the backupstorage.BackupStorage interface.
+// It lists the backups of all the storages: each backup reads its files
+// from the first storage that has them, the primary first. The storages
+// which cannot be listed are skipped, unless none can be listed.
+func (rbs *ReplicatedBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
+	primary, secondaries, err := rbs.storages()
+	if err != nil {
+		return nil, err
+	}
+	storages := append([]backupstorage.BackupStorage{primary}, secondaries...)
+
+	byName := make(map[string]*ReplicatedBackupHandle)
+	var firstErr error
+	listed := 0
+	for i, bs := range storages {
+		bhs, err := bs.ListBackups(ctx, dir)
+		if err != nil {
+			log.Warningf("Cannot list the backups of %v in the %v backup storage, skipping it: %v", dir, rbs.names[i], err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		listed++
+		for _, bh := range bhs {
+			rbh, ok := byName[bh.Name()]
+			if !ok {
+				rbh = &ReplicatedBackupHandle{
+					rbs:      rbs,
+					dir:      dir,
+					name:     bh.Name(),
+					readOnly: true,
+				}
+				byName[bh.Name()] = rbh
+			}
+			rbh.handles = append(rbh.handles, bh)
+		}
+	}
+	if listed == 0 {
+		return nil, firstErr
+	}
+
+	// Backups must be returned in order, oldest first.
+	result := make([]backupstorage.BackupHandle, 0, len(byName))
+	for _, rbh := range byName {
+		result = append(result, rbh)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+// StartBackup is part of the backupstorage.BackupStorage interface.
+// The backup is written to the primary storage.
+func (rbs *ReplicatedBackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	primary, _, err := rbs.storages()
+	if err != nil {
+		return nil, err
+	}
+	bh, err := primary.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplicatedBackupHandle{
+		rbs:     rbs,
+		dir:     dir,
+		name:    name,
+		handles: []backupstorage.BackupHandle{bh},
+	}, nil
+}
+
+// RemoveBackup is part of the backupstorage.BackupStorage interface.
+// The backup is removed from all the storages. Only the errors of the
+// primary storage are returned, the others are logged.
+func (rbs *ReplicatedBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	primary, secondaries, err := rbs.storages()
+	if err != nil {
+		return err
+	}
+	for i, bs := range secondaries {
+		if err := bs.RemoveBackup(ctx, dir, name); err != nil {
+			log.Warningf("Cannot remove backup %v/%v from the %v backup storage: %v", dir, name, rbs.names[i+1], err)
+		}
+	}
+	return primary.RemoveBackup(ctx, dir, name)
+}
+
+// Close is part of the backupstorage.BackupStorage interface.
+// If backups are being copied, the storages are closed after the copies.
+// The storages read from the flags are dropped once closed, so they are
+// read again by the next use, and a second Close does nothing.
+func (rbs *ReplicatedBackupStorage) Close() error {
+	rbs.mu.Lock()
+	defer rbs.mu.Unlock()
+	if rbs.pendingCopies > 0 {
+		rbs.closeRequested = true
+		return nil
+	}
+	return rbs.closeLocked()
+}
+
+func (rbs *ReplicatedBackupStorage) closeLocked() error {
+	if rbs.primary == nil {
+		return nil
+	}
+	var firstErr error
+	for _, bs := range append([]backupstorage.BackupStorage{rbs.primary}, rbs.secondaries...) {
+		if err := bs.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if rbs.fromFlags {
+		rbs.primary, rbs.secondaries = nil, nil
+	}
+	return firstErr
+}
+
+// WaitForCopies waits until the complete backups are copied to the
+// secondary storages, or the context is done. It returns the first error
+// of the copies since the last call. Processes should call it before
+// exiting, so their last backups are copied.
+func (rbs *ReplicatedBackupStorage) WaitForCopies(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		rbs.mu.Lock()
+		for rbs.pendingCopies > 0 {
+			rbs.copiesDone.Wait()
+		}
+		rbs.mu.Unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	rbs.mu.Lock()
+	defer rbs.mu.Unlock()
+	err := rbs.copyErr
+	rbs.copyErr = nil
+	return err
+}
+
+// replicate copies a complete backup from the primary storage to the
+// secondaries, in the background.
+func (rbs *ReplicatedBackupStorage) replicate(dir, name string) {
+	rbs.mu.Lock()
+	primary, secondaries, names := rbs.primary, rbs.secondaries, rbs.names
+	rbs.pendingCopies += len(secondaries)
+	rbs.mu.Unlock()
+
+	for i, secondary := range secondaries {
+		go func(secondary backupstorage.BackupStorage, secondaryName string) {
+			ctx, cancel := context.WithTimeout(context.Background(), *copyTimeout)
+			defer cancel()
+			start := time.Now()
+			_, err := mysqlctl.SyncBackups(ctx, primary, secondary, dir, []string{name}, logutil.NewConsoleLogger())
+			if err != nil {
+				err = fmt.Errorf("cannot copy backup %v/%v to the %v backup storage: %v", dir, name, secondaryName, err)
+			}
+			rbs.copyDone(err)
+			if err != nil {
+				log.Error(err)
+				replicateCopies.Add("Failure", 1)
+				return
+			}
+			log.Infof("Copied backup %v/%v to the %v backup storage in %v", dir, name, secondaryName, time.Since(start))
+			replicateCopies.Add("Success", 1)
+		}(secondary, names[i+1])
+	}
+}
+
+func (rbs *ReplicatedBackupStorage) copyDone(err error) {
+	rbs.mu.Lock()
+	defer rbs.mu.Unlock()
+	if err != nil && rbs.copyErr == nil {
+		rbs.copyErr = err
+	}
+	rbs.pendingCopies--
+	if rbs.pendingCopies > 0 {
+		return
+	}
+	rbs.copiesDone.Broadcast()
+	if rbs.closeRequested {
+		rbs.closeRequested = false
+		if err := rbs.closeLocked(); err != nil {
+			log.Warningf("Cannot close the backup storages after the copies: %v", err)
+		}
+	}
+}
+
+// ReplicatedBackupHandle implements BackupHandle on top of the handles
+// of the copies of a backup.
+type ReplicatedBackupHandle struct {
+	rbs  *ReplicatedBackupStorage
+	dir  string
+	name string
+	// handles are the handles of the copies of the backup, in order
+	// of preference. A read-write backup only has the primary handle.
+	handles  []backupstorage.BackupHandle
+	readOnly bool
+}
+
+// Directory is part of the backupstorage.BackupHandle interface.
+func (rbh *ReplicatedBackupHandle) Directory() string {
+	return rbh.dir
+}
+
+// Name is part of the backupstorage.BackupHandle interface.
+func (rbh *ReplicatedBackupHandle) Name() string {
+	return rbh.name
+}
+
+// AddFile is part of the backupstorage.BackupHandle interface.
+func (rbh *ReplicatedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	if rbh.readOnly {
+		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
+	}
+	return rbh.handles[0].AddFile(ctx, filename, filesize)
+}
+
+// EndBackup is part of the backupstorage.BackupHandle interface.
+// Once the backup is complete in the primary storage, it is copied
+// to the secondaries in the background.
+func (rbh *ReplicatedBackupHandle) EndBackup(ctx context.Context) error {
+	if rbh.readOnly {
+		return fmt.Errorf("EndBackup cannot be called on read-only backup")
+	}
+	if err := rbh.handles[0].EndBackup(ctx); err != nil {
+		return err
+	}
+	rbh.rbs.replicate(rbh.dir, rbh.name)
+	return nil
+}
+
+// AbortBackup is part of the backupstorage.BackupHandle interface.
+func (rbh *ReplicatedBackupHandle) AbortBackup(ctx context.Context) error {
+	if rbh.readOnly {
+		return fmt.Errorf("AbortBackup cannot be called on read-only backup")
+	}
+	return rbh.handles[0].AbortBackup(ctx)
+}
+
+// ReadFile is part of the backupstorage.BackupHandle interface.
+// It reads the file from the first copy which can open it.
+func (rbh *ReplicatedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	if !rbh.readOnly {
+		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
+	}
+	var firstErr error
+	for _, bh := range rbh.handles {
+		rc, err := bh.ReadFile(ctx, filename)
+		if err == nil {
+			return rc, nil
+		}
+		log.Warningf("Cannot read %v of backup %v/%v from one of its copies, trying the next one: %v", filename, rbh.dir, rbh.name, err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// ListFiles is part of the backupstorage.BackupHandle interface.
+// It lists the files of the first copy which can be listed.
+func (rbh *ReplicatedBackupHandle) ListFiles(ctx context.Context) ([]string, error) {
+	if !rbh.readOnly {
+		return nil, fmt.Errorf("ListFiles cannot be called on read-write backup")
+	}
+	var firstErr error
+	for _, bh := range rbh.handles {
+		files, err := bh.ListFiles(ctx)
+		if err == nil {
+			return files, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+var _ backupstorage.BackupStorage = (*ReplicatedBackupStorage)(nil)
+var _ backupstorage.BackupHandle = (*ReplicatedBackupHandle)(nil)
+
+func init() {
+	rbs := &ReplicatedBackupStorage{fromFlags: true}
+	rbs.copiesDone = sync.NewCond(&rbs.mu)
+	backupstorage.BackupStorageMap["replicated"] = rbs
+}
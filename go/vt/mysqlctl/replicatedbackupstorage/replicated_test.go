@@ -0,0 +1,267 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicatedbackupstorage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const testDir = "keyspace/shard"
+
+// setupStorages returns a replicated storage on top of two file
+// storages, and their root directories.
+func setupStorages(t *testing.T) (*ReplicatedBackupStorage, string, string) {
+	primaryRoot, err := ioutil.TempDir("", "rbstest-primary")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	secondaryRoot, err := ioutil.TempDir("", "rbstest-secondary")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	rbs := NewReplicatedBackupStorage(filebackupstorage.NewFileBackupStorage(primaryRoot), filebackupstorage.NewFileBackupStorage(secondaryRoot))
+	return rbs, primaryRoot, secondaryRoot
+}
+
+// writeBackup writes a backup with a data file and a MANIFEST.
+func writeBackup(t *testing.T, bs backupstorage.BackupStorage, name string) {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, testDir, name)
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	for _, file := range []string{"0", "MANIFEST"} {
+		wc, err := bh.AddFile(ctx, file, backupstorage.FileSizeUnknown)
+		if err != nil {
+			t.Fatalf("AddFile failed: %v", err)
+		}
+		if _, err := wc.Write([]byte("contents of " + file)); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		if err := wc.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+}
+
+func readFile(t *testing.T, bs backupstorage.BackupStorage, name, file string) string {
+	ctx := context.Background()
+	bhs, err := bs.ListBackups(ctx, testDir)
+	if err != nil {
+		t.Fatalf("ListBackups failed: %v", err)
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		rc, err := bh.ReadFile(ctx, file)
+		if err != nil {
+			t.Fatalf("ReadFile(%v) failed: %v", file, err)
+		}
+		defer rc.Close()
+		data, err := ioutil.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("ReadAll failed: %v", err)
+		}
+		return string(data)
+	}
+	t.Fatalf("no backup %v", name)
+	return ""
+}
+
+func TestReplicatedBackupStorage(t *testing.T) {
+	rbs, primaryRoot, secondaryRoot := setupStorages(t)
+	defer os.RemoveAll(primaryRoot)
+	defer os.RemoveAll(secondaryRoot)
+	ctx := context.Background()
+	secondary := filebackupstorage.NewFileBackupStorage(secondaryRoot)
+
+	// A complete backup is copied to the secondary.
+	writeBackup(t, rbs, "backup1")
+	if err := rbs.WaitForCopies(ctx); err != nil {
+		t.Fatalf("WaitForCopies failed: %v", err)
+	}
+	bhs, err := secondary.ListBackups(ctx, testDir)
+	if err != nil || len(bhs) != 1 || bhs[0].Name() != "backup1" {
+		t.Fatalf("secondary ListBackups returned %v %v", bhs, err)
+	}
+	files, err := bhs[0].ListFiles(ctx)
+	if err != nil || !reflect.DeepEqual(files, []string{"0", "MANIFEST"}) {
+		t.Fatalf("secondary ListFiles returned %v %v", files, err)
+	}
+	if got := readFile(t, secondary, "backup1", "0"); got != "contents of 0" {
+		t.Errorf("secondary file 0 = %q", got)
+	}
+
+	// An aborted backup is not.
+	bh, err := rbs.StartBackup(ctx, testDir, "backup2")
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	if err := bh.AbortBackup(ctx); err != nil {
+		t.Fatalf("AbortBackup failed: %v", err)
+	}
+	if err := rbs.WaitForCopies(ctx); err != nil {
+		t.Fatalf("WaitForCopies failed: %v", err)
+	}
+	if bhs, err := rbs.ListBackups(ctx, testDir); err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups after abort returned %v %v", bhs, err)
+	}
+
+	// The backups are read from the secondary when the primary lost them.
+	if err := os.RemoveAll(path.Join(primaryRoot, testDir, "backup1", "0")); err != nil {
+		t.Fatalf("RemoveAll failed: %v", err)
+	}
+	if got := readFile(t, rbs, "backup1", "0"); got != "contents of 0" {
+		t.Errorf("file 0 read back as %q", got)
+	}
+	if err := os.RemoveAll(primaryRoot); err != nil {
+		t.Fatalf("RemoveAll failed: %v", err)
+	}
+	if got := readFile(t, rbs, "backup1", "MANIFEST"); got != "contents of MANIFEST" {
+		t.Errorf("MANIFEST read back as %q", got)
+	}
+
+	// The backups are removed from all the storages.
+	if err := rbs.RemoveBackup(ctx, testDir, "backup1"); err != nil {
+		t.Fatalf("RemoveBackup failed: %v", err)
+	}
+	if bhs, err := rbs.ListBackups(ctx, testDir); err != nil || len(bhs) != 0 {
+		t.Fatalf("ListBackups after RemoveBackup returned %v %v", bhs, err)
+	}
+}
+
+func TestReplicatedBackupStorageClose(t *testing.T) {
+	rbs, primaryRoot, secondaryRoot := setupStorages(t)
+	defer os.RemoveAll(primaryRoot)
+	defer os.RemoveAll(secondaryRoot)
+
+	// Closing while a copy runs doesn't stop it.
+	rbs.mu.Lock()
+	rbs.pendingCopies++
+	rbs.mu.Unlock()
+	if err := rbs.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if !rbs.closeRequested {
+		t.Errorf("Close didn't wait for the copy")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := rbs.WaitForCopies(ctx); err == nil {
+		t.Errorf("WaitForCopies didn't wait for the copy")
+	}
+	rbs.copyDone(fmt.Errorf("copy failed"))
+	if rbs.closeRequested {
+		t.Errorf("the end of the copy didn't close the storages")
+	}
+	if err := rbs.WaitForCopies(context.Background()); err == nil || err.Error() != "copy failed" {
+		t.Errorf("WaitForCopies returned %v, want the copy error", err)
+	}
+	if err := rbs.WaitForCopies(context.Background()); err != nil {
+		t.Errorf("second WaitForCopies returned %v", err)
+	}
+}
+
+func TestReplicatedBackupStorageFlags(t *testing.T) {
+	primaryRoot, err := ioutil.TempDir("", "rbstest-primary")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(primaryRoot)
+	secondaryRoot, err := ioutil.TempDir("", "rbstest-secondary")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(secondaryRoot)
+	*primaryName = "file:" + primaryRoot
+	*secondaryNames = "file:" + secondaryRoot
+	rbs := backupstorage.BackupStorageMap["replicated"].(*ReplicatedBackupStorage)
+	defer func() {
+		*primaryName = ""
+		*secondaryNames = ""
+		rbs.primary, rbs.secondaries = nil, nil
+	}()
+
+	writeBackup(t, rbs, "backup1")
+	if err := rbs.WaitForCopies(context.Background()); err != nil {
+		t.Fatalf("WaitForCopies failed: %v", err)
+	}
+	if _, err := os.Stat(path.Join(secondaryRoot, testDir, "backup1", "MANIFEST")); err != nil {
+		t.Errorf("backup1 was not copied to the secondary: %v", err)
+	}
+
+	// The storages are dropped once closed, and read again after.
+	for i := 0; i < 2; i++ {
+		if err := rbs.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		if rbs.primary != nil || rbs.secondaries != nil {
+			t.Fatalf("Close kept the storages")
+		}
+	}
+	if bhs, err := rbs.ListBackups(context.Background(), testDir); err != nil || len(bhs) != 1 {
+		t.Errorf("ListBackups after Close returned %v %v", bhs, err)
+	}
+}
+
+func TestReplicatedBackupStorageVerification(t *testing.T) {
+	rbs, primaryRoot, secondaryRoot := setupStorages(t)
+	defer os.RemoveAll(primaryRoot)
+	defer os.RemoveAll(secondaryRoot)
+	ctx := context.Background()
+
+	// A verification has no MANIFEST, it is complete with its VERIFICATION.
+	bh, err := rbs.StartBackup(ctx, testDir, "backup1")
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	wc, err := bh.AddFile(ctx, "VERIFICATION", backupstorage.FileSizeUnknown)
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	if _, err := wc.Write([]byte("contents of VERIFICATION")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+	if err := rbs.WaitForCopies(ctx); err != nil {
+		t.Fatalf("WaitForCopies failed: %v", err)
+	}
+	secondary := filebackupstorage.NewFileBackupStorage(secondaryRoot)
+	if got := readFile(t, secondary, "backup1", "VERIFICATION"); got != "contents of VERIFICATION" {
+		t.Errorf("secondary VERIFICATION = %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+)
+
+// This file has the helpers to restore single tables of a backup.
+// The backup is restored into a scratch mysqld, with RestoreParams.Tables
+// for the engines which can restore only some tables, and the tables
+// are then copied into the target shard under a new name.
+
+// restoredTableSuffix is appended to the name of restored tables when
+// no new name is given.
+const restoredTableSuffix = "_restored"
+
+// TableRestore is a table to restore from a backup, and the name of the
+// table created for it in the target database.
+type TableRestore struct {
+	Name    string
+	NewName string
+}
+
+// ParseTableRestores parses a comma-separated list of tables to restore.
+// Each table is either "name" or "name:new_name". The default new name
+// is the name followed by "_restored", so the restored table doesn't
+// collide with the table it is restored from.
+func ParseTableRestores(list string) ([]TableRestore, error) {
+	var restores []TableRestore
+	newNames := make(map[string]bool)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		tr := TableRestore{Name: parts[0]}
+		switch len(parts) {
+		case 1:
+			tr.NewName = tr.Name + restoredTableSuffix
+		case 2:
+			tr.NewName = parts[1]
+		default:
+			return nil, fmt.Errorf("invalid table to restore %q, expected name or name:new_name", entry)
+		}
+		if tr.Name == "" || tr.NewName == "" {
+			return nil, fmt.Errorf("invalid table to restore %q, expected name or name:new_name", entry)
+		}
+		if newNames[tr.NewName] {
+			return nil, fmt.Errorf("table %v is restored more than once", tr.NewName)
+		}
+		newNames[tr.NewName] = true
+		restores = append(restores, tr)
+	}
+	if len(restores) == 0 {
+		return nil, fmt.Errorf("no table to restore in %q", list)
+	}
+	return restores, nil
+}
+
+// RenameTableSchema returns the CREATE TABLE statement of a table, as
+// returned by GetSchema, with the new name of the table.
+func RenameTableSchema(schema, name, newName string) (string, error) {
+	prefix := "CREATE TABLE " + sqlescape.EscapeID(name) + " "
+	if !strings.HasPrefix(schema, prefix) {
+		return "", fmt.Errorf("unexpected schema for table %v: %v", name, schema)
+	}
+	return "CREATE TABLE " + sqlescape.EscapeID(newName) + " " + schema[len(prefix):], nil
+}
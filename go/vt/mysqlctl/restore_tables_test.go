@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTableRestores(t *testing.T) {
+	table := []struct {
+		list    string
+		want    []TableRestore
+		wantErr string
+	}{{
+		list: "t1",
+		want: []TableRestore{{Name: "t1", NewName: "t1_restored"}},
+	}, {
+		list: "t1:t1_old, t2",
+		want: []TableRestore{{Name: "t1", NewName: "t1_old"}, {Name: "t2", NewName: "t2_restored"}},
+	}, {
+		list:    "",
+		wantErr: `no table to restore in ""`,
+	}, {
+		list:    "t1:",
+		wantErr: `invalid table to restore "t1:", expected name or name:new_name`,
+	}, {
+		list:    "t1:a:b",
+		wantErr: `invalid table to restore "t1:a:b", expected name or name:new_name`,
+	}, {
+		list:    "t1:t3,t2:t3",
+		wantErr: "table t3 is restored more than once",
+	}}
+	for _, tcase := range table {
+		got, err := ParseTableRestores(tcase.list)
+		if tcase.wantErr != "" {
+			if err == nil || err.Error() != tcase.wantErr {
+				t.Errorf("ParseTableRestores(%q) = %v, want error %v", tcase.list, err, tcase.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("ParseTableRestores(%q) = %v, %v, want %v", tcase.list, got, err, tcase.want)
+		}
+	}
+}
+
+func TestRenameTableSchema(t *testing.T) {
+	schema := "CREATE TABLE `t1` (\n  `id` int(11) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	got, err := RenameTableSchema(schema, "t1", "t1_restored")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "CREATE TABLE `t1_restored` (\n  `id` int(11) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	if got != want {
+		t.Errorf("RenameTableSchema() = %v, want %v", got, want)
+	}
+
+	if _, err := RenameTableSchema("CREATE VIEW `t1` AS select 1", "t1", "t2"); err == nil {
+		t.Errorf("RenameTableSchema() of a view should fail")
+	}
+}
@@ -181,6 +181,36 @@ func (bh *S3BackupHandle) ReadFile(ctx context.Context, filename string) (io.Rea
 	return out.Body, nil
 }
 
+// ListFiles is part of the backupstorage.BackupHandle interface.
+func (bh *S3BackupHandle) ListFiles(ctx context.Context) ([]string, error) {
+	if !bh.readOnly {
+		return nil, fmt.Errorf("ListFiles cannot be called on read-write backup")
+	}
+	searchPrefix := objName(bh.dir, bh.name, "")
+	query := &s3.ListObjectsV2Input{
+		Bucket: bucket,
+		Prefix: searchPrefix,
+	}
+
+	var files []string
+	for {
+		objs, err := bh.client.ListObjectsV2(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs.Contents {
+			files = append(files, strings.TrimPrefix(*obj.Key, *searchPrefix))
+		}
+
+		if objs.NextContinuationToken == nil {
+			break
+		}
+		query.ContinuationToken = objs.NextContinuationToken
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
 var _ backupstorage.BackupHandle = (*S3BackupHandle)(nil)
 
 // S3BackupStorage implements the backupstorage.BackupStorage interface.
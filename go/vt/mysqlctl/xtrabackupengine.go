@@ -31,7 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -90,7 +89,7 @@ type xtraBackupManifest struct {
 	// StripeBlockSize is the size in bytes of each stripe block.
 	StripeBlockSize int32
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
@@ -103,8 +102,12 @@ func (be *XtrabackupEngine) backupFileName() string {
 		fileName += "."
 		fileName += *xtrabackupStreamMode
 	}
-	if *backupStorageCompress {
+	switch codec := BackupCompressionCodec(); codec {
+	case NoCompressionCodec:
+	case GzipCompressionCodec:
 		fileName += ".gz"
+	default:
+		fileName += "." + codec
 	}
 	return fileName
 }
@@ -172,12 +175,15 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 			Position:     replicationPosition,
 			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
 			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Encryption:   GetBackupEncryption(bh),
+
+			CompressionCodec: BackupCompressionCodec(),
 		},
 
 		// XtraBackup-specific fields
 		FileName:        backupFileName,
 		StreamMode:      *xtrabackupStreamMode,
-		SkipCompress:    !*backupStorageCompress,
+		SkipCompress:    BackupCompressionCodec() == NoCompressionCodec,
 		Params:          *xtrabackupBackupFlags,
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
@@ -267,24 +273,18 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
-	destCompressors := []*pgzip.Writer{}
+	destCompressors := []io.WriteCloser{}
 	for _, file := range destFiles {
 		buffer := bufio.NewWriterSize(file, writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
-		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
-		if *backupStorageCompress {
-			compressor, err := pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
-			if err != nil {
-				return replicationPosition, vterrors.Wrap(err, "cannot create gzip compressor")
-			}
-			compressor.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-			writer = compressor
-			destCompressors = append(destCompressors, compressor)
+		// Create the compression pipe.
+		compressor, err := NewCompressor(BackupCompressionCodec(), buffer)
+		if err != nil {
+			return replicationPosition, vterrors.Wrap(err, "cannot create compressor")
 		}
-
-		destWriters = append(destWriters, writer)
+		destCompressors = append(destCompressors, compressor)
+		destWriters = append(destWriters, compressor)
 	}
 
 	if err = backupCmd.Start(); err != nil {
@@ -322,7 +322,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		}
 	}()
 
-	// Copy from the stream output to destination file (optional gzip)
+	// Copy from the stream output to destination file (compressor)
 	blockSize := int64(*xtrabackupStripeBlockSize)
 	if blockSize < 1024 {
 		// Enforce minimum block size.
@@ -331,7 +331,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Add a buffer in front of the raw stdout pipe so io.CopyN() can use the
 	// buffered reader's WriteTo() method instead of allocating a new buffer
 	// every time.
-	backupOutBuf := bufio.NewReaderSize(backupOut, int(blockSize))
+	backupOutBuf := bufio.NewReaderSize(NewThrottledReader(ctx, backupOut), int(blockSize))
 	if _, err := copyToStripes(destWriters, backupOutBuf, blockSize); err != nil {
 		return replicationPosition, vterrors.Wrap(err, "cannot copy output from xtrabackup command")
 	}
@@ -339,7 +339,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -372,12 +372,12 @@ func (be *XtrabackupEngine) ExecuteRestore(ctx context.Context, params RestorePa
 
 	var bm xtraBackupManifest
 
-	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+	if err := GetBackupManifestInto(ctx, bh, &bm); err != nil {
 		return nil, err
 	}
 
 	// mark restore as in progress
-	if err := createStateFile(params.Cnf); err != nil {
+	if err := CreateStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
 
@@ -492,7 +492,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	// Pull details from the MANIFEST where available, so we can still restore
 	// backups taken with different flags. Some fields were not always present,
 	// so if necessary we default to the flag values.
-	compressed := !bm.SkipCompress
+	compressionCodec := ManifestCompressionCodec(&bm.BackupManifest, bm.SkipCompress)
 	streamMode := bm.StreamMode
 	if streamMode == "" {
 		streamMode = *xtrabackupStreamMode
@@ -514,31 +514,25 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	}()
 
 	srcReaders := []io.Reader{}
-	srcDecompressors := []*pgzip.Reader{}
-	for _, file := range srcFiles {
-		reader := io.Reader(file)
-
-		// Create the decompressor if needed.
-		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
-			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
-			}
-			srcDecompressors = append(srcDecompressors, decompressor)
-			reader = decompressor
-		}
-
-		srcReaders = append(srcReaders, reader)
-	}
+	srcDecompressors := []io.ReadCloser{}
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
+	for _, file := range srcFiles {
+		// Create the decompressor.
+		decompressor, err := NewDecompressor(compressionCodec, file)
+		if err != nil {
+			return vterrors.Wrap(err, "can't create decompressor")
+		}
+		srcDecompressors = append(srcDecompressors, decompressor)
+		srcReaders = append(srcReaders, decompressor)
+	}
 
-	reader := stripeReader(srcReaders, int64(bm.StripeBlockSize))
+	reader := NewThrottledReader(ctx, stripeReader(srcReaders, int64(bm.StripeBlockSize)))
 
 	switch streamMode {
 	case streamModeTar:
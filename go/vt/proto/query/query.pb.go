@@ -888,7 +888,12 @@ type ExecuteOptions struct {
 	TransactionIsolation ExecuteOptions_TransactionIsolation `protobuf:"varint,9,opt,name=transaction_isolation,json=transactionIsolation,proto3,enum=query.ExecuteOptions_TransactionIsolation" json:"transaction_isolation,omitempty"`
 	// skip_query_plan_cache specifies if the query plan should be cached by vitess.
 	// By default all query plans are cached.
-	SkipQueryPlanCache   bool     `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	// session_track_gtids asks vttablet to track the GTIDs of the
+	// transactions it commits on behalf of the query, and return them
+	// in QueryResult.session_state_changes. This is only possible
+	// when vttablet commits the transaction itself (autocommit).
+	SessionTrackGtids    bool     `protobuf:"varint,11,opt,name=session_track_gtids,json=sessionTrackGtids,proto3" json:"session_track_gtids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -975,6 +980,13 @@ func (m *ExecuteOptions) GetSkipQueryPlanCache() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetSessionTrackGtids() bool {
+	if m != nil {
+		return m.SessionTrackGtids
+	}
+	return false
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -1212,14 +1224,18 @@ func (m *ResultExtras) GetFresher() bool {
 // len(QueryResult[0].fields) is always equal to len(row) (for each
 // row in rows for each QueryResult in QueryResult[1:]).
 type QueryResult struct {
-	Fields               []*Field      `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	RowsAffected         uint64        `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
-	InsertId             uint64        `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
-	Rows                 []*Row        `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
-	Extras               *ResultExtras `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Fields       []*Field      `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	RowsAffected uint64        `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	InsertId     uint64        `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
+	Rows         []*Row        `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Extras       *ResultExtras `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
+	// session_state_changes contains the GTIDs reported by MySQL
+	// through session tracking, if requested by
+	// ExecuteOptions.session_track_gtids.
+	SessionStateChanges  string   `protobuf:"bytes,6,opt,name=session_state_changes,json=sessionStateChanges,proto3" json:"session_state_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *QueryResult) Reset()         { *m = QueryResult{} }
@@ -1282,6 +1298,13 @@ func (m *QueryResult) GetExtras() *ResultExtras {
 	return nil
 }
 
+func (m *QueryResult) GetSessionStateChanges() string {
+	if m != nil {
+		return m.SessionStateChanges
+	}
+	return ""
+}
+
 // QueryWarning is used to convey out of band query execution warnings
 // by storing in the vtgate.Session
 type QueryWarning struct {
@@ -2048,6 +2071,10 @@ func (m *CommitRequest) GetTransactionId() int64 {
 
 // CommitResponse is the returned value from Commit
 type CommitResponse struct {
+	// session_state_changes contains the GTIDs reported by MySQL
+	// for the commit, if ExecuteOptions.session_track_gtids was set
+	// when the transaction began.
+	SessionStateChanges  string   `protobuf:"bytes,1,opt,name=session_state_changes,json=sessionStateChanges,proto3" json:"session_state_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2078,6 +2105,13 @@ func (m *CommitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
 
+func (m *CommitResponse) GetSessionStateChanges() string {
+	if m != nil {
+		return m.SessionStateChanges
+	}
+	return ""
+}
+
 // RollbackRequest is the payload to Rollback
 type RollbackRequest struct {
 	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
@@ -3733,7 +3767,11 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_schema_changed lists the tables that were created, altered
+	// or dropped since the last health broadcast. It is only set on the
+	// broadcast that immediately follows a schema reload on the tablet.
+	TableSchemaChanged   []string `protobuf:"bytes,7,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3806,6 +3844,13 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4253,209 +4298,213 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0xdb, 0x48,
-	0x7a, 0x37, 0xf8, 0x12, 0xf9, 0x51, 0xa4, 0x5a, 0x4d, 0xc9, 0xe6, 0x68, 0x5e, 0x5a, 0xec, 0xce,
-	0xae, 0xa2, 0xdd, 0xc8, 0x1e, 0x8d, 0xd7, 0x71, 0x66, 0x37, 0x89, 0x21, 0x0a, 0xf2, 0x70, 0x4c,
-	0x82, 0x74, 0x13, 0xb4, 0xd7, 0x53, 0x5b, 0x85, 0x82, 0xc8, 0x36, 0x85, 0x12, 0x08, 0xd0, 0x00,
-	0x28, 0x59, 0x37, 0x27, 0x9b, 0xcd, 0xfb, 0x31, 0x79, 0x4e, 0x36, 0xa9, 0x4c, 0x52, 0x95, 0x43,
-	0x2a, 0x97, 0xfc, 0x0d, 0xa9, 0x1c, 0x72, 0xcc, 0x2d, 0x87, 0x24, 0x87, 0x9c, 0x52, 0xb9, 0x6d,
-	0xe5, 0x94, 0x43, 0x0e, 0xa9, 0x54, 0x3f, 0x00, 0x82, 0x12, 0x67, 0xec, 0x75, 0x72, 0x91, 0x67,
-	0x6e, 0xdd, 0xdf, 0xf7, 0xf5, 0xe3, 0xf7, 0xfb, 0x3e, 0x7c, 0xdd, 0xe8, 0x6e, 0x28, 0x3f, 0x99,
-	0xd2, 0xe0, 0x6c, 0x67, 0x12, 0xf8, 0x91, 0x8f, 0xf3, 0xbc, 0xb2, 0x51, 0x8d, 0xfc, 0x89, 0x3f,
-	0xb4, 0x23, 0x5b, 0x88, 0x37, 0xca, 0x27, 0x51, 0x30, 0x19, 0x88, 0x8a, 0xfa, 0x43, 0x05, 0x0a,
-	0xa6, 0x1d, 0x8c, 0x68, 0x84, 0x37, 0xa0, 0x78, 0x4c, 0xcf, 0xc2, 0x89, 0x3d, 0xa0, 0x75, 0x65,
-	0x53, 0xd9, 0x2a, 0x91, 0xa4, 0x8e, 0xd7, 0x20, 0x1f, 0x1e, 0xd9, 0xc1, 0xb0, 0x9e, 0xe1, 0x0a,
-	0x51, 0xc1, 0xdf, 0x86, 0x72, 0x64, 0x1f, 0xba, 0x34, 0xb2, 0xa2, 0xb3, 0x09, 0xad, 0x67, 0x37,
-	0x95, 0xad, 0xea, 0xee, 0xda, 0x4e, 0x32, 0x9e, 0xc9, 0x95, 0xe6, 0xd9, 0x84, 0x12, 0x88, 0x92,
-	0x32, 0xc6, 0x90, 0x1b, 0x50, 0xd7, 0xad, 0xe7, 0x78, 0x5f, 0xbc, 0xac, 0xee, 0x43, 0xf5, 0x81,
-	0x79, 0xd7, 0x8e, 0x68, 0xc3, 0x76, 0x5d, 0x1a, 0x34, 0xf7, 0xd9, 0x74, 0xa6, 0x21, 0x0d, 0x3c,
-	0x7b, 0x9c, 0x4c, 0x27, 0xae, 0xe3, 0xab, 0x50, 0x18, 0x05, 0xfe, 0x74, 0x12, 0xd6, 0x33, 0x9b,
-	0xd9, 0xad, 0x12, 0x91, 0x35, 0xf5, 0xfb, 0x00, 0xfa, 0x09, 0xf5, 0x22, 0xd3, 0x3f, 0xa6, 0x1e,
-	0x7e, 0x03, 0x4a, 0x91, 0x33, 0xa6, 0x61, 0x64, 0x8f, 0x27, 0xbc, 0x8b, 0x2c, 0x99, 0x09, 0x3e,
-	0x03, 0xd2, 0x06, 0x14, 0x27, 0x7e, 0xe8, 0x44, 0x8e, 0xef, 0x71, 0x3c, 0x25, 0x92, 0xd4, 0xd5,
-	0x9f, 0x87, 0xfc, 0x03, 0xdb, 0x9d, 0x52, 0xfc, 0x36, 0xe4, 0x38, 0x60, 0x85, 0x03, 0x2e, 0xef,
-	0x08, 0xd2, 0x39, 0x4e, 0xae, 0x60, 0x7d, 0x9f, 0x30, 0x4b, 0xde, 0xf7, 0x32, 0x11, 0x15, 0xf5,
-	0x18, 0x96, 0xf7, 0x1c, 0x6f, 0xf8, 0xc0, 0x0e, 0x1c, 0x46, 0xc6, 0x4b, 0x76, 0x83, 0xbf, 0x06,
-	0x05, 0x5e, 0x08, 0xeb, 0xd9, 0xcd, 0xec, 0x56, 0x79, 0x77, 0x59, 0x36, 0xe4, 0x73, 0x23, 0x52,
-	0xa7, 0xfe, 0xbd, 0x02, 0xb0, 0xe7, 0x4f, 0xbd, 0xe1, 0x7d, 0xa6, 0xc4, 0x08, 0xb2, 0xe1, 0x13,
-	0x57, 0x12, 0xc9, 0x8a, 0xf8, 0x1e, 0x54, 0x0f, 0x1d, 0x6f, 0x68, 0x9d, 0xc8, 0xe9, 0x08, 0x2e,
-	0xcb, 0xbb, 0x5f, 0x93, 0xdd, 0xcd, 0x1a, 0xef, 0xa4, 0x67, 0x1d, 0xea, 0x5e, 0x14, 0x9c, 0x91,
-	0xca, 0x61, 0x5a, 0xb6, 0xd1, 0x07, 0x7c, 0xd1, 0x88, 0x0d, 0x7a, 0x4c, 0xcf, 0xe2, 0x41, 0x8f,
-	0xe9, 0x19, 0xfe, 0xa9, 0x34, 0xa2, 0xf2, 0x6e, 0x2d, 0x1e, 0x2b, 0xd5, 0x56, 0xc2, 0x7c, 0x3f,
-	0x73, 0x5b, 0x51, 0xff, 0xa2, 0x00, 0x55, 0xfd, 0x29, 0x1d, 0x4c, 0x23, 0xda, 0x99, 0x30, 0x1f,
-	0x84, 0x78, 0x07, 0x6a, 0x8e, 0x37, 0x70, 0xa7, 0x43, 0x6a, 0x51, 0xe6, 0x6a, 0x2b, 0x62, 0xbe,
-	0xe6, 0xfd, 0x15, 0xc9, 0xaa, 0x54, 0xa5, 0x82, 0x40, 0x83, 0xda, 0xc0, 0x1f, 0x4f, 0xec, 0x60,
-	0xde, 0x3e, 0xcb, 0xc7, 0x5f, 0x95, 0xe3, 0xcf, 0xec, 0xc9, 0xaa, 0xb4, 0x4e, 0x75, 0xd1, 0x86,
-	0x15, 0xd9, 0xef, 0xd0, 0x7a, 0xec, 0x50, 0x77, 0x18, 0xf2, 0xd0, 0xad, 0x26, 0x54, 0xcd, 0x4f,
-	0x71, 0xa7, 0x29, 0x8d, 0x0f, 0xb8, 0x2d, 0xa9, 0x3a, 0x73, 0x75, 0xbc, 0x0d, 0xab, 0x03, 0xd7,
-	0x61, 0x53, 0x79, 0xcc, 0x28, 0xb6, 0x02, 0xff, 0x34, 0xac, 0xe7, 0xf9, 0xfc, 0x57, 0x84, 0xe2,
-	0x80, 0xc9, 0x89, 0x7f, 0x1a, 0xe2, 0xf7, 0xa1, 0x78, 0xea, 0x07, 0xc7, 0xae, 0x6f, 0x0f, 0xeb,
-	0x05, 0x3e, 0xe6, 0x5b, 0x8b, 0xc7, 0x7c, 0x28, 0xad, 0x48, 0x62, 0x8f, 0xb7, 0x00, 0x85, 0x4f,
-	0x5c, 0x2b, 0xa4, 0x2e, 0x1d, 0x44, 0x96, 0xeb, 0x8c, 0x9d, 0xa8, 0x5e, 0xe4, 0x5f, 0x41, 0x35,
-	0x7c, 0xe2, 0xf6, 0xb8, 0xb8, 0xc5, 0xa4, 0xd8, 0x82, 0xf5, 0x28, 0xb0, 0xbd, 0xd0, 0x1e, 0xb0,
-	0xce, 0x2c, 0x27, 0xf4, 0x5d, 0x9b, 0x7f, 0x01, 0x25, 0x3e, 0xe4, 0xf6, 0xe2, 0x21, 0xcd, 0x59,
-	0x93, 0x66, 0xdc, 0x82, 0xac, 0x45, 0x0b, 0xa4, 0xf8, 0x5d, 0x58, 0x0f, 0x8f, 0x9d, 0x89, 0xc5,
-	0xfb, 0xb1, 0x26, 0xae, 0xed, 0x59, 0x03, 0x7b, 0x70, 0x44, 0xeb, 0xc0, 0x61, 0x63, 0xa6, 0xe4,
-	0xa1, 0xd6, 0x75, 0x6d, 0xaf, 0xc1, 0x34, 0xea, 0x77, 0xa0, 0x3a, 0xcf, 0x23, 0x5e, 0x85, 0x8a,
-	0xf9, 0xa8, 0xab, 0x5b, 0x9a, 0xb1, 0x6f, 0x19, 0x5a, 0x5b, 0x47, 0x57, 0x70, 0x05, 0x4a, 0x5c,
-	0xd4, 0x31, 0x5a, 0x8f, 0x90, 0x82, 0x97, 0x20, 0xab, 0xb5, 0x5a, 0x28, 0xa3, 0xde, 0x86, 0x62,
-	0x4c, 0x08, 0x5e, 0x81, 0x72, 0xdf, 0xe8, 0x75, 0xf5, 0x46, 0xf3, 0xa0, 0xa9, 0xef, 0xa3, 0x2b,
-	0xb8, 0x08, 0xb9, 0x4e, 0xcb, 0xec, 0x22, 0x45, 0x94, 0xb4, 0x2e, 0xca, 0xb0, 0x96, 0xfb, 0x7b,
-	0x1a, 0xca, 0xaa, 0x7f, 0xad, 0xc0, 0xda, 0x22, 0x60, 0xb8, 0x0c, 0x4b, 0xfb, 0xfa, 0x81, 0xd6,
-	0x6f, 0x99, 0xe8, 0x0a, 0xae, 0xc1, 0x0a, 0xd1, 0xbb, 0xba, 0x66, 0x6a, 0x7b, 0x2d, 0xdd, 0x22,
-	0xba, 0xb6, 0x8f, 0x14, 0x8c, 0xa1, 0xca, 0x4a, 0x56, 0xa3, 0xd3, 0x6e, 0x37, 0x4d, 0x53, 0xdf,
-	0x47, 0x19, 0xbc, 0x06, 0x88, 0xcb, 0xfa, 0xc6, 0x4c, 0x9a, 0xc5, 0x08, 0x96, 0x7b, 0x3a, 0x69,
-	0x6a, 0xad, 0xe6, 0x47, 0xac, 0x03, 0x94, 0xc3, 0x5f, 0x81, 0x37, 0x1b, 0x1d, 0xa3, 0xd7, 0xec,
-	0x99, 0xba, 0x61, 0x5a, 0x3d, 0x43, 0xeb, 0xf6, 0x3e, 0xe8, 0x98, 0xbc, 0x67, 0x01, 0x2e, 0x8f,
-	0xab, 0x00, 0x5a, 0xdf, 0xec, 0x88, 0x7e, 0x50, 0xe1, 0xc3, 0x5c, 0x51, 0x41, 0x19, 0xf5, 0x93,
-	0x0c, 0xe4, 0x39, 0x3f, 0x2c, 0xab, 0xa6, 0x72, 0x25, 0x2f, 0x27, 0x19, 0x26, 0xf3, 0x39, 0x19,
-	0x86, 0x27, 0x66, 0x99, 0xeb, 0x44, 0x05, 0xbf, 0x0e, 0x25, 0x3f, 0x18, 0x59, 0x42, 0x23, 0xb2,
-	0x74, 0xd1, 0x0f, 0x46, 0x3c, 0x9d, 0xb3, 0x0c, 0xc9, 0x92, 0xfb, 0xa1, 0x1d, 0x52, 0x1e, 0xb5,
-	0x25, 0x92, 0xd4, 0xf1, 0x6b, 0xc0, 0xec, 0x2c, 0x3e, 0x8f, 0x02, 0xd7, 0x2d, 0xf9, 0xc1, 0xc8,
-	0x60, 0x53, 0xf9, 0x2a, 0x54, 0x06, 0xbe, 0x3b, 0x1d, 0x7b, 0x96, 0x4b, 0xbd, 0x51, 0x74, 0x54,
-	0x5f, 0xda, 0x54, 0xb6, 0x2a, 0x64, 0x59, 0x08, 0x5b, 0x5c, 0x86, 0xeb, 0xb0, 0x34, 0x38, 0xb2,
-	0x83, 0x90, 0x8a, 0x48, 0xad, 0x90, 0xb8, 0xca, 0x47, 0xa5, 0x03, 0x67, 0x6c, 0xbb, 0x21, 0x8f,
-	0xca, 0x0a, 0x49, 0xea, 0x0c, 0xc4, 0x63, 0xd7, 0x1e, 0x85, 0x3c, 0x9a, 0x2a, 0x44, 0x54, 0xd4,
-	0x9f, 0x81, 0x2c, 0xf1, 0x4f, 0x59, 0x97, 0x62, 0xc0, 0xb0, 0xae, 0x6c, 0x66, 0xb7, 0x30, 0x89,
-	0xab, 0x6c, 0x11, 0x91, 0x79, 0x54, 0xa4, 0xd7, 0x38, 0x73, 0x7e, 0x1f, 0x96, 0x09, 0x0d, 0xa7,
-	0x6e, 0xa4, 0x3f, 0x8d, 0x02, 0x3b, 0xc4, 0xbb, 0x50, 0x4e, 0x67, 0x0e, 0xe5, 0xb3, 0x32, 0x07,
-	0xd0, 0x59, 0xca, 0xa8, 0xc3, 0xd2, 0xe3, 0x80, 0x86, 0x47, 0x34, 0x90, 0x99, 0x29, 0xae, 0xb2,
-	0xbc, 0x5c, 0xe6, 0xa1, 0x2e, 0xc6, 0x60, 0xd9, 0x5c, 0xe6, 0x14, 0x65, 0x2e, 0x9b, 0x73, 0xa7,
-	0x12, 0xa9, 0x63, 0xec, 0xb1, 0x34, 0x61, 0xd9, 0x8f, 0x1f, 0xd3, 0x41, 0x44, 0xc5, 0xa2, 0x95,
-	0x23, 0xcb, 0x4c, 0xa8, 0x49, 0x19, 0x73, 0x9b, 0xe3, 0x85, 0x34, 0x88, 0x2c, 0x67, 0xc8, 0x1d,
-	0x9a, 0x23, 0x45, 0x21, 0x68, 0x0e, 0xf1, 0x5b, 0x90, 0xe3, 0x89, 0x26, 0xc7, 0x47, 0x01, 0x39,
-	0x0a, 0xf1, 0x4f, 0x09, 0x97, 0xe3, 0x6f, 0x42, 0x81, 0x72, 0xbc, 0xdc, 0xa9, 0xb3, 0xd4, 0x9c,
-	0xa6, 0x82, 0x48, 0x13, 0xf5, 0xbb, 0xb0, 0xcc, 0x31, 0x3c, 0xb4, 0x03, 0xcf, 0xf1, 0x46, 0x7c,
-	0x45, 0xf7, 0x87, 0x22, 0xf6, 0x2a, 0x84, 0x97, 0x19, 0x05, 0x63, 0x1a, 0x86, 0xf6, 0x88, 0xca,
-	0x15, 0x36, 0xae, 0xaa, 0x7f, 0x99, 0x85, 0x72, 0x2f, 0x0a, 0xa8, 0x3d, 0xe6, 0xec, 0xe1, 0xef,
-	0x02, 0x84, 0x91, 0x1d, 0xd1, 0x31, 0xf5, 0xa2, 0x98, 0x86, 0x37, 0xe4, 0xf0, 0x29, 0xbb, 0x9d,
-	0x5e, 0x6c, 0x44, 0x52, 0xf6, 0xe7, 0xdd, 0x93, 0x79, 0x01, 0xf7, 0x6c, 0x7c, 0x9a, 0x81, 0x52,
-	0xd2, 0x1b, 0xd6, 0xa0, 0x38, 0xb0, 0x23, 0x3a, 0xf2, 0x83, 0x33, 0xb9, 0x16, 0xbf, 0xf3, 0x79,
-	0xa3, 0xef, 0x34, 0xa4, 0x31, 0x49, 0x9a, 0xe1, 0x37, 0x41, 0x6c, 0x70, 0x44, 0xe8, 0x0b, 0xbc,
-	0x25, 0x2e, 0xe1, 0xc1, 0xff, 0x3e, 0xe0, 0x49, 0xe0, 0x8c, 0xed, 0xe0, 0xcc, 0x3a, 0xa6, 0x67,
-	0xf1, 0x22, 0x92, 0x5d, 0xe0, 0x70, 0x24, 0xed, 0xee, 0xd1, 0x33, 0x99, 0xf6, 0x6e, 0xcf, 0xb7,
-	0x95, 0x21, 0x7b, 0xd1, 0x8d, 0xa9, 0x96, 0x7c, 0x27, 0x10, 0xc6, 0x6b, 0x7e, 0x9e, 0x47, 0x37,
-	0x2b, 0xaa, 0xdf, 0x80, 0x62, 0x3c, 0x79, 0x5c, 0x82, 0xbc, 0x1e, 0x04, 0x7e, 0x80, 0xae, 0xf0,
-	0xec, 0xd7, 0x6e, 0x89, 0x04, 0xba, 0xbf, 0xcf, 0x12, 0xe8, 0xdf, 0x65, 0x92, 0x85, 0x97, 0xd0,
-	0x27, 0x53, 0x1a, 0x46, 0xf8, 0x17, 0xa0, 0x46, 0x79, 0xa4, 0x39, 0x27, 0xd4, 0x1a, 0xf0, 0x5d,
-	0x1a, 0x8b, 0x33, 0xf1, 0x39, 0xac, 0xec, 0x88, 0x4d, 0x65, 0xbc, 0x7b, 0x23, 0xab, 0x89, 0xad,
-	0x14, 0x0d, 0xb1, 0x0e, 0x35, 0x67, 0x3c, 0xa6, 0x43, 0xc7, 0x8e, 0xd2, 0x1d, 0x08, 0x87, 0xad,
-	0xc7, 0x9b, 0x98, 0xb9, 0x4d, 0x20, 0x59, 0x4d, 0x5a, 0x24, 0xdd, 0xbc, 0x03, 0x85, 0x88, 0x6f,
-	0x58, 0xe5, 0x1a, 0x5e, 0x89, 0xb3, 0x1a, 0x17, 0x12, 0xa9, 0xc4, 0xdf, 0x00, 0xb1, 0xfd, 0xe5,
-	0xf9, 0x6b, 0x16, 0x10, 0xb3, 0x5d, 0x0d, 0x11, 0x7a, 0xfc, 0x0e, 0x54, 0xe7, 0x16, 0xbf, 0x21,
-	0x27, 0x2c, 0x4b, 0x2a, 0xe9, 0x95, 0x6c, 0x88, 0xaf, 0xc3, 0x92, 0x2f, 0x16, 0x3e, 0x9e, 0xd9,
-	0x66, 0x33, 0x9e, 0x5f, 0x15, 0x49, 0x6c, 0xa5, 0xfe, 0x1c, 0xac, 0x24, 0x0c, 0x86, 0x13, 0xdf,
-	0x0b, 0x29, 0xde, 0x86, 0x42, 0xc0, 0x3f, 0x27, 0xc9, 0x1a, 0x96, 0x5d, 0xa4, 0xf2, 0x01, 0x91,
-	0x16, 0xea, 0x10, 0x56, 0x84, 0xe4, 0xa1, 0x13, 0x1d, 0x71, 0x47, 0xe1, 0x77, 0x20, 0x4f, 0x59,
-	0xe1, 0x1c, 0xe7, 0xa4, 0xdb, 0xe0, 0x7a, 0x22, 0xb4, 0xa9, 0x51, 0x32, 0xcf, 0x1d, 0xe5, 0x3f,
-	0x33, 0x50, 0x93, 0xb3, 0xdc, 0xb3, 0xa3, 0xc1, 0xd1, 0x25, 0x75, 0xf6, 0x37, 0x61, 0x89, 0xc9,
-	0x9d, 0xe4, 0xc3, 0x58, 0xe0, 0xee, 0xd8, 0x82, 0x39, 0xdc, 0x0e, 0xad, 0x94, 0x77, 0xe5, 0xe6,
-	0xab, 0x62, 0x87, 0xa9, 0x95, 0x7f, 0x41, 0x5c, 0x14, 0x9e, 0x13, 0x17, 0x4b, 0x2f, 0x14, 0x17,
-	0xfb, 0xb0, 0x36, 0xcf, 0xb8, 0x0c, 0x8e, 0x6f, 0xc1, 0x92, 0x70, 0x4a, 0x9c, 0x02, 0x17, 0xf9,
-	0x2d, 0x36, 0x51, 0xff, 0x21, 0x03, 0x6b, 0x32, 0x3b, 0x7d, 0x31, 0x3e, 0xd3, 0x14, 0xcf, 0xf9,
-	0x17, 0xe1, 0xf9, 0x05, 0xfd, 0xa7, 0x36, 0x60, 0xfd, 0x1c, 0x8f, 0x2f, 0xf1, 0xb1, 0xfe, 0x58,
-	0x81, 0xe5, 0x3d, 0x3a, 0x72, 0xbc, 0x4b, 0xea, 0x85, 0x14, 0xb9, 0xb9, 0x17, 0x0a, 0xe2, 0x5b,
-	0x50, 0x91, 0x78, 0x25, 0x5b, 0x17, 0xd9, 0x56, 0x16, 0xb1, 0xfd, 0xef, 0x0a, 0x54, 0x1a, 0xfe,
-	0x78, 0xec, 0x44, 0x97, 0x94, 0xa9, 0x8b, 0x38, 0x73, 0x8b, 0x70, 0x22, 0xa8, 0xc6, 0x30, 0x05,
-	0x41, 0xea, 0x7f, 0x28, 0xb0, 0x42, 0x7c, 0xd7, 0x3d, 0xb4, 0x07, 0xc7, 0xaf, 0x36, 0x76, 0x0c,
-	0x68, 0x06, 0x54, 0xa2, 0xff, 0x6f, 0x05, 0xaa, 0xdd, 0x80, 0xb2, 0x1f, 0xeb, 0x57, 0x1a, 0x3c,
-	0xdb, 0x09, 0x0f, 0x23, 0xb9, 0x87, 0x28, 0x11, 0x5e, 0x56, 0x57, 0x61, 0x25, 0xc1, 0x2e, 0xf9,
-	0xf8, 0x17, 0x05, 0xd6, 0x45, 0x80, 0x48, 0xcd, 0xf0, 0x92, 0xd2, 0x12, 0xe3, 0xcd, 0xa5, 0xf0,
-	0xd6, 0xe1, 0xea, 0x79, 0x6c, 0x12, 0xf6, 0x0f, 0x32, 0x70, 0x2d, 0x8e, 0x8d, 0x4b, 0x0e, 0xfc,
-	0xff, 0x10, 0x0f, 0x1b, 0x50, 0xbf, 0x48, 0x82, 0x64, 0xe8, 0xe3, 0x0c, 0xd4, 0x1b, 0x01, 0xb5,
-	0x23, 0x9a, 0xda, 0x8b, 0xbc, 0x3a, 0xb1, 0x81, 0xdf, 0x85, 0xe5, 0x89, 0x1d, 0x44, 0xce, 0xc0,
-	0x99, 0xd8, 0xec, 0x6f, 0x2f, 0xcf, 0xb7, 0x3a, 0xe7, 0x3a, 0x98, 0x33, 0x51, 0x5f, 0x87, 0xd7,
-	0x16, 0x30, 0x22, 0xf9, 0xfa, 0x1f, 0x05, 0x70, 0x2f, 0xb2, 0x83, 0xe8, 0x0b, 0xb0, 0xaa, 0x2c,
-	0x0c, 0xa6, 0x75, 0xa8, 0xcd, 0xe1, 0x4f, 0xf3, 0x42, 0xa3, 0x2f, 0xc4, 0x8a, 0xf3, 0x99, 0xbc,
-	0xa4, 0xf1, 0x4b, 0x5e, 0xfe, 0x4d, 0x81, 0x8d, 0x86, 0x2f, 0x0e, 0x16, 0x5f, 0xc9, 0x2f, 0x4c,
-	0x7d, 0x13, 0x5e, 0x5f, 0x08, 0x50, 0x12, 0xf0, 0xaf, 0x0a, 0x5c, 0x25, 0xd4, 0x1e, 0xbe, 0x9a,
-	0xe0, 0xef, 0xc3, 0xb5, 0x0b, 0xe0, 0xe4, 0x0e, 0xf5, 0x16, 0x14, 0xc7, 0x34, 0xb2, 0x87, 0x76,
-	0x64, 0x4b, 0x48, 0x1b, 0x71, 0xbf, 0x33, 0xeb, 0xb6, 0xb4, 0x20, 0x89, 0xad, 0xfa, 0x69, 0x06,
-	0x6a, 0x7c, 0xaf, 0xfb, 0xe5, 0x8f, 0xd6, 0xe2, 0x7f, 0x81, 0x8f, 0x15, 0x58, 0x9b, 0x27, 0x28,
-	0xf9, 0x27, 0xf8, 0xff, 0x3e, 0xaf, 0x58, 0x90, 0x10, 0xb2, 0x8b, 0xb6, 0xa0, 0xff, 0x98, 0x81,
-	0x7a, 0x7a, 0x4a, 0x5f, 0x9e, 0x6d, 0xcc, 0x9f, 0x6d, 0xfc, 0xc4, 0x87, 0x59, 0x9f, 0x28, 0xf0,
-	0xda, 0x02, 0x42, 0x7f, 0x32, 0x47, 0xa7, 0x4e, 0x38, 0x32, 0xcf, 0x3d, 0xe1, 0x78, 0x51, 0x57,
-	0xff, 0xb3, 0x02, 0x6b, 0x6d, 0x71, 0xb0, 0x2c, 0xfe, 0xe3, 0x2f, 0x6f, 0x36, 0xe3, 0x67, 0xc7,
-	0xb9, 0xd9, 0xf5, 0x8d, 0xda, 0x80, 0xf5, 0x73, 0xd0, 0x5e, 0xe2, 0x6c, 0xe2, 0xbf, 0x14, 0x58,
-	0x95, 0xbd, 0x68, 0x97, 0x76, 0x23, 0xb0, 0x80, 0x1d, 0xfc, 0x16, 0x64, 0x9d, 0x61, 0xbc, 0x83,
-	0x9c, 0xbf, 0x04, 0x67, 0x0a, 0xf5, 0x0e, 0xe0, 0x34, 0xee, 0x97, 0xa0, 0xee, 0x9f, 0xb2, 0xb0,
-	0xda, 0x9b, 0xb8, 0x4e, 0x24, 0x95, 0xaf, 0x76, 0xe2, 0xff, 0x0a, 0x2c, 0x87, 0x0c, 0xac, 0x25,
-	0xae, 0xe4, 0x38, 0xb1, 0x25, 0x52, 0xe6, 0xb2, 0x06, 0x17, 0xe1, 0xb7, 0xa1, 0x1c, 0x9b, 0x4c,
-	0xbd, 0x48, 0x1e, 0xa8, 0x81, 0xb4, 0x98, 0x7a, 0x11, 0xbe, 0x09, 0xd7, 0xbc, 0xe9, 0x98, 0x5f,
-	0x69, 0x5b, 0x13, 0x1a, 0xc4, 0x17, 0xbe, 0x76, 0x10, 0x5f, 0x3d, 0xd7, 0xbc, 0xe9, 0x98, 0xf8,
-	0xa7, 0x61, 0x97, 0x06, 0xe2, 0xc2, 0xd7, 0x0e, 0x22, 0x7c, 0x07, 0x4a, 0xb6, 0x3b, 0xf2, 0x03,
-	0x27, 0x3a, 0x1a, 0xcb, 0x3b, 0x67, 0x35, 0xbe, 0x81, 0x39, 0x4f, 0xff, 0x8e, 0x16, 0x5b, 0x92,
-	0x59, 0x23, 0xf5, 0x5b, 0x50, 0x4a, 0xe4, 0x18, 0xc1, 0xb2, 0x7e, 0xbf, 0xaf, 0xb5, 0xac, 0x5e,
-	0xb7, 0xd5, 0x34, 0x7b, 0xe2, 0x9e, 0xf8, 0xa0, 0xdf, 0x6a, 0x59, 0xbd, 0x86, 0x66, 0x20, 0x45,
-	0x25, 0x00, 0xbc, 0x4b, 0xde, 0xf9, 0x8c, 0x20, 0xe5, 0x39, 0x04, 0xbd, 0x0e, 0xa5, 0xc0, 0x3f,
-	0x95, 0xd8, 0x33, 0x1c, 0x4e, 0x31, 0xf0, 0x4f, 0x39, 0x72, 0x55, 0x03, 0x9c, 0x9e, 0xab, 0x8c,
-	0xb6, 0x54, 0xf2, 0x56, 0xe6, 0x92, 0xf7, 0x6c, 0xfc, 0x24, 0x79, 0x8b, 0xad, 0x3c, 0xfb, 0xce,
-	0x3f, 0xa0, 0xb6, 0x1b, 0xc5, 0xeb, 0x95, 0xfa, 0x57, 0x19, 0xa8, 0x10, 0x26, 0x71, 0xc6, 0xb4,
-	0x17, 0xd9, 0x51, 0xc8, 0x3c, 0x75, 0xc4, 0x4d, 0xac, 0x59, 0xda, 0x2d, 0x91, 0xb2, 0x90, 0x89,
-	0xbb, 0x82, 0x5d, 0x58, 0x0f, 0xe9, 0xc0, 0xf7, 0x86, 0xa1, 0x75, 0x48, 0x8f, 0x1c, 0x6f, 0x68,
-	0x8d, 0xed, 0x30, 0x92, 0xd7, 0x91, 0x15, 0x52, 0x93, 0xca, 0x3d, 0xae, 0x6b, 0x73, 0x15, 0xbe,
-	0x01, 0x6b, 0x87, 0x8e, 0xe7, 0xfa, 0x23, 0x6b, 0xe2, 0xda, 0x67, 0x34, 0x08, 0x25, 0x54, 0x16,
-	0x5e, 0x79, 0x82, 0x85, 0xae, 0x2b, 0x54, 0xc2, 0xdd, 0x1f, 0xc1, 0xf6, 0xc2, 0x51, 0xac, 0xc7,
-	0x8e, 0x1b, 0xd1, 0x80, 0x0e, 0xad, 0x80, 0x4e, 0x5c, 0x67, 0x20, 0x5e, 0x13, 0x88, 0xbd, 0xfb,
-	0xd7, 0x17, 0x0c, 0x7d, 0x20, 0xcd, 0xc9, 0xcc, 0x9a, 0xb1, 0x3d, 0x98, 0x4c, 0xad, 0x29, 0xbf,
-	0x41, 0x64, 0xab, 0x98, 0x42, 0x8a, 0x83, 0xc9, 0xb4, 0xcf, 0xea, 0x18, 0x41, 0xf6, 0xc9, 0x44,
-	0x2c, 0x5e, 0x0a, 0x61, 0x45, 0xf5, 0xc7, 0x0a, 0x54, 0xb5, 0xd1, 0x28, 0xa0, 0x23, 0x3b, 0x92,
-	0x34, 0xdd, 0x80, 0x35, 0x41, 0xc9, 0x99, 0x25, 0x9f, 0x29, 0x09, 0x3c, 0x8a, 0xc0, 0x23, 0x75,
-	0xe2, 0x91, 0x52, 0x1c, 0xbe, 0x57, 0xa7, 0xde, 0xc2, 0x36, 0x19, 0xde, 0x66, 0x2d, 0xd1, 0xa6,
-	0x5b, 0xfd, 0x2c, 0xbc, 0xb6, 0x98, 0x85, 0xb1, 0x23, 0x1e, 0x9a, 0x54, 0xc8, 0xd5, 0x05, 0xa0,
-	0xdb, 0x8e, 0xf7, 0x39, 0x4d, 0xed, 0xa7, 0x9c, 0xaf, 0xcf, 0x68, 0x6a, 0x3f, 0x55, 0xff, 0x26,
-	0xb9, 0x01, 0x88, 0xc3, 0x25, 0x59, 0x8d, 0xe3, 0xbc, 0xa0, 0x7c, 0x5e, 0x5e, 0xa8, 0xc3, 0x52,
-	0x48, 0x83, 0x13, 0xc7, 0x1b, 0xc5, 0x57, 0xd4, 0xb2, 0x8a, 0x7b, 0xf0, 0x75, 0x89, 0x9d, 0x3e,
-	0x8d, 0x68, 0xe0, 0xd9, 0xae, 0x7b, 0x66, 0x89, 0x83, 0x0a, 0x2f, 0xa2, 0x43, 0x6b, 0xf6, 0xa8,
-	0x4a, 0xac, 0xc8, 0x5f, 0x15, 0xd6, 0x7a, 0x62, 0x4c, 0x12, 0x5b, 0x33, 0x79, 0x6e, 0xf5, 0x1d,
-	0xa8, 0x06, 0x32, 0x88, 0xad, 0x90, 0xb9, 0x47, 0xe6, 0xa3, 0xb5, 0xe4, 0x9e, 0x39, 0x15, 0xe1,
-	0xa4, 0x12, 0xcc, 0x05, 0xfc, 0x6d, 0x58, 0x96, 0x33, 0xb2, 0x5d, 0xc7, 0x9e, 0x6d, 0x4c, 0xcf,
-	0xbd, 0x34, 0xd3, 0x98, 0x92, 0xc8, 0x37, 0x69, 0xbc, 0xf2, 0x61, 0xae, 0x58, 0x40, 0x4b, 0xec,
-	0x6f, 0xb8, 0xd6, 0x9f, 0x0c, 0x79, 0x64, 0x5c, 0xe2, 0x3d, 0x42, 0xfa, 0x71, 0x5a, 0x6e, 0xfe,
-	0x71, 0xda, 0xfc, 0x63, 0xb7, 0xfc, 0xb9, 0xc7, 0x6e, 0xea, 0x1d, 0x58, 0x9b, 0xc7, 0x2f, 0x63,
-	0x65, 0x0b, 0xf2, 0xfc, 0x5a, 0xfc, 0xdc, 0x62, 0x98, 0xba, 0xf7, 0x26, 0xc2, 0x40, 0xfd, 0x5b,
-	0x05, 0x6a, 0x0b, 0x7e, 0x94, 0x92, 0xbf, 0x30, 0x25, 0x75, 0xc8, 0xf3, 0xd3, 0x90, 0xe7, 0x17,
-	0xf4, 0xf2, 0xdd, 0xc9, 0xb5, 0x8b, 0xff, 0x59, 0xfc, 0x32, 0x9d, 0x08, 0x2b, 0x96, 0xce, 0x78,
-	0x58, 0x0c, 0xf8, 0x29, 0x4f, 0xbc, 0xcf, 0x2b, 0x33, 0x99, 0x38, 0xf8, 0xb9, 0x78, 0x6c, 0x94,
-	0x7b, 0xee, 0xb1, 0xd1, 0xf6, 0xef, 0x67, 0xa1, 0xd4, 0x3e, 0xeb, 0x3d, 0x71, 0x0f, 0x5c, 0x7b,
-	0xc4, 0x6f, 0xbb, 0xdb, 0x5d, 0xf3, 0x11, 0xba, 0x82, 0x57, 0xa1, 0x62, 0x74, 0x4c, 0xcb, 0x60,
-	0x0b, 0xc2, 0x41, 0x4b, 0xbb, 0x8b, 0x14, 0xb6, 0x62, 0x74, 0x49, 0xd3, 0xba, 0xa7, 0x3f, 0x12,
-	0x92, 0x0c, 0xae, 0xc1, 0x4a, 0xdf, 0x68, 0xde, 0xef, 0xeb, 0x33, 0x61, 0x0e, 0xaf, 0xc3, 0x6a,
-	0xbb, 0xdf, 0x32, 0x9b, 0xdd, 0x56, 0x4a, 0x5c, 0x64, 0xab, 0xcb, 0x5e, 0xab, 0xb3, 0x27, 0xaa,
-	0x88, 0xf5, 0xdf, 0x37, 0x7a, 0xcd, 0xbb, 0x86, 0xbe, 0x2f, 0x44, 0x9b, 0x4c, 0xf4, 0x91, 0x4e,
-	0x3a, 0x07, 0xcd, 0x78, 0xc8, 0x3b, 0x18, 0x41, 0x79, 0xaf, 0x69, 0x68, 0x44, 0xf6, 0xf2, 0x4c,
-	0xc1, 0x55, 0x28, 0xe9, 0x46, 0xbf, 0x2d, 0xeb, 0x19, 0x5c, 0x87, 0x9a, 0xd6, 0x37, 0x3b, 0x56,
-	0xd3, 0x68, 0x10, 0xbd, 0xad, 0x1b, 0xa6, 0xd4, 0xe4, 0x70, 0x0d, 0xaa, 0x66, 0xb3, 0xad, 0xf7,
-	0x4c, 0xad, 0xdd, 0x95, 0x42, 0x36, 0x8b, 0x62, 0x4f, 0x8f, 0x6d, 0x10, 0xde, 0x80, 0x75, 0xa3,
-	0x63, 0xc9, 0x27, 0x4b, 0xd6, 0x03, 0xad, 0xd5, 0xd7, 0xa5, 0x6e, 0x13, 0x5f, 0x03, 0xdc, 0x31,
-	0xac, 0x7e, 0x77, 0x5f, 0x33, 0x75, 0xcb, 0xe8, 0x3c, 0x94, 0x8a, 0x3b, 0xb8, 0x0a, 0xc5, 0xd9,
-	0x0c, 0x9e, 0x31, 0x16, 0x2a, 0x5d, 0x8d, 0x98, 0x33, 0xb0, 0xcf, 0x9e, 0x31, 0xb2, 0xe0, 0x2e,
-	0xe9, 0xf4, 0xbb, 0x33, 0xb3, 0x55, 0x28, 0x4b, 0xb2, 0xa4, 0x28, 0xc7, 0x44, 0x7b, 0x4d, 0xa3,
-	0x91, 0xcc, 0xef, 0x59, 0x71, 0x23, 0x83, 0x94, 0xed, 0x63, 0xc8, 0x71, 0x77, 0x14, 0x21, 0x67,
-	0x74, 0x0c, 0x1d, 0x5d, 0xc1, 0x2b, 0x00, 0xcd, 0x5e, 0xd3, 0x30, 0xf5, 0xbb, 0x44, 0x6b, 0x31,
-	0xd8, 0x5c, 0x10, 0x13, 0xc8, 0xd0, 0x2e, 0xc3, 0x52, 0xb3, 0x77, 0xd0, 0xea, 0x68, 0xa6, 0x84,
-	0xd9, 0xec, 0xdd, 0xef, 0x77, 0x4c, 0xa6, 0x44, 0xb8, 0x0c, 0x85, 0x66, 0xcf, 0xd4, 0xbf, 0x67,
-	0x32, 0x5c, 0x5c, 0x27, 0x58, 0x45, 0xcf, 0xee, 0x6c, 0xff, 0x28, 0x0b, 0x39, 0xfe, 0xe0, 0xb4,
-	0x02, 0x25, 0xee, 0x6d, 0xf3, 0x51, 0x97, 0x0d, 0x59, 0x82, 0x5c, 0xd3, 0x30, 0x6f, 0xa3, 0x5f,
-	0xcc, 0x60, 0x80, 0x7c, 0x9f, 0x97, 0x7f, 0xa9, 0xc0, 0xca, 0x4d, 0xc3, 0x7c, 0xf7, 0x16, 0xfa,
-	0x41, 0x86, 0x75, 0xdb, 0x17, 0x95, 0x5f, 0x8e, 0x15, 0xbb, 0x37, 0xd1, 0x0f, 0x13, 0xc5, 0xee,
-	0x4d, 0xf4, 0x2b, 0xb1, 0xe2, 0xbd, 0x5d, 0xf4, 0xab, 0x89, 0xe2, 0xbd, 0x5d, 0xf4, 0x6b, 0xb1,
-	0xe2, 0xd6, 0x4d, 0xf4, 0xeb, 0x89, 0xe2, 0xd6, 0x4d, 0xf4, 0x1b, 0x05, 0x86, 0x85, 0x23, 0x79,
-	0x6f, 0x17, 0xfd, 0x66, 0x31, 0xa9, 0xdd, 0xba, 0x89, 0x7e, 0xab, 0xc8, 0xfc, 0x9f, 0x78, 0x15,
-	0xfd, 0x36, 0x62, 0xd3, 0x64, 0x0e, 0x42, 0xbf, 0xc3, 0x8b, 0x4c, 0x85, 0x7e, 0x17, 0x31, 0x8c,
-	0x4c, 0xca, 0xab, 0x1f, 0x73, 0xcd, 0x23, 0x5d, 0x23, 0xe8, 0xf7, 0x0a, 0xe2, 0x85, 0x5a, 0xa3,
-	0xd9, 0xd6, 0x5a, 0x08, 0xf3, 0x16, 0x8c, 0x95, 0x3f, 0xb8, 0xc1, 0x8a, 0x2c, 0x3c, 0xd1, 0x1f,
-	0x76, 0xd9, 0x80, 0x0f, 0x34, 0xd2, 0xf8, 0x40, 0x23, 0xe8, 0x8f, 0x6e, 0xb0, 0x01, 0x1f, 0x68,
-	0x44, 0xf2, 0xf5, 0xc7, 0x5d, 0x66, 0xc8, 0x55, 0x9f, 0xdc, 0x60, 0x93, 0x96, 0xf2, 0x3f, 0xe9,
-	0xe2, 0x22, 0x64, 0xf7, 0x9a, 0x26, 0xfa, 0x11, 0x1f, 0x8d, 0x85, 0x28, 0xfa, 0x53, 0xc4, 0x84,
-	0x3d, 0xdd, 0x44, 0x7f, 0xc6, 0x84, 0x79, 0xb3, 0xdf, 0x6d, 0xe9, 0xe8, 0x0d, 0x36, 0xb9, 0xbb,
-	0x7a, 0xa7, 0xad, 0x9b, 0xe4, 0x11, 0xfa, 0x73, 0x6e, 0xfe, 0x61, 0xaf, 0x63, 0xa0, 0x4f, 0x11,
-	0xae, 0x02, 0xe8, 0xdf, 0xeb, 0x12, 0xbd, 0xd7, 0x6b, 0x76, 0x0c, 0xf4, 0xf6, 0xf6, 0x01, 0xa0,
-	0xf3, 0xe9, 0x80, 0x01, 0xe8, 0x1b, 0xf7, 0x8c, 0xce, 0x43, 0x03, 0x5d, 0x61, 0x95, 0x2e, 0xd1,
-	0xbb, 0x1a, 0xd1, 0x91, 0x82, 0x01, 0x0a, 0xf2, 0xdd, 0x5b, 0x06, 0x2f, 0x43, 0x91, 0x74, 0x5a,
-	0xad, 0x3d, 0xad, 0x71, 0x0f, 0x65, 0xf7, 0xbe, 0x0d, 0x2b, 0x8e, 0xbf, 0x73, 0xe2, 0x44, 0x34,
-	0x0c, 0xc5, 0x93, 0xe6, 0x8f, 0x54, 0x59, 0x73, 0xfc, 0xeb, 0xa2, 0x74, 0x7d, 0xe4, 0x5f, 0x3f,
-	0x89, 0xae, 0x73, 0xed, 0x75, 0x9e, 0x31, 0x0e, 0x0b, 0xbc, 0xf2, 0xde, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x99, 0x5d, 0x63, 0x30, 0x2d, 0x00, 0x00,
+	// 3328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0xdb, 0xd6,
+	0xb9, 0x37, 0xf8, 0x12, 0xf9, 0x51, 0xa4, 0xa0, 0x23, 0xc9, 0xa6, 0xe5, 0x3c, 0x14, 0x24, 0x4e,
+	0x74, 0x95, 0x5c, 0xd9, 0x91, 0x1d, 0x5f, 0xdf, 0x24, 0xf7, 0x5e, 0x43, 0x14, 0xe4, 0x30, 0x26,
+	0x41, 0xfa, 0x10, 0xb4, 0x63, 0x4f, 0x66, 0x30, 0x10, 0x79, 0x4c, 0x61, 0x04, 0x02, 0x34, 0x00,
+	0xca, 0xd6, 0xce, 0xf7, 0xe6, 0xe6, 0xbe, 0x1f, 0xe9, 0x33, 0x4d, 0x3b, 0xcd, 0x74, 0xd7, 0xe9,
+	0xa6, 0x7f, 0x43, 0xa7, 0x8b, 0x2e, 0xdb, 0x55, 0x16, 0x6d, 0x17, 0x5d, 0x75, 0xba, 0xeb, 0x74,
+	0x95, 0x45, 0x17, 0x9d, 0xce, 0x79, 0x00, 0x04, 0x25, 0xfa, 0x11, 0xb7, 0x1b, 0x3b, 0xd9, 0x9d,
+	0xef, 0x71, 0x1e, 0xdf, 0xef, 0xfb, 0xf0, 0x9d, 0x0f, 0xe7, 0x1c, 0x28, 0xde, 0x1e, 0x11, 0xff,
+	0x60, 0x7d, 0xe8, 0x7b, 0xa1, 0x87, 0xb2, 0x8c, 0x58, 0x2e, 0x87, 0xde, 0xd0, 0xeb, 0x59, 0xa1,
+	0xc5, 0xd9, 0xcb, 0xc5, 0xfd, 0xd0, 0x1f, 0x76, 0x39, 0xa1, 0x7c, 0x28, 0x41, 0xce, 0xb0, 0xfc,
+	0x3e, 0x09, 0xd1, 0x32, 0xe4, 0xf7, 0xc8, 0x41, 0x30, 0xb4, 0xba, 0xa4, 0x22, 0xad, 0x48, 0xab,
+	0x05, 0x1c, 0xd3, 0x68, 0x11, 0xb2, 0xc1, 0xae, 0xe5, 0xf7, 0x2a, 0x29, 0x26, 0xe0, 0x04, 0x7a,
+	0x03, 0x8a, 0xa1, 0xb5, 0xe3, 0x90, 0xd0, 0x0c, 0x0f, 0x86, 0xa4, 0x92, 0x5e, 0x91, 0x56, 0xcb,
+	0x1b, 0x8b, 0xeb, 0xf1, 0x7c, 0x06, 0x13, 0x1a, 0x07, 0x43, 0x82, 0x21, 0x8c, 0xdb, 0x08, 0x41,
+	0xa6, 0x4b, 0x1c, 0xa7, 0x92, 0x61, 0x63, 0xb1, 0xb6, 0xb2, 0x05, 0xe5, 0x6b, 0xc6, 0x65, 0x2b,
+	0x24, 0x55, 0xcb, 0x71, 0x88, 0x5f, 0xdb, 0xa2, 0xcb, 0x19, 0x05, 0xc4, 0x77, 0xad, 0x41, 0xbc,
+	0x9c, 0x88, 0x46, 0xc7, 0x21, 0xd7, 0xf7, 0xbd, 0xd1, 0x30, 0xa8, 0xa4, 0x56, 0xd2, 0xab, 0x05,
+	0x2c, 0x28, 0xe5, 0x7d, 0x00, 0x6d, 0x9f, 0xb8, 0xa1, 0xe1, 0xed, 0x11, 0x17, 0x3d, 0x03, 0x85,
+	0xd0, 0x1e, 0x90, 0x20, 0xb4, 0x06, 0x43, 0x36, 0x44, 0x1a, 0x8f, 0x19, 0xf7, 0x31, 0x69, 0x19,
+	0xf2, 0x43, 0x2f, 0xb0, 0x43, 0xdb, 0x73, 0x99, 0x3d, 0x05, 0x1c, 0xd3, 0xca, 0x3f, 0x42, 0xf6,
+	0x9a, 0xe5, 0x8c, 0x08, 0x7a, 0x1e, 0x32, 0xcc, 0x60, 0x89, 0x19, 0x5c, 0x5c, 0xe7, 0xa0, 0x33,
+	0x3b, 0x99, 0x80, 0x8e, 0xbd, 0x4f, 0x35, 0xd9, 0xd8, 0xb3, 0x98, 0x13, 0xca, 0x1e, 0xcc, 0x6e,
+	0xda, 0x6e, 0xef, 0x9a, 0xe5, 0xdb, 0x14, 0x8c, 0xc7, 0x1c, 0x06, 0xbd, 0x04, 0x39, 0xd6, 0x08,
+	0x2a, 0xe9, 0x95, 0xf4, 0x6a, 0x71, 0x63, 0x56, 0x74, 0x64, 0x6b, 0xc3, 0x42, 0xa6, 0xfc, 0x54,
+	0x02, 0xd8, 0xf4, 0x46, 0x6e, 0xef, 0x2a, 0x15, 0x22, 0x19, 0xd2, 0xc1, 0x6d, 0x47, 0x00, 0x49,
+	0x9b, 0xe8, 0x0a, 0x94, 0x77, 0x6c, 0xb7, 0x67, 0xee, 0x8b, 0xe5, 0x70, 0x2c, 0x8b, 0x1b, 0x2f,
+	0x89, 0xe1, 0xc6, 0x9d, 0xd7, 0x93, 0xab, 0x0e, 0x34, 0x37, 0xf4, 0x0f, 0x70, 0x69, 0x27, 0xc9,
+	0x5b, 0xee, 0x00, 0x3a, 0xaa, 0x44, 0x27, 0xdd, 0x23, 0x07, 0xd1, 0xa4, 0x7b, 0xe4, 0x00, 0xfd,
+	0x4d, 0xd2, 0xa2, 0xe2, 0xc6, 0x42, 0x34, 0x57, 0xa2, 0xaf, 0x30, 0xf3, 0xcd, 0xd4, 0x45, 0x49,
+	0xf9, 0x45, 0x0e, 0xca, 0xda, 0x5d, 0xd2, 0x1d, 0x85, 0xa4, 0x39, 0xa4, 0x3e, 0x08, 0xd0, 0x3a,
+	0x2c, 0xd8, 0x6e, 0xd7, 0x19, 0xf5, 0x88, 0x49, 0xa8, 0xab, 0xcd, 0x90, 0xfa, 0x9a, 0x8d, 0x97,
+	0xc7, 0xf3, 0x42, 0x94, 0x08, 0x02, 0x15, 0x16, 0xba, 0xde, 0x60, 0x68, 0xf9, 0x93, 0xfa, 0x69,
+	0x36, 0xff, 0xbc, 0x98, 0x7f, 0xac, 0x8f, 0xe7, 0x85, 0x76, 0x62, 0x88, 0x06, 0xcc, 0x89, 0x71,
+	0x7b, 0xe6, 0x2d, 0x9b, 0x38, 0xbd, 0x80, 0x85, 0x6e, 0x39, 0x86, 0x6a, 0x72, 0x89, 0xeb, 0x35,
+	0xa1, 0xbc, 0xcd, 0x74, 0x71, 0xd9, 0x9e, 0xa0, 0xd1, 0x1a, 0xcc, 0x77, 0x1d, 0x9b, 0x2e, 0xe5,
+	0x16, 0x85, 0xd8, 0xf4, 0xbd, 0x3b, 0x41, 0x25, 0xcb, 0xd6, 0x3f, 0xc7, 0x05, 0xdb, 0x94, 0x8f,
+	0xbd, 0x3b, 0x01, 0x7a, 0x13, 0xf2, 0x77, 0x3c, 0x7f, 0xcf, 0xf1, 0xac, 0x5e, 0x25, 0xc7, 0xe6,
+	0x7c, 0x6e, 0xfa, 0x9c, 0xd7, 0x85, 0x16, 0x8e, 0xf5, 0xd1, 0x2a, 0xc8, 0xc1, 0x6d, 0xc7, 0x0c,
+	0x88, 0x43, 0xba, 0xa1, 0xe9, 0xd8, 0x03, 0x3b, 0xac, 0xe4, 0xd9, 0x57, 0x50, 0x0e, 0x6e, 0x3b,
+	0x6d, 0xc6, 0xae, 0x53, 0x2e, 0x32, 0x61, 0x29, 0xf4, 0x2d, 0x37, 0xb0, 0xba, 0x74, 0x30, 0xd3,
+	0x0e, 0x3c, 0xc7, 0xa2, 0xad, 0x4a, 0x81, 0x4d, 0xb9, 0x36, 0x7d, 0x4a, 0x63, 0xdc, 0xa5, 0x16,
+	0xf5, 0xc0, 0x8b, 0xe1, 0x14, 0x2e, 0x7a, 0x1d, 0x96, 0x82, 0x3d, 0x7b, 0x68, 0xb2, 0x71, 0xcc,
+	0xa1, 0x63, 0xb9, 0x66, 0xd7, 0xea, 0xee, 0x92, 0x0a, 0x30, 0xb3, 0x11, 0x15, 0xb2, 0x50, 0x6b,
+	0x39, 0x96, 0x5b, 0xa5, 0x12, 0xea, 0xe7, 0x80, 0x04, 0x01, 0x5d, 0x4f, 0xe8, 0x5b, 0xdd, 0x3d,
+	0xb3, 0x1f, 0xda, 0xbd, 0xa0, 0x52, 0xe4, 0x7e, 0x16, 0x22, 0x83, 0x4a, 0x2e, 0x53, 0x81, 0xf2,
+	0x16, 0x94, 0x27, 0x71, 0x47, 0xf3, 0x50, 0x32, 0x6e, 0xb4, 0x34, 0x53, 0xd5, 0xb7, 0x4c, 0x5d,
+	0x6d, 0x68, 0xf2, 0x31, 0x54, 0x82, 0x02, 0x63, 0x35, 0xf5, 0xfa, 0x0d, 0x59, 0x42, 0x33, 0x90,
+	0x56, 0xeb, 0x75, 0x39, 0xa5, 0x5c, 0x84, 0x7c, 0x04, 0x20, 0x9a, 0x83, 0x62, 0x47, 0x6f, 0xb7,
+	0xb4, 0x6a, 0x6d, 0xbb, 0xa6, 0x6d, 0xc9, 0xc7, 0x50, 0x1e, 0x32, 0xcd, 0xba, 0xd1, 0x92, 0x25,
+	0xde, 0x52, 0x5b, 0x72, 0x8a, 0xf6, 0xdc, 0xda, 0x54, 0xe5, 0xb4, 0xf2, 0x43, 0x09, 0x16, 0xa7,
+	0x01, 0x81, 0x8a, 0x30, 0xb3, 0xa5, 0x6d, 0xab, 0x9d, 0xba, 0x21, 0x1f, 0x43, 0x0b, 0x30, 0x87,
+	0xb5, 0x96, 0xa6, 0x1a, 0xea, 0x66, 0x5d, 0x33, 0xb1, 0xa6, 0x6e, 0xc9, 0x12, 0x42, 0x50, 0xa6,
+	0x2d, 0xb3, 0xda, 0x6c, 0x34, 0x6a, 0x86, 0xa1, 0x6d, 0xc9, 0x29, 0xb4, 0x08, 0x32, 0xe3, 0x75,
+	0xf4, 0x31, 0x37, 0x8d, 0x64, 0x98, 0x6d, 0x6b, 0xb8, 0xa6, 0xd6, 0x6b, 0x37, 0xe9, 0x00, 0x72,
+	0x06, 0xbd, 0x00, 0xcf, 0x56, 0x9b, 0x7a, 0xbb, 0xd6, 0x36, 0x34, 0xdd, 0x30, 0xdb, 0xba, 0xda,
+	0x6a, 0xbf, 0xd3, 0x34, 0xd8, 0xc8, 0xdc, 0xb8, 0x2c, 0x2a, 0x03, 0xa8, 0x1d, 0xa3, 0xc9, 0xc7,
+	0x91, 0x73, 0xef, 0x66, 0xf2, 0x92, 0x9c, 0x52, 0x3e, 0x4e, 0x41, 0x96, 0xe1, 0x43, 0xb3, 0x70,
+	0x22, 0xb7, 0xb2, 0x76, 0x9c, 0x91, 0x52, 0x0f, 0xc8, 0x48, 0x2c, 0x91, 0x8b, 0xdc, 0xc8, 0x09,
+	0x74, 0x0a, 0x0a, 0x9e, 0xdf, 0x37, 0xb9, 0x84, 0x67, 0xf5, 0xbc, 0xe7, 0xf7, 0x59, 0xfa, 0xa7,
+	0x19, 0x95, 0x6e, 0x06, 0x3b, 0x56, 0x40, 0x58, 0x94, 0x17, 0x70, 0x4c, 0xa3, 0x93, 0x40, 0xf5,
+	0x4c, 0xb6, 0x8e, 0x1c, 0x93, 0xcd, 0x78, 0x7e, 0x5f, 0xa7, 0x4b, 0x79, 0x11, 0x4a, 0x5d, 0xcf,
+	0x19, 0x0d, 0x5c, 0xd3, 0x21, 0x6e, 0x3f, 0xdc, 0xad, 0xcc, 0xac, 0x48, 0xab, 0x25, 0x3c, 0xcb,
+	0x99, 0x75, 0xc6, 0x43, 0x15, 0x98, 0xe9, 0xee, 0x5a, 0x7e, 0x40, 0x78, 0x64, 0x97, 0x70, 0x44,
+	0xb2, 0x59, 0x49, 0xd7, 0x1e, 0x58, 0x4e, 0xc0, 0xa2, 0xb8, 0x84, 0x63, 0x9a, 0x1a, 0x71, 0xcb,
+	0xb1, 0xfa, 0x01, 0x8b, 0xbe, 0x12, 0xe6, 0x84, 0xf2, 0x77, 0x90, 0xc6, 0xde, 0x1d, 0x3a, 0x24,
+	0x9f, 0x30, 0xa8, 0x48, 0x2b, 0xe9, 0x55, 0x84, 0x23, 0x92, 0x6e, 0x3a, 0x22, 0xef, 0xf2, 0x74,
+	0x2c, 0x28, 0xe5, 0x7d, 0x98, 0xc5, 0x24, 0x18, 0x39, 0xa1, 0x76, 0x37, 0xf4, 0xad, 0x00, 0x6d,
+	0x40, 0x31, 0x99, 0x69, 0xa4, 0xfb, 0x65, 0x1a, 0x20, 0x71, 0x9b, 0xce, 0x7a, 0xcb, 0x27, 0xc1,
+	0x2e, 0xf1, 0x45, 0x26, 0x8b, 0x48, 0xe5, 0x73, 0x09, 0x8a, 0xec, 0xd3, 0xe0, 0x73, 0xd0, 0xec,
+	0x2f, 0x72, 0x90, 0x34, 0x91, 0xfd, 0x99, 0x53, 0xb1, 0x90, 0x51, 0xf4, 0x68, 0x5a, 0x31, 0xad,
+	0x5b, 0xb7, 0x48, 0x37, 0x24, 0x7c, 0x93, 0xcb, 0xe0, 0x59, 0xca, 0x54, 0x05, 0x8f, 0xba, 0xcd,
+	0x76, 0x03, 0xe2, 0x87, 0xa6, 0xdd, 0x63, 0x0e, 0xcd, 0xe0, 0x3c, 0x67, 0xd4, 0x7a, 0xe8, 0x39,
+	0xc8, 0xb0, 0xc4, 0x94, 0x61, 0xb3, 0x80, 0x98, 0x05, 0x7b, 0x77, 0x30, 0xe3, 0xa3, 0x57, 0x21,
+	0x47, 0x98, 0xbd, 0x95, 0xec, 0x44, 0x2a, 0x4f, 0x42, 0x81, 0x85, 0x0a, 0xda, 0x80, 0xa5, 0xe8,
+	0x63, 0x0e, 0x42, 0x2b, 0x24, 0x66, 0x77, 0xd7, 0x72, 0xfb, 0x24, 0x10, 0x4e, 0x8f, 0xbe, 0xf4,
+	0x36, 0x95, 0x55, 0xb9, 0x48, 0x79, 0x1b, 0x66, 0x99, 0xdd, 0xd7, 0x2d, 0xdf, 0xb5, 0xdd, 0x3e,
+	0xab, 0x1a, 0xbc, 0x1e, 0x8f, 0xd7, 0x12, 0x66, 0x6d, 0x0a, 0xdb, 0x80, 0x04, 0x81, 0xd5, 0x27,
+	0x62, 0x17, 0x8f, 0x48, 0xe5, 0x07, 0x69, 0x28, 0xb6, 0x43, 0x9f, 0x58, 0x03, 0x86, 0x38, 0x7a,
+	0x1b, 0x80, 0xcd, 0x3c, 0x20, 0x6e, 0x18, 0x41, 0xf7, 0x8c, 0x58, 0x72, 0x42, 0x6f, 0xbd, 0x1d,
+	0x29, 0xe1, 0x84, 0xfe, 0x61, 0x97, 0xa6, 0x1e, 0xc1, 0xa5, 0xcb, 0x9f, 0xa6, 0xa0, 0x10, 0x8f,
+	0x86, 0x54, 0xc8, 0x77, 0xad, 0x90, 0xf4, 0x3d, 0xff, 0x40, 0xec, 0xf7, 0xa7, 0x1f, 0x34, 0xfb,
+	0x7a, 0x55, 0x28, 0xe3, 0xb8, 0x1b, 0x7a, 0x16, 0x78, 0x11, 0xc5, 0x3f, 0x17, 0x6e, 0x6f, 0x81,
+	0x71, 0xd8, 0x07, 0xf3, 0x26, 0xa0, 0xa1, 0x6f, 0x0f, 0x2c, 0xff, 0xc0, 0xdc, 0x23, 0x07, 0xd1,
+	0x46, 0x95, 0x9e, 0x12, 0x24, 0xb2, 0xd0, 0xbb, 0x42, 0x0e, 0x44, 0xaa, 0xbc, 0x38, 0xd9, 0x57,
+	0x84, 0xf9, 0x51, 0xd7, 0x27, 0x7a, 0xb2, 0x6a, 0x23, 0x88, 0xea, 0x8a, 0x2c, 0xfb, 0x22, 0x68,
+	0x53, 0x79, 0x05, 0xf2, 0xd1, 0xe2, 0x51, 0x01, 0xb2, 0x9a, 0xef, 0x7b, 0xbe, 0x7c, 0x8c, 0x65,
+	0xcc, 0x46, 0x9d, 0x27, 0xdd, 0xad, 0x2d, 0x9a, 0x74, 0x7f, 0x92, 0x8a, 0x37, 0x77, 0x4c, 0x6e,
+	0x8f, 0x48, 0x10, 0xa2, 0x7f, 0x82, 0x05, 0xc2, 0xa2, 0xd3, 0xde, 0x27, 0x66, 0x97, 0x55, 0x82,
+	0x34, 0x36, 0xf9, 0x27, 0x34, 0xb7, 0xce, 0x0b, 0xd7, 0xa8, 0x42, 0xc4, 0xf3, 0xb1, 0xae, 0x60,
+	0xf5, 0x90, 0x06, 0x0b, 0xf6, 0x60, 0x40, 0x7a, 0x36, 0x0b, 0xb2, 0x78, 0x00, 0xee, 0xb0, 0xa5,
+	0xa8, 0x50, 0x9a, 0x28, 0x34, 0xf1, 0x7c, 0xdc, 0x23, 0x1e, 0xe6, 0x34, 0xe4, 0x42, 0x56, 0x14,
+	0x8b, 0x3a, 0xa1, 0x14, 0x65, 0x42, 0xc6, 0xc4, 0x42, 0x88, 0x5e, 0x01, 0x5e, 0x62, 0xb3, 0x9c,
+	0x37, 0x0e, 0x88, 0x71, 0xe5, 0x84, 0xb9, 0x1c, 0x9d, 0x86, 0xf2, 0xc4, 0x06, 0xdb, 0x63, 0x80,
+	0xa5, 0x71, 0x29, 0xc1, 0xad, 0xf5, 0xd0, 0x19, 0x98, 0xf1, 0xf8, 0xe6, 0x5a, 0xc9, 0x4d, 0xac,
+	0x78, 0x72, 0xe7, 0xc5, 0x91, 0x96, 0xf2, 0x0f, 0x30, 0x17, 0x23, 0x18, 0x0c, 0x3d, 0x37, 0x20,
+	0x68, 0x0d, 0x72, 0x3e, 0xfb, 0x04, 0x05, 0x6a, 0x48, 0x0c, 0x91, 0xc8, 0x21, 0x58, 0x68, 0x28,
+	0x3d, 0x98, 0xe3, 0x9c, 0xeb, 0x76, 0xb8, 0xcb, 0x1c, 0x85, 0x4e, 0x43, 0x96, 0xd0, 0xc6, 0x21,
+	0xcc, 0x71, 0xab, 0xca, 0xe4, 0x98, 0x4b, 0x13, 0xb3, 0xa4, 0x1e, 0x3a, 0xcb, 0x1f, 0x52, 0xb0,
+	0x20, 0x56, 0xb9, 0x69, 0x85, 0xdd, 0xdd, 0x27, 0xd4, 0xd9, 0xaf, 0xc2, 0x0c, 0xe5, 0xdb, 0xf1,
+	0x87, 0x31, 0xc5, 0xdd, 0x91, 0x06, 0x75, 0xb8, 0x15, 0x98, 0x09, 0xef, 0x8a, 0x02, 0xaf, 0x64,
+	0x05, 0x89, 0x6a, 0x61, 0x4a, 0x5c, 0xe4, 0x1e, 0x12, 0x17, 0x33, 0x8f, 0x14, 0x17, 0x5b, 0xb0,
+	0x38, 0x89, 0xb8, 0x08, 0x8e, 0xd7, 0x60, 0x86, 0x3b, 0x25, 0x4a, 0x81, 0xd3, 0xfc, 0x16, 0xa9,
+	0x28, 0x3f, 0x4b, 0xc1, 0xa2, 0xc8, 0x4e, 0x5f, 0x8e, 0xcf, 0x34, 0x81, 0x73, 0xf6, 0x51, 0x70,
+	0x7e, 0x44, 0xff, 0x29, 0x55, 0x58, 0x3a, 0x84, 0xe3, 0x63, 0x7c, 0xac, 0xbf, 0x97, 0x60, 0x76,
+	0x93, 0xf4, 0x6d, 0xf7, 0x09, 0xf5, 0x42, 0x02, 0xdc, 0xcc, 0x23, 0x05, 0xf1, 0x05, 0x28, 0x09,
+	0x7b, 0x05, 0x5a, 0x47, 0xd1, 0x96, 0xa6, 0xa1, 0xfd, 0x5b, 0x09, 0x4a, 0x55, 0x6f, 0x30, 0xb0,
+	0xc3, 0x27, 0x14, 0xa9, 0xa3, 0x76, 0x66, 0xa6, 0xd9, 0xb9, 0x05, 0xe5, 0xc8, 0x4c, 0x01, 0xd0,
+	0x7d, 0xcb, 0x2c, 0xe9, 0xfe, 0x65, 0xd6, 0xef, 0x24, 0x98, 0xc3, 0x9e, 0xe3, 0xec, 0x58, 0xdd,
+	0xbd, 0xa7, 0x1b, 0x2f, 0x04, 0xf2, 0xd8, 0x50, 0x8e, 0x98, 0xf2, 0x47, 0x09, 0xca, 0x2d, 0x9f,
+	0xd0, 0x1f, 0xfe, 0xa7, 0xda, 0x78, 0x5a, 0x3d, 0xf7, 0x42, 0x51, 0x77, 0x14, 0x30, 0x6b, 0x2b,
+	0xf3, 0x30, 0x17, 0xdb, 0x2e, 0xf0, 0xf8, 0x95, 0x04, 0x4b, 0x3c, 0xa8, 0x84, 0xa4, 0xf7, 0x84,
+	0xc2, 0x12, 0xd9, 0x9b, 0x49, 0xd8, 0x5b, 0x81, 0xe3, 0x87, 0x6d, 0x13, 0x66, 0x7f, 0x90, 0x82,
+	0x13, 0x51, 0x6c, 0x3c, 0xe1, 0x86, 0xff, 0x05, 0xf1, 0xb0, 0x0c, 0x95, 0xa3, 0x20, 0x08, 0x84,
+	0x3e, 0x4a, 0x41, 0xa5, 0xea, 0x13, 0x2b, 0x24, 0x89, 0xfa, 0xe5, 0xe9, 0x89, 0x0d, 0xf4, 0x3a,
+	0xcc, 0x0e, 0x2d, 0x3f, 0xb4, 0xbb, 0xf6, 0xd0, 0xa2, 0x7f, 0x88, 0xd9, 0x95, 0xf4, 0xd1, 0x01,
+	0x26, 0x54, 0x94, 0x53, 0x70, 0x72, 0x0a, 0x22, 0x02, 0xaf, 0x3f, 0x49, 0x80, 0xda, 0xa1, 0xe5,
+	0x87, 0x5f, 0x82, 0x9d, 0x68, 0x6a, 0x30, 0x2d, 0xc1, 0xc2, 0x84, 0xfd, 0x49, 0x5c, 0x48, 0xf8,
+	0xa5, 0xd8, 0x71, 0xee, 0x8b, 0x4b, 0xd2, 0x7e, 0x81, 0xcb, 0x6f, 0x24, 0x58, 0xae, 0x7a, 0xfc,
+	0x00, 0xf3, 0xa9, 0xfc, 0xc2, 0x94, 0x67, 0xe1, 0xd4, 0x54, 0x03, 0x05, 0x00, 0xbf, 0x96, 0xe0,
+	0x38, 0x26, 0x56, 0xef, 0xe9, 0x34, 0xfe, 0x2a, 0x9c, 0x38, 0x62, 0x9c, 0x28, 0xda, 0x2e, 0x40,
+	0x7e, 0x40, 0x42, 0xab, 0x67, 0x85, 0x96, 0x30, 0x69, 0x39, 0x1a, 0x77, 0xac, 0xdd, 0x10, 0x1a,
+	0x38, 0xd6, 0x55, 0x3e, 0x4d, 0xc1, 0x02, 0xab, 0x8f, 0xbf, 0xfa, 0x39, 0x9b, 0xfe, 0xff, 0xf0,
+	0x91, 0x04, 0x8b, 0x93, 0x00, 0xc5, 0xff, 0x11, 0x7f, 0xed, 0x33, 0x8e, 0x29, 0x09, 0x21, 0x3d,
+	0xad, 0x04, 0xfd, 0x79, 0x0a, 0x2a, 0xc9, 0x25, 0x7d, 0x75, 0x1e, 0x32, 0x79, 0x1e, 0xf2, 0x85,
+	0x0f, 0xc0, 0x3e, 0x96, 0xe0, 0xe4, 0x14, 0x40, 0xbf, 0x98, 0xa3, 0x13, 0xa7, 0x22, 0xa9, 0x87,
+	0x9e, 0x8a, 0x3c, 0xaa, 0xab, 0x7f, 0x29, 0xc1, 0x62, 0x83, 0x1f, 0x46, 0xf3, 0x7f, 0xff, 0x27,
+	0x37, 0x9b, 0xb1, 0xf3, 0xe6, 0xcc, 0xf8, 0x9a, 0x88, 0x9e, 0x67, 0x1c, 0x32, 0xed, 0x31, 0xce,
+	0x33, 0x3e, 0x97, 0x60, 0x5e, 0x8c, 0xa2, 0x76, 0xf7, 0x9e, 0x1e, 0x74, 0xd0, 0x73, 0x90, 0xb6,
+	0x7b, 0x51, 0x05, 0x39, 0x79, 0x39, 0x4f, 0x05, 0xca, 0x25, 0x40, 0x49, 0xbb, 0x1f, 0x03, 0xba,
+	0xcf, 0xd2, 0x30, 0xdf, 0x1e, 0x3a, 0x76, 0x28, 0x84, 0x4f, 0x77, 0xe2, 0x7f, 0x01, 0x66, 0x03,
+	0x6a, 0xac, 0xc9, 0xaf, 0xfe, 0x18, 0xb0, 0x05, 0x5c, 0x64, 0xbc, 0x2a, 0x63, 0xa1, 0xe7, 0xa1,
+	0x18, 0xa9, 0x8c, 0xdc, 0x50, 0x1c, 0xc2, 0x81, 0xd0, 0x18, 0xb9, 0x21, 0x3a, 0x0f, 0x27, 0xdc,
+	0xd1, 0x80, 0x5d, 0xb5, 0x9b, 0x43, 0xe2, 0x47, 0x17, 0xd1, 0x96, 0x1f, 0x5d, 0x89, 0x2f, 0xb8,
+	0xa3, 0x01, 0xbd, 0x71, 0x6f, 0x11, 0x9f, 0x5f, 0x44, 0x5b, 0x7e, 0x88, 0x2e, 0x41, 0xc1, 0x72,
+	0xfa, 0x9e, 0x6f, 0x87, 0xbb, 0x03, 0x71, 0x17, 0xae, 0x44, 0xb7, 0x36, 0x87, 0xe1, 0x5f, 0x57,
+	0x23, 0x4d, 0x3c, 0xee, 0xa4, 0xbc, 0x06, 0x85, 0x98, 0x4f, 0xaf, 0x71, 0xb5, 0xab, 0x1d, 0xb5,
+	0x6e, 0xb6, 0x5b, 0xf5, 0x9a, 0xd1, 0xe6, 0xf7, 0xd1, 0xdb, 0x9d, 0x7a, 0xdd, 0x6c, 0x57, 0x55,
+	0x5d, 0x96, 0x14, 0x0c, 0xc0, 0x86, 0x64, 0x83, 0x8f, 0x01, 0x92, 0x1e, 0x02, 0xd0, 0x29, 0x28,
+	0xf8, 0xde, 0x1d, 0x61, 0x7b, 0x8a, 0x99, 0x93, 0xf7, 0xbd, 0x3b, 0xcc, 0x72, 0x45, 0x05, 0x94,
+	0x5c, 0xab, 0x88, 0xb6, 0x44, 0xf2, 0x96, 0x26, 0x92, 0xf7, 0x78, 0xfe, 0x38, 0x79, 0xf3, 0x52,
+	0x9e, 0x7e, 0xe7, 0xef, 0x10, 0xcb, 0x09, 0xa3, 0xfd, 0x4a, 0xf9, 0x2c, 0x05, 0x25, 0x4c, 0x39,
+	0xf6, 0x80, 0xd0, 0x23, 0xa5, 0x80, 0x7a, 0x6a, 0x97, 0xa9, 0x98, 0xe3, 0xb4, 0x5b, 0xc0, 0x45,
+	0xce, 0xe3, 0xf7, 0x0b, 0xec, 0x88, 0xaa, 0xeb, 0xb9, 0xbd, 0xc0, 0xdc, 0x21, 0xbb, 0xf4, 0xfd,
+	0xc9, 0xc0, 0x0a, 0x42, 0x71, 0xed, 0x59, 0xc2, 0x0b, 0x42, 0xb8, 0xc9, 0x64, 0x0d, 0x26, 0x42,
+	0x67, 0x61, 0x71, 0xc7, 0x76, 0x1d, 0xaf, 0x4f, 0x5f, 0x0e, 0x1c, 0x10, 0x3f, 0x10, 0xa6, 0xd2,
+	0xf0, 0xca, 0x62, 0xc4, 0x65, 0x2d, 0x2e, 0xe2, 0xee, 0xbe, 0x09, 0x6b, 0x53, 0x67, 0x31, 0x6f,
+	0xd9, 0x4e, 0x48, 0x7c, 0xd2, 0x33, 0x7d, 0x32, 0x74, 0xec, 0x2e, 0x7f, 0xe5, 0xc0, 0x6b, 0xf7,
+	0x97, 0xa7, 0x4c, 0xbd, 0x2d, 0xd4, 0xf1, 0x58, 0x9b, 0xa2, 0xdd, 0x1d, 0x8e, 0xcc, 0x11, 0xbb,
+	0x75, 0xa4, 0xbb, 0x98, 0x84, 0xf3, 0xdd, 0xe1, 0xa8, 0x43, 0x69, 0x7a, 0x1d, 0x76, 0x7b, 0xc8,
+	0x37, 0x2f, 0x09, 0xd3, 0x26, 0x5d, 0x3c, 0xbf, 0xb5, 0x0b, 0xba, 0xbb, 0x64, 0x60, 0x89, 0x23,
+	0xb9, 0x5e, 0x65, 0x86, 0x45, 0x31, 0x62, 0xb2, 0x36, 0x13, 0xf1, 0x13, 0xb9, 0x1e, 0x3d, 0xe8,
+	0x2d, 0xab, 0xfd, 0xbe, 0x4f, 0xfa, 0x56, 0x28, 0x80, 0x3d, 0x0b, 0x8b, 0x1c, 0xc4, 0x03, 0x53,
+	0x3c, 0xb8, 0xe2, 0x08, 0x48, 0x1c, 0x01, 0x21, 0xe3, 0xcf, 0xad, 0xa2, 0x80, 0x3f, 0x3e, 0x72,
+	0xa7, 0xf6, 0x49, 0xb1, 0x3e, 0x8b, 0x23, 0x77, 0x4a, 0xaf, 0xbf, 0x87, 0x93, 0xd3, 0x71, 0x1b,
+	0xd8, 0xfc, 0xc9, 0x4c, 0x09, 0x1f, 0x9f, 0x02, 0x53, 0xc3, 0x76, 0x1f, 0xd0, 0xd5, 0xba, 0x5b,
+	0xc9, 0xdc, 0xbf, 0xab, 0x75, 0x57, 0xf9, 0x51, 0x7c, 0xcf, 0x10, 0x05, 0x58, 0xbc, 0x7f, 0x47,
+	0x99, 0x44, 0x7a, 0x50, 0x26, 0xa9, 0xc0, 0x4c, 0x40, 0xfc, 0x7d, 0xdb, 0xed, 0x47, 0x97, 0xe7,
+	0x82, 0x44, 0x6d, 0x78, 0x59, 0xd8, 0x4e, 0xee, 0x86, 0xc4, 0x77, 0x2d, 0xc7, 0x39, 0x30, 0xf9,
+	0xd1, 0x86, 0x1b, 0x92, 0x9e, 0x39, 0x7e, 0x1e, 0xc6, 0xf7, 0xf0, 0x17, 0xb9, 0xb6, 0x16, 0x2b,
+	0xe3, 0x58, 0xd7, 0x88, 0x54, 0xd1, 0x5b, 0x50, 0xf6, 0x45, 0xd8, 0xb3, 0x63, 0xd6, 0xe8, 0x3c,
+	0x7b, 0x31, 0xbe, 0x01, 0x4f, 0x7c, 0x13, 0xb8, 0xe4, 0x27, 0x49, 0x74, 0x11, 0x66, 0xc5, 0x8a,
+	0x2c, 0xc7, 0xb6, 0xc6, 0xa5, 0xec, 0xa1, 0x37, 0x73, 0x2a, 0x15, 0xe2, 0x62, 0x38, 0x26, 0xde,
+	0xcd, 0xe4, 0x73, 0xf2, 0x0c, 0xfd, 0x7f, 0x5e, 0xe8, 0x0c, 0x7b, 0x2c, 0x32, 0x9e, 0xe0, 0xaa,
+	0x22, 0xf9, 0xcc, 0x2e, 0x33, 0xf9, 0xcc, 0x6e, 0xf2, 0xd9, 0x5e, 0xf6, 0xd0, 0xb3, 0x3d, 0xe5,
+	0x12, 0x2c, 0x4e, 0xda, 0x2f, 0x62, 0x65, 0x15, 0xb2, 0xec, 0xf2, 0xfd, 0xd0, 0xf6, 0x99, 0xb8,
+	0x5d, 0xc7, 0x5c, 0x41, 0xf9, 0xb1, 0x04, 0x0b, 0x53, 0x7e, 0xad, 0xe2, 0xff, 0x36, 0x29, 0x71,
+	0x2c, 0xf4, 0xb7, 0x90, 0xa5, 0x2e, 0x8e, 0x5e, 0xc4, 0x9c, 0x38, 0xfa, 0x67, 0x46, 0xdd, 0x4a,
+	0x30, 0xd7, 0xa2, 0x09, 0x90, 0x85, 0x45, 0x97, 0x9d, 0x0b, 0x45, 0x95, 0x61, 0x91, 0xf2, 0xf8,
+	0x51, 0xd1, 0xd1, 0x83, 0xa6, 0xcc, 0x43, 0x0f, 0x9a, 0xd6, 0xbe, 0x9e, 0x86, 0x42, 0xe3, 0xa0,
+	0x7d, 0xdb, 0xd9, 0x76, 0xac, 0x3e, 0xbb, 0x53, 0x6f, 0xb4, 0x8c, 0x1b, 0xf2, 0x31, 0xfa, 0xc2,
+	0x49, 0x6f, 0x1a, 0xa6, 0x4e, 0xb7, 0x90, 0xed, 0xba, 0x7a, 0x59, 0x96, 0xe8, 0x1e, 0xd3, 0xc2,
+	0x35, 0xf3, 0x8a, 0x76, 0x83, 0x73, 0x52, 0xf4, 0xed, 0x51, 0x47, 0xaf, 0x5d, 0xed, 0x68, 0x63,
+	0x66, 0x06, 0x2d, 0xc1, 0x7c, 0xa3, 0x53, 0x37, 0x6a, 0xad, 0x7a, 0x82, 0x9d, 0xa7, 0xfb, 0xd1,
+	0x66, 0xbd, 0xb9, 0xc9, 0x49, 0x99, 0x8e, 0xdf, 0xd1, 0xdb, 0xb5, 0xcb, 0xba, 0xb6, 0xc5, 0x59,
+	0x2b, 0x94, 0x75, 0x53, 0xc3, 0xcd, 0xed, 0x5a, 0x34, 0xe5, 0x25, 0x24, 0x43, 0x71, 0xb3, 0xa6,
+	0xab, 0x58, 0x8c, 0x72, 0x4f, 0x42, 0x65, 0x28, 0x68, 0x7a, 0xa7, 0x21, 0xe8, 0x14, 0xaa, 0xc0,
+	0x02, 0x7d, 0x8a, 0x64, 0xd6, 0xf4, 0x2a, 0xd6, 0x1a, 0xf4, 0xc5, 0x12, 0x97, 0x64, 0xd0, 0x02,
+	0x94, 0x8d, 0x5a, 0x43, 0x6b, 0x1b, 0x6a, 0xa3, 0x25, 0x98, 0x74, 0x15, 0xf9, 0xb6, 0x16, 0xe9,
+	0xc8, 0x68, 0x19, 0x96, 0xf4, 0xa6, 0x29, 0x1e, 0x53, 0x99, 0xd7, 0xd4, 0x7a, 0x47, 0x13, 0xb2,
+	0x15, 0x74, 0x02, 0x50, 0x53, 0x37, 0x3b, 0xad, 0x2d, 0xd5, 0xd0, 0x4c, 0xbd, 0x79, 0x5d, 0x08,
+	0x2e, 0xa1, 0x32, 0xe4, 0xc7, 0x2b, 0xb8, 0x47, 0x51, 0x28, 0xb5, 0x54, 0x6c, 0x8c, 0x8d, 0xbd,
+	0x77, 0x8f, 0x82, 0x05, 0x97, 0x71, 0xb3, 0xd3, 0x1a, 0xab, 0xcd, 0x43, 0x51, 0x80, 0x25, 0x58,
+	0x19, 0xca, 0xda, 0xac, 0xe9, 0xd5, 0x78, 0x7d, 0xf7, 0xf2, 0xcb, 0x29, 0x59, 0x5a, 0xdb, 0x83,
+	0x0c, 0x73, 0x47, 0x1e, 0x32, 0x7a, 0x53, 0xa7, 0x8f, 0xcb, 0xe6, 0x00, 0x6a, 0xed, 0x9a, 0x6e,
+	0x68, 0x97, 0xb1, 0x5a, 0xa7, 0x66, 0x33, 0x46, 0x04, 0x20, 0xb5, 0x76, 0x16, 0x66, 0x6a, 0xed,
+	0xed, 0x7a, 0x53, 0x35, 0x84, 0x99, 0xb5, 0xf6, 0xd5, 0x4e, 0x93, 0xbe, 0xf1, 0xba, 0x27, 0xa3,
+	0x22, 0xe4, 0xe8, 0x73, 0xae, 0xf7, 0x0c, 0x6a, 0x17, 0x93, 0x71, 0x54, 0xe5, 0x7b, 0x97, 0xd6,
+	0x3e, 0x49, 0x43, 0x86, 0x3d, 0x9d, 0x2d, 0x41, 0x81, 0x79, 0x9b, 0xbe, 0x62, 0x93, 0x8f, 0xa1,
+	0x02, 0x64, 0x6a, 0xba, 0x71, 0x51, 0xfe, 0xe7, 0x14, 0x02, 0xc8, 0x76, 0x58, 0xfb, 0x5f, 0x72,
+	0xb4, 0x5d, 0xd3, 0x8d, 0xd7, 0x2f, 0xc8, 0x1f, 0xa4, 0xe8, 0xb0, 0x1d, 0x4e, 0xfc, 0x6b, 0x24,
+	0xd8, 0x38, 0x2f, 0x7f, 0x18, 0x0b, 0x36, 0xce, 0xcb, 0xff, 0x16, 0x09, 0xce, 0x6d, 0xc8, 0xff,
+	0x1e, 0x0b, 0xce, 0x6d, 0xc8, 0xff, 0x11, 0x09, 0x2e, 0x9c, 0x97, 0xff, 0x33, 0x16, 0x5c, 0x38,
+	0x2f, 0xff, 0x57, 0x8e, 0xda, 0xc2, 0x2c, 0x39, 0xb7, 0x21, 0xff, 0x77, 0x3e, 0xa6, 0x2e, 0x9c,
+	0x97, 0xff, 0x27, 0x4f, 0xfd, 0x1f, 0x7b, 0x55, 0xfe, 0x5f, 0x99, 0x2e, 0x93, 0x3a, 0x48, 0xfe,
+	0x3f, 0xd6, 0xa4, 0x22, 0xf9, 0xff, 0x65, 0x6a, 0x23, 0xe5, 0x32, 0xf2, 0x23, 0x26, 0xb9, 0xa1,
+	0xa9, 0x58, 0xfe, 0x5a, 0x8e, 0xbf, 0x9d, 0xab, 0xd6, 0x1a, 0x6a, 0x5d, 0x46, 0xac, 0x07, 0x45,
+	0xe5, 0x1b, 0x67, 0x69, 0x93, 0x86, 0xa7, 0xfc, 0xcd, 0x16, 0x9d, 0xf0, 0x9a, 0x8a, 0xab, 0xef,
+	0xa8, 0x58, 0xfe, 0xd6, 0x59, 0x3a, 0xe1, 0x35, 0x15, 0x0b, 0xbc, 0xbe, 0xdd, 0xa2, 0x8a, 0x4c,
+	0xf4, 0xf1, 0x59, 0xba, 0x68, 0xc1, 0xff, 0x4e, 0x0b, 0xe5, 0x21, 0xbd, 0x59, 0x33, 0xe4, 0x4f,
+	0xd8, 0x6c, 0x34, 0x44, 0xe5, 0xef, 0xca, 0x94, 0xd9, 0xd6, 0x0c, 0xf9, 0x7b, 0x94, 0x99, 0x35,
+	0x3a, 0xad, 0xba, 0x26, 0x3f, 0x43, 0x17, 0x77, 0x59, 0x6b, 0x36, 0x34, 0x03, 0xdf, 0x90, 0xbf,
+	0xcf, 0xd4, 0xdf, 0x6d, 0x37, 0x75, 0xf9, 0x53, 0x99, 0xbe, 0xab, 0xd3, 0xde, 0x6b, 0x61, 0xad,
+	0xdd, 0xae, 0x35, 0x75, 0xf9, 0xf9, 0xb5, 0x6d, 0x90, 0x0f, 0xa7, 0x03, 0x6a, 0x40, 0x47, 0xbf,
+	0xa2, 0x37, 0xaf, 0xeb, 0xf2, 0x31, 0x4a, 0xb4, 0xb0, 0xd6, 0x52, 0xb1, 0x26, 0x4b, 0x08, 0x20,
+	0x27, 0x5e, 0xe4, 0xa5, 0xd0, 0x2c, 0xe4, 0x71, 0xb3, 0x5e, 0xdf, 0x54, 0xab, 0x57, 0xe4, 0xf4,
+	0xe6, 0x1b, 0x30, 0x67, 0x7b, 0xeb, 0xfb, 0x76, 0x48, 0x82, 0x80, 0x3f, 0xce, 0xbe, 0xa9, 0x08,
+	0xca, 0xf6, 0xce, 0xf0, 0xd6, 0x99, 0xbe, 0x77, 0x66, 0x3f, 0x3c, 0xc3, 0xa4, 0x67, 0x58, 0xc6,
+	0xd8, 0xc9, 0x31, 0xe2, 0xdc, 0x9f, 0x07, 0x00, 0x35, 0x53, 0x2f, 0xcf, 0xfa, 0x2d, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2682,6 +2683,77 @@ func (m *ResetReplicationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResetReplicationResponse proto.InternalMessageInfo
 
+type InjectEmptyTransactionsRequest struct {
+	// gtids is a MySQL 5.6+ GTID set.
+	Gtids                string   `protobuf:"bytes,1,opt,name=gtids,proto3" json:"gtids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InjectEmptyTransactionsRequest) Reset()         { *m = InjectEmptyTransactionsRequest{} }
+func (m *InjectEmptyTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*InjectEmptyTransactionsRequest) ProtoMessage()    {}
+func (*InjectEmptyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
+}
+
+func (m *InjectEmptyTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InjectEmptyTransactionsRequest.Unmarshal(m, b)
+}
+func (m *InjectEmptyTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InjectEmptyTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *InjectEmptyTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectEmptyTransactionsRequest.Merge(m, src)
+}
+func (m *InjectEmptyTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_InjectEmptyTransactionsRequest.Size(m)
+}
+func (m *InjectEmptyTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectEmptyTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectEmptyTransactionsRequest proto.InternalMessageInfo
+
+func (m *InjectEmptyTransactionsRequest) GetGtids() string {
+	if m != nil {
+		return m.Gtids
+	}
+	return ""
+}
+
+type InjectEmptyTransactionsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InjectEmptyTransactionsResponse) Reset()         { *m = InjectEmptyTransactionsResponse{} }
+func (m *InjectEmptyTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*InjectEmptyTransactionsResponse) ProtoMessage()    {}
+func (*InjectEmptyTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
+}
+
+func (m *InjectEmptyTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InjectEmptyTransactionsResponse.Unmarshal(m, b)
+}
+func (m *InjectEmptyTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InjectEmptyTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *InjectEmptyTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectEmptyTransactionsResponse.Merge(m, src)
+}
+func (m *InjectEmptyTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_InjectEmptyTransactionsResponse.Size(m)
+}
+func (m *InjectEmptyTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectEmptyTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectEmptyTransactionsResponse proto.InternalMessageInfo
+
 type VReplicationExecRequest struct {
 	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2693,7 +2765,7 @@ func (m *VReplicationExecRequest) Reset()         { *m = VReplicationExecRequest
 func (m *VReplicationExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecRequest) ProtoMessage()    {}
 func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
 }
 
 func (m *VReplicationExecRequest) XXX_Unmarshal(b []byte) error {
@@ -2732,7 +2804,7 @@ func (m *VReplicationExecResponse) Reset()         { *m = VReplicationExecRespon
 func (m *VReplicationExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecResponse) ProtoMessage()    {}
 func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
 }
 
 func (m *VReplicationExecResponse) XXX_Unmarshal(b []byte) error {
@@ -2772,7 +2844,7 @@ func (m *VReplicationWaitForPosRequest) Reset()         { *m = VReplicationWaitF
 func (m *VReplicationWaitForPosRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosRequest) ProtoMessage()    {}
 func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
 }
 
 func (m *VReplicationWaitForPosRequest) XXX_Unmarshal(b []byte) error {
@@ -2817,7 +2889,7 @@ func (m *VReplicationWaitForPosResponse) Reset()         { *m = VReplicationWait
 func (m *VReplicationWaitForPosResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosResponse) ProtoMessage()    {}
 func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
 }
 
 func (m *VReplicationWaitForPosResponse) XXX_Unmarshal(b []byte) error {
@@ -2848,7 +2920,7 @@ func (m *InitMasterRequest) Reset()         { *m = InitMasterRequest{} }
 func (m *InitMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*InitMasterRequest) ProtoMessage()    {}
 func (*InitMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
 }
 
 func (m *InitMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -2880,7 +2952,7 @@ func (m *InitMasterResponse) Reset()         { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()    {}
 func (*InitMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
 }
 
 func (m *InitMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -2922,7 +2994,7 @@ func (m *PopulateReparentJournalRequest) Reset()         { *m = PopulateReparent
 func (m *PopulateReparentJournalRequest) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalRequest) ProtoMessage()    {}
 func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
 }
 
 func (m *PopulateReparentJournalRequest) XXX_Unmarshal(b []byte) error {
@@ -2981,7 +3053,7 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
 }
 
 func (m *PopulateReparentJournalResponse) XXX_Unmarshal(b []byte) error {
@@ -3015,7 +3087,7 @@ func (m *InitSlaveRequest) Reset()         { *m = InitSlaveRequest{} }
 func (m *InitSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveRequest) ProtoMessage()    {}
 func (*InitSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
 }
 
 func (m *InitSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3067,7 +3139,7 @@ func (m *InitSlaveResponse) Reset()         { *m = InitSlaveResponse{} }
 func (m *InitSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveResponse) ProtoMessage()    {}
 func (*InitSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
 }
 
 func (m *InitSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -3098,7 +3170,7 @@ func (m *DemoteMasterRequest) Reset()         { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()    {}
 func (*DemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
 }
 
 func (m *DemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3130,7 +3202,7 @@ func (m *DemoteMasterResponse) Reset()         { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()    {}
 func (*DemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
 }
 
 func (m *DemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3168,7 +3240,7 @@ func (m *UndoDemoteMasterRequest) Reset()         { *m = UndoDemoteMasterRequest
 func (m *UndoDemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterRequest) ProtoMessage()    {}
 func (*UndoDemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
 }
 
 func (m *UndoDemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3199,7 +3271,7 @@ func (m *UndoDemoteMasterResponse) Reset()         { *m = UndoDemoteMasterRespon
 func (m *UndoDemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterResponse) ProtoMessage()    {}
 func (*UndoDemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
 }
 
 func (m *UndoDemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3231,7 +3303,7 @@ func (m *PromoteSlaveWhenCaughtUpRequest) Reset()         { *m = PromoteSlaveWhe
 func (m *PromoteSlaveWhenCaughtUpRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpRequest) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
 }
 
 func (m *PromoteSlaveWhenCaughtUpRequest) XXX_Unmarshal(b []byte) error {
@@ -3270,7 +3342,7 @@ func (m *PromoteSlaveWhenCaughtUpResponse) Reset()         { *m = PromoteSlaveWh
 func (m *PromoteSlaveWhenCaughtUpResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpResponse) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
 }
 
 func (m *PromoteSlaveWhenCaughtUpResponse) XXX_Unmarshal(b []byte) error {
@@ -3308,7 +3380,7 @@ func (m *SlaveWasPromotedRequest) Reset()         { *m = SlaveWasPromotedRequest
 func (m *SlaveWasPromotedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedRequest) ProtoMessage()    {}
 func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
 }
 
 func (m *SlaveWasPromotedRequest) XXX_Unmarshal(b []byte) error {
@@ -3339,7 +3411,7 @@ func (m *SlaveWasPromotedResponse) Reset()         { *m = SlaveWasPromotedRespon
 func (m *SlaveWasPromotedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedResponse) ProtoMessage()    {}
 func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
 }
 
 func (m *SlaveWasPromotedResponse) XXX_Unmarshal(b []byte) error {
@@ -3374,7 +3446,7 @@ func (m *SetMasterRequest) Reset()         { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()    {}
 func (*SetMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
 }
 
 func (m *SetMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3433,7 +3505,7 @@ func (m *SetMasterResponse) Reset()         { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()    {}
 func (*SetMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
 }
 
 func (m *SetMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3466,7 +3538,7 @@ func (m *SlaveWasRestartedRequest) Reset()         { *m = SlaveWasRestartedReque
 func (m *SlaveWasRestartedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedRequest) ProtoMessage()    {}
 func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
 }
 
 func (m *SlaveWasRestartedRequest) XXX_Unmarshal(b []byte) error {
@@ -3504,7 +3576,7 @@ func (m *SlaveWasRestartedResponse) Reset()         { *m = SlaveWasRestartedResp
 func (m *SlaveWasRestartedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedResponse) ProtoMessage()    {}
 func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
 }
 
 func (m *SlaveWasRestartedResponse) XXX_Unmarshal(b []byte) error {
@@ -3535,7 +3607,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
 }
 
 func (m *StopReplicationAndGetStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3567,7 +3639,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
 }
 
 func (m *StopReplicationAndGetStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3605,7 +3677,7 @@ func (m *PromoteSlaveRequest) Reset()         { *m = PromoteSlaveRequest{} }
 func (m *PromoteSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveRequest) ProtoMessage()    {}
 func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
 }
 
 func (m *PromoteSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3637,7 +3709,7 @@ func (m *PromoteSlaveResponse) Reset()         { *m = PromoteSlaveResponse{} }
 func (m *PromoteSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveResponse) ProtoMessage()    {}
 func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
 }
 
 func (m *PromoteSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -3677,7 +3749,7 @@ func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
 func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()    {}
 func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
 }
 
 func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3723,7 +3795,7 @@ func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
 func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()    {}
 func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
 }
 
 func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3752,6 +3824,13 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
+	// restore_to_time, if set, restores the tablet to this time: the
+	// most recent backup that finished before is restored, and the
+	// archived binlogs are replayed until this time.
+	RestoreToTime *vttime.Time `protobuf:"bytes,1,opt,name=restore_to_time,json=restoreToTime,proto3" json:"restore_to_time,omitempty"`
+	// restore_to_pos, if set, restores the tablet to this replication
+	// position, in the same way.
+	RestoreToPos         string   `protobuf:"bytes,2,opt,name=restore_to_pos,json=restoreToPos,proto3" json:"restore_to_pos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3761,7 +3840,7 @@ func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupReque
 func (m *RestoreFromBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()    {}
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
 }
 
 func (m *RestoreFromBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3782,6 +3861,20 @@ func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
 
+func (m *RestoreFromBackupRequest) GetRestoreToTime() *vttime.Time {
+	if m != nil {
+		return m.RestoreToTime
+	}
+	return nil
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
 type RestoreFromBackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3793,7 +3886,7 @@ func (m *RestoreFromBackupResponse) Reset()         { *m = RestoreFromBackupResp
 func (m *RestoreFromBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()    {}
 func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
 }
 
 func (m *RestoreFromBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3821,6 +3914,350 @@ func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+type SetBackupMaxRateRequest struct {
+	// max_rate is the rate limit of the backups and restores of the
+	// tablet, in bytes per second. 0 means no limit.
+	MaxRate              int64    `protobuf:"varint,1,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBackupMaxRateRequest) Reset()         { *m = SetBackupMaxRateRequest{} }
+func (m *SetBackupMaxRateRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBackupMaxRateRequest) ProtoMessage()    {}
+func (*SetBackupMaxRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
+}
+
+func (m *SetBackupMaxRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBackupMaxRateRequest.Unmarshal(m, b)
+}
+func (m *SetBackupMaxRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBackupMaxRateRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBackupMaxRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBackupMaxRateRequest.Merge(m, src)
+}
+func (m *SetBackupMaxRateRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBackupMaxRateRequest.Size(m)
+}
+func (m *SetBackupMaxRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBackupMaxRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBackupMaxRateRequest proto.InternalMessageInfo
+
+func (m *SetBackupMaxRateRequest) GetMaxRate() int64 {
+	if m != nil {
+		return m.MaxRate
+	}
+	return 0
+}
+
+type SetBackupMaxRateResponse struct {
+	// previous_max_rate is the rate limit before the change.
+	PreviousMaxRate      int64    `protobuf:"varint,1,opt,name=previous_max_rate,json=previousMaxRate,proto3" json:"previous_max_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBackupMaxRateResponse) Reset()         { *m = SetBackupMaxRateResponse{} }
+func (m *SetBackupMaxRateResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBackupMaxRateResponse) ProtoMessage()    {}
+func (*SetBackupMaxRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
+}
+
+func (m *SetBackupMaxRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBackupMaxRateResponse.Unmarshal(m, b)
+}
+func (m *SetBackupMaxRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBackupMaxRateResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBackupMaxRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBackupMaxRateResponse.Merge(m, src)
+}
+func (m *SetBackupMaxRateResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBackupMaxRateResponse.Size(m)
+}
+func (m *SetBackupMaxRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBackupMaxRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBackupMaxRateResponse proto.InternalMessageInfo
+
+func (m *SetBackupMaxRateResponse) GetPreviousMaxRate() int64 {
+	if m != nil {
+		return m.PreviousMaxRate
+	}
+	return 0
+}
+
+type GetMysqlVariablesRequest struct {
+	// names are the names of the global variables to return,
+	// all of them if empty.
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMysqlVariablesRequest) Reset()         { *m = GetMysqlVariablesRequest{} }
+func (m *GetMysqlVariablesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMysqlVariablesRequest) ProtoMessage()    {}
+func (*GetMysqlVariablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
+}
+
+func (m *GetMysqlVariablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMysqlVariablesRequest.Unmarshal(m, b)
+}
+func (m *GetMysqlVariablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMysqlVariablesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMysqlVariablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMysqlVariablesRequest.Merge(m, src)
+}
+func (m *GetMysqlVariablesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMysqlVariablesRequest.Size(m)
+}
+func (m *GetMysqlVariablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMysqlVariablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMysqlVariablesRequest proto.InternalMessageInfo
+
+func (m *GetMysqlVariablesRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type GetMysqlVariablesResponse struct {
+	// variables are the current values of the global variables.
+	Variables map[string]string `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// persisted are the variables changed by ApplyMysqlVariables,
+	// which are written in the my.cnf.
+	Persisted            map[string]string `protobuf:"bytes,2,rep,name=persisted,proto3" json:"persisted,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMysqlVariablesResponse) Reset()         { *m = GetMysqlVariablesResponse{} }
+func (m *GetMysqlVariablesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMysqlVariablesResponse) ProtoMessage()    {}
+func (*GetMysqlVariablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
+}
+
+func (m *GetMysqlVariablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMysqlVariablesResponse.Unmarshal(m, b)
+}
+func (m *GetMysqlVariablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMysqlVariablesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMysqlVariablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMysqlVariablesResponse.Merge(m, src)
+}
+func (m *GetMysqlVariablesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMysqlVariablesResponse.Size(m)
+}
+func (m *GetMysqlVariablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMysqlVariablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMysqlVariablesResponse proto.InternalMessageInfo
+
+func (m *GetMysqlVariablesResponse) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *GetMysqlVariablesResponse) GetPersisted() map[string]string {
+	if m != nil {
+		return m.Persisted
+	}
+	return nil
+}
+
+type ApplyMysqlVariablesRequest struct {
+	// variables are the new values of the global variables.
+	Variables map[string]string `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// allow_restart allows the variables which are not dynamic: they are
+	// only written in the my.cnf, and applied by the next restart of mysqld.
+	AllowRestart         bool     `protobuf:"varint,2,opt,name=allow_restart,json=allowRestart,proto3" json:"allow_restart,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyMysqlVariablesRequest) Reset()         { *m = ApplyMysqlVariablesRequest{} }
+func (m *ApplyMysqlVariablesRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyMysqlVariablesRequest) ProtoMessage()    {}
+func (*ApplyMysqlVariablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
+}
+
+func (m *ApplyMysqlVariablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyMysqlVariablesRequest.Unmarshal(m, b)
+}
+func (m *ApplyMysqlVariablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyMysqlVariablesRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyMysqlVariablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyMysqlVariablesRequest.Merge(m, src)
+}
+func (m *ApplyMysqlVariablesRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyMysqlVariablesRequest.Size(m)
+}
+func (m *ApplyMysqlVariablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyMysqlVariablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyMysqlVariablesRequest proto.InternalMessageInfo
+
+func (m *ApplyMysqlVariablesRequest) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *ApplyMysqlVariablesRequest) GetAllowRestart() bool {
+	if m != nil {
+		return m.AllowRestart
+	}
+	return false
+}
+
+type ApplyMysqlVariablesResponse struct {
+	// previous are the values of the variables before the change.
+	Previous map[string]string `protobuf:"bytes,1,rep,name=previous,proto3" json:"previous,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// restart_required are the names of the variables which are not
+	// applied until mysqld restarts.
+	RestartRequired      []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyMysqlVariablesResponse) Reset()         { *m = ApplyMysqlVariablesResponse{} }
+func (m *ApplyMysqlVariablesResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyMysqlVariablesResponse) ProtoMessage()    {}
+func (*ApplyMysqlVariablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
+}
+
+func (m *ApplyMysqlVariablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyMysqlVariablesResponse.Unmarshal(m, b)
+}
+func (m *ApplyMysqlVariablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyMysqlVariablesResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyMysqlVariablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyMysqlVariablesResponse.Merge(m, src)
+}
+func (m *ApplyMysqlVariablesResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyMysqlVariablesResponse.Size(m)
+}
+func (m *ApplyMysqlVariablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyMysqlVariablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyMysqlVariablesResponse proto.InternalMessageInfo
+
+func (m *ApplyMysqlVariablesResponse) GetPrevious() map[string]string {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *ApplyMysqlVariablesResponse) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
+type RestartMysqldRequest struct {
+	// upgrade_hook is the name of a hook run while mysqld is stopped,
+	// to upgrade it for instance. None is run if empty.
+	UpgradeHook          string   `protobuf:"bytes,1,opt,name=upgrade_hook,json=upgradeHook,proto3" json:"upgrade_hook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartMysqldRequest) Reset()         { *m = RestartMysqldRequest{} }
+func (m *RestartMysqldRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartMysqldRequest) ProtoMessage()    {}
+func (*RestartMysqldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
+}
+
+func (m *RestartMysqldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartMysqldRequest.Unmarshal(m, b)
+}
+func (m *RestartMysqldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartMysqldRequest.Marshal(b, m, deterministic)
+}
+func (m *RestartMysqldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartMysqldRequest.Merge(m, src)
+}
+func (m *RestartMysqldRequest) XXX_Size() int {
+	return xxx_messageInfo_RestartMysqldRequest.Size(m)
+}
+func (m *RestartMysqldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartMysqldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartMysqldRequest proto.InternalMessageInfo
+
+func (m *RestartMysqldRequest) GetUpgradeHook() string {
+	if m != nil {
+		return m.UpgradeHook
+	}
+	return ""
+}
+
+type RestartMysqldResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartMysqldResponse) Reset()         { *m = RestartMysqldResponse{} }
+func (m *RestartMysqldResponse) String() string { return proto.CompactTextString(m) }
+func (*RestartMysqldResponse) ProtoMessage()    {}
+func (*RestartMysqldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
+}
+
+func (m *RestartMysqldResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartMysqldResponse.Unmarshal(m, b)
+}
+func (m *RestartMysqldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartMysqldResponse.Marshal(b, m, deterministic)
+}
+func (m *RestartMysqldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartMysqldResponse.Merge(m, src)
+}
+func (m *RestartMysqldResponse) XXX_Size() int {
+	return xxx_messageInfo_RestartMysqldResponse.Size(m)
+}
+func (m *RestartMysqldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartMysqldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartMysqldResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -3891,6 +4328,8 @@ func init() {
 	proto.RegisterType((*GetSlavesResponse)(nil), "tabletmanagerdata.GetSlavesResponse")
 	proto.RegisterType((*ResetReplicationRequest)(nil), "tabletmanagerdata.ResetReplicationRequest")
 	proto.RegisterType((*ResetReplicationResponse)(nil), "tabletmanagerdata.ResetReplicationResponse")
+	proto.RegisterType((*InjectEmptyTransactionsRequest)(nil), "tabletmanagerdata.InjectEmptyTransactionsRequest")
+	proto.RegisterType((*InjectEmptyTransactionsResponse)(nil), "tabletmanagerdata.InjectEmptyTransactionsResponse")
 	proto.RegisterType((*VReplicationExecRequest)(nil), "tabletmanagerdata.VReplicationExecRequest")
 	proto.RegisterType((*VReplicationExecResponse)(nil), "tabletmanagerdata.VReplicationExecResponse")
 	proto.RegisterType((*VReplicationWaitForPosRequest)(nil), "tabletmanagerdata.VReplicationWaitForPosRequest")
@@ -3921,142 +4360,175 @@ func init() {
 	proto.RegisterType((*BackupResponse)(nil), "tabletmanagerdata.BackupResponse")
 	proto.RegisterType((*RestoreFromBackupRequest)(nil), "tabletmanagerdata.RestoreFromBackupRequest")
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
+	proto.RegisterType((*SetBackupMaxRateRequest)(nil), "tabletmanagerdata.SetBackupMaxRateRequest")
+	proto.RegisterType((*SetBackupMaxRateResponse)(nil), "tabletmanagerdata.SetBackupMaxRateResponse")
+	proto.RegisterType((*GetMysqlVariablesRequest)(nil), "tabletmanagerdata.GetMysqlVariablesRequest")
+	proto.RegisterType((*GetMysqlVariablesResponse)(nil), "tabletmanagerdata.GetMysqlVariablesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "tabletmanagerdata.GetMysqlVariablesResponse.PersistedEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tabletmanagerdata.GetMysqlVariablesResponse.VariablesEntry")
+	proto.RegisterType((*ApplyMysqlVariablesRequest)(nil), "tabletmanagerdata.ApplyMysqlVariablesRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tabletmanagerdata.ApplyMysqlVariablesRequest.VariablesEntry")
+	proto.RegisterType((*ApplyMysqlVariablesResponse)(nil), "tabletmanagerdata.ApplyMysqlVariablesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "tabletmanagerdata.ApplyMysqlVariablesResponse.PreviousEntry")
+	proto.RegisterType((*RestartMysqldRequest)(nil), "tabletmanagerdata.RestartMysqldRequest")
+	proto.RegisterType((*RestartMysqldResponse)(nil), "tabletmanagerdata.RestartMysqldResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2108 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x06, 0x49, 0x49, 0xa6, 0x0e, 0x2f, 0x22, 0x97, 0x94, 0x48, 0xc9, 0x8d, 0x2e, 0x6b, 0xa7,
-	0x51, 0x5d, 0x94, 0x4a, 0x94, 0x34, 0x08, 0x52, 0xa4, 0xa8, 0xac, 0x8b, 0xed, 0x44, 0x89, 0x95,
-	0x95, 0x65, 0x17, 0x41, 0x81, 0xc5, 0x90, 0x3b, 0x22, 0x17, 0x5a, 0xee, 0xac, 0x67, 0x66, 0x29,
-	0xf1, 0x4f, 0xf4, 0x17, 0xf4, 0xad, 0x40, 0xfb, 0xde, 0xc7, 0xfe, 0x10, 0xf7, 0xa7, 0xf4, 0xa1,
-	0x0f, 0x2d, 0xe6, 0xb2, 0xe4, 0x2c, 0x49, 0xc9, 0x92, 0xe0, 0x02, 0x79, 0x11, 0x38, 0xdf, 0x39,
-	0x73, 0x6e, 0x73, 0x6e, 0x0b, 0x41, 0x83, 0xa3, 0x76, 0x80, 0x79, 0x1f, 0x85, 0xa8, 0x8b, 0xa9,
-	0x87, 0x38, 0x6a, 0x45, 0x94, 0x70, 0x62, 0x55, 0xa7, 0x08, 0x6b, 0x85, 0xb7, 0x31, 0xa6, 0x43,
-	0x45, 0x5f, 0x2b, 0x73, 0x12, 0x91, 0x31, 0xff, 0xda, 0x32, 0xc5, 0x51, 0xe0, 0x77, 0x10, 0xf7,
-	0x49, 0x68, 0xc0, 0xa5, 0x80, 0x74, 0x63, 0xee, 0x07, 0xea, 0x68, 0xff, 0x37, 0x03, 0x4b, 0xaf,
-	0x84, 0xe0, 0x03, 0x7c, 0xee, 0x87, 0xbe, 0x60, 0xb6, 0x2c, 0x98, 0x0b, 0x51, 0x1f, 0x37, 0x33,
-	0x9b, 0x99, 0xed, 0x45, 0x47, 0xfe, 0xb6, 0x56, 0x60, 0x81, 0x75, 0x7a, 0xb8, 0x8f, 0x9a, 0x59,
-	0x89, 0xea, 0x93, 0xd5, 0x84, 0x07, 0x1d, 0x12, 0xc4, 0xfd, 0x90, 0x35, 0x73, 0x9b, 0xb9, 0xed,
-	0x45, 0x27, 0x39, 0x5a, 0x2d, 0xa8, 0x45, 0xd4, 0xef, 0x23, 0x3a, 0x74, 0x2f, 0xf0, 0xd0, 0x4d,
-	0xb8, 0xe6, 0x24, 0x57, 0x55, 0x93, 0xbe, 0xc3, 0xc3, 0x7d, 0xcd, 0x6f, 0xc1, 0x1c, 0x1f, 0x46,
-	0xb8, 0x39, 0xaf, 0xb4, 0x8a, 0xdf, 0xd6, 0x06, 0x14, 0x84, 0xe9, 0x6e, 0x80, 0xc3, 0x2e, 0xef,
-	0x35, 0x17, 0x36, 0x33, 0xdb, 0x73, 0x0e, 0x08, 0xe8, 0x58, 0x22, 0xd6, 0x43, 0x58, 0xa4, 0xe4,
-	0xd2, 0xed, 0x90, 0x38, 0xe4, 0xcd, 0x07, 0x92, 0x9c, 0xa7, 0xe4, 0x72, 0x5f, 0x9c, 0xad, 0xc7,
-	0xb0, 0x70, 0xee, 0xe3, 0xc0, 0x63, 0xcd, 0xfc, 0x66, 0x6e, 0xbb, 0xb0, 0x5b, 0x6c, 0xa9, 0x78,
-	0x1d, 0x09, 0xd0, 0xd1, 0x34, 0xfb, 0x6f, 0x19, 0xa8, 0x9c, 0x4a, 0x67, 0x8c, 0x10, 0x7c, 0x02,
-	0x4b, 0x42, 0x4b, 0x1b, 0x31, 0xec, 0x6a, 0xbf, 0x55, 0x34, 0xca, 0x09, 0xac, 0xae, 0x58, 0x2f,
-	0x41, 0xbd, 0x8b, 0xeb, 0x8d, 0x2e, 0xb3, 0x66, 0x56, 0xaa, 0xb3, 0x5b, 0xd3, 0x4f, 0x39, 0x11,
-	0x6a, 0xa7, 0xc2, 0xd3, 0x00, 0x13, 0x01, 0x1d, 0x60, 0xca, 0x7c, 0x12, 0x36, 0x73, 0x52, 0x63,
-	0x72, 0x14, 0x86, 0x5a, 0x4a, 0xeb, 0x7e, 0x0f, 0x85, 0x5d, 0xec, 0x60, 0x16, 0x07, 0xdc, 0x7a,
-	0x0e, 0xa5, 0x36, 0x3e, 0x27, 0x34, 0x65, 0x68, 0x61, 0xf7, 0xd1, 0x0c, 0xed, 0x93, 0x6e, 0x3a,
-	0x45, 0x75, 0x53, 0xfb, 0x72, 0x04, 0x45, 0x74, 0xce, 0x31, 0x75, 0x8d, 0x97, 0xbe, 0xa5, 0xa0,
-	0x82, 0xbc, 0xa8, 0x60, 0xfb, 0xdf, 0x19, 0x28, 0x9f, 0x31, 0x4c, 0x4f, 0x30, 0xed, 0xfb, 0x8c,
-	0xe9, 0x94, 0xea, 0x11, 0xc6, 0x93, 0x94, 0x12, 0xbf, 0x05, 0x16, 0x33, 0x4c, 0x75, 0x42, 0xc9,
-	0xdf, 0xd6, 0xaf, 0xa1, 0x1a, 0x21, 0xc6, 0x2e, 0x09, 0xf5, 0xdc, 0x4e, 0x0f, 0x77, 0x2e, 0x58,
-	0xdc, 0x97, 0x71, 0x98, 0x73, 0x2a, 0x09, 0x61, 0x5f, 0xe3, 0xd6, 0x8f, 0x00, 0x11, 0xf5, 0x07,
-	0x7e, 0x80, 0xbb, 0x58, 0x25, 0x56, 0x61, 0xf7, 0xb3, 0x19, 0xd6, 0xa6, 0x6d, 0x69, 0x9d, 0x8c,
-	0xee, 0x1c, 0x86, 0x9c, 0x0e, 0x1d, 0x43, 0xc8, 0xda, 0x37, 0xb0, 0x34, 0x41, 0xb6, 0x2a, 0x90,
-	0xbb, 0xc0, 0x43, 0x6d, 0xb9, 0xf8, 0x69, 0xd5, 0x61, 0x7e, 0x80, 0x82, 0x18, 0x6b, 0xcb, 0xd5,
-	0xe1, 0xeb, 0xec, 0x57, 0x19, 0xfb, 0x5d, 0x06, 0x8a, 0x07, 0xed, 0xf7, 0xf8, 0x5d, 0x86, 0xac,
-	0xd7, 0xd6, 0x77, 0xb3, 0x5e, 0x7b, 0x14, 0x87, 0x9c, 0x11, 0x87, 0x97, 0x33, 0x5c, 0xdb, 0x99,
-	0xe1, 0x9a, 0xa9, 0xec, 0xff, 0xe9, 0xd8, 0x5f, 0x33, 0x50, 0x18, 0x6b, 0x62, 0xd6, 0x31, 0x54,
-	0x84, 0x9d, 0x6e, 0x34, 0xc6, 0x9a, 0x19, 0x69, 0xe5, 0xd6, 0x7b, 0x1f, 0xc0, 0x59, 0x8a, 0x53,
-	0x67, 0x66, 0x1d, 0x41, 0xd9, 0x6b, 0xa7, 0x64, 0xa9, 0x0a, 0xda, 0x78, 0x8f, 0xc7, 0x4e, 0xc9,
-	0x33, 0x4e, 0xcc, 0xfe, 0x04, 0x0a, 0x27, 0x7e, 0xd8, 0x75, 0xf0, 0xdb, 0x18, 0x33, 0x2e, 0x4a,
-	0x29, 0x42, 0xc3, 0x80, 0x20, 0x4f, 0x3b, 0x99, 0x1c, 0xed, 0x6d, 0x28, 0x2a, 0x46, 0x16, 0x91,
-	0x90, 0xe1, 0x1b, 0x38, 0x9f, 0x40, 0xf1, 0x34, 0xc0, 0x38, 0x4a, 0x64, 0xae, 0x41, 0xde, 0x8b,
-	0xa9, 0x6c, 0xaa, 0x92, 0x35, 0xe7, 0x8c, 0xce, 0xf6, 0x12, 0x94, 0x34, 0xaf, 0x12, 0x6b, 0xff,
-	0x2b, 0x03, 0xd6, 0xe1, 0x15, 0xee, 0xc4, 0x1c, 0x3f, 0x27, 0xe4, 0x22, 0x91, 0x31, 0xab, 0xbf,
-	0xae, 0x03, 0x44, 0x88, 0xa2, 0x3e, 0xe6, 0x98, 0x2a, 0xf7, 0x17, 0x1d, 0x03, 0xb1, 0x4e, 0x60,
-	0x11, 0x5f, 0x71, 0x8a, 0x5c, 0x1c, 0x0e, 0x64, 0xa7, 0x2d, 0xec, 0x7e, 0x3e, 0x23, 0x3a, 0xd3,
-	0xda, 0x5a, 0x87, 0xe2, 0xda, 0x61, 0x38, 0x50, 0x39, 0x91, 0xc7, 0xfa, 0xb8, 0xf6, 0x3b, 0x28,
-	0xa5, 0x48, 0x77, 0xca, 0x87, 0x73, 0xa8, 0xa5, 0x54, 0xe9, 0x38, 0x6e, 0x40, 0x01, 0x5f, 0xf9,
-	0xdc, 0x65, 0x1c, 0xf1, 0x98, 0xe9, 0x00, 0x81, 0x80, 0x4e, 0x25, 0x22, 0xc7, 0x08, 0xf7, 0x48,
-	0xcc, 0x47, 0x63, 0x44, 0x9e, 0x34, 0x8e, 0x69, 0x52, 0x05, 0xfa, 0x64, 0x0f, 0xa0, 0xf2, 0x0c,
-	0x73, 0xd5, 0x57, 0x92, 0xf0, 0xad, 0xc0, 0x82, 0x74, 0x5c, 0x65, 0xdc, 0xa2, 0xa3, 0x4f, 0xd6,
-	0x23, 0x28, 0xf9, 0x61, 0x27, 0x88, 0x3d, 0xec, 0x0e, 0x7c, 0x7c, 0xc9, 0xa4, 0x8a, 0xbc, 0x53,
-	0xd4, 0xe0, 0x6b, 0x81, 0x59, 0x1f, 0x43, 0x19, 0x5f, 0x29, 0x26, 0x2d, 0x44, 0x8d, 0xad, 0x92,
-	0x46, 0x65, 0x83, 0x66, 0x36, 0x86, 0xaa, 0xa1, 0x57, 0x7b, 0x77, 0x02, 0x55, 0xd5, 0x19, 0x8d,
-	0x66, 0x7f, 0x97, 0x6e, 0x5b, 0x61, 0x13, 0x88, 0xdd, 0x80, 0xe5, 0x67, 0x98, 0x1b, 0x29, 0xac,
-	0x7d, 0xb4, 0x7f, 0x82, 0x95, 0x49, 0x82, 0x36, 0xe2, 0x0f, 0x50, 0x48, 0x17, 0x9d, 0x50, 0xbf,
-	0x3e, 0x43, 0xbd, 0x79, 0xd9, 0xbc, 0x62, 0xd7, 0xc1, 0x3a, 0xc5, 0xdc, 0xc1, 0xc8, 0x7b, 0x19,
-	0x06, 0xc3, 0x44, 0xe3, 0x32, 0xd4, 0x52, 0xa8, 0x4e, 0xe1, 0x31, 0xfc, 0x86, 0xfa, 0x1c, 0x27,
-	0xdc, 0x2b, 0x50, 0x4f, 0xc3, 0x9a, 0xfd, 0x5b, 0xa8, 0xaa, 0xe1, 0xf4, 0x6a, 0x18, 0x25, 0xcc,
-	0xd6, 0x6f, 0xa1, 0xa0, 0xcc, 0x73, 0xe5, 0x80, 0x17, 0x26, 0x97, 0x77, 0xeb, 0xad, 0xd1, 0xbe,
-	0x22, 0x63, 0xce, 0xe5, 0x0d, 0xe0, 0xa3, 0xdf, 0xc2, 0x4e, 0x53, 0xd6, 0xd8, 0x20, 0x07, 0x9f,
-	0x53, 0xcc, 0x7a, 0x22, 0xa5, 0x4c, 0x83, 0xd2, 0xb0, 0x66, 0x6f, 0xc0, 0xb2, 0x13, 0x87, 0xcf,
-	0x31, 0x0a, 0x78, 0x4f, 0x0e, 0x8e, 0xe4, 0x42, 0x13, 0x56, 0x26, 0x09, 0xfa, 0xca, 0x17, 0xd0,
-	0x7c, 0xd1, 0x0d, 0x09, 0xc5, 0x8a, 0x78, 0x48, 0x29, 0xa1, 0xa9, 0x96, 0xc2, 0x39, 0xa6, 0xe1,
-	0xb8, 0x51, 0xc8, 0xa3, 0xfd, 0x10, 0x56, 0x67, 0xdc, 0xd2, 0x22, 0xbf, 0x16, 0x46, 0x8b, 0x7e,
-	0x92, 0xce, 0xe4, 0x47, 0x50, 0xba, 0x44, 0x3e, 0x77, 0x23, 0xc2, 0xc6, 0xc9, 0xb4, 0xe8, 0x14,
-	0x05, 0x78, 0xa2, 0x31, 0xe5, 0x99, 0x79, 0x57, 0xcb, 0xdc, 0x85, 0x95, 0x13, 0x8a, 0xcf, 0x03,
-	0xbf, 0xdb, 0x9b, 0x28, 0x10, 0xb1, 0x93, 0xc9, 0xc0, 0x25, 0x15, 0x92, 0x1c, 0xed, 0x2e, 0x34,
-	0xa6, 0xee, 0xe8, 0xbc, 0x3a, 0x86, 0xb2, 0xe2, 0x72, 0xa9, 0xdc, 0x2b, 0x92, 0x7e, 0xfe, 0xf1,
-	0xb5, 0x99, 0x6d, 0x6e, 0x21, 0x4e, 0xa9, 0x63, 0x9c, 0x98, 0xfd, 0x9f, 0x0c, 0x58, 0x7b, 0x51,
-	0x14, 0x0c, 0xd3, 0x96, 0x55, 0x20, 0xc7, 0xde, 0x06, 0x49, 0x8b, 0x61, 0x6f, 0x03, 0xd1, 0x62,
-	0xce, 0x09, 0xed, 0x60, 0x5d, 0xac, 0xea, 0x20, 0xd6, 0x00, 0x14, 0x04, 0xe4, 0xd2, 0x35, 0x76,
-	0x58, 0xd9, 0x19, 0xf2, 0x4e, 0x45, 0x12, 0x9c, 0x31, 0x3e, 0xbd, 0x00, 0xcd, 0x7d, 0xa8, 0x05,
-	0x68, 0xfe, 0x9e, 0x0b, 0xd0, 0xdf, 0x33, 0x50, 0x4b, 0x79, 0xaf, 0x63, 0xfc, 0xf3, 0x5b, 0xd5,
-	0x6a, 0x50, 0x3d, 0x26, 0x9d, 0x0b, 0xd5, 0xf5, 0x92, 0xd2, 0xa8, 0x83, 0x65, 0x82, 0xe3, 0xc2,
-	0x3b, 0x0b, 0x83, 0x29, 0xe6, 0x15, 0xa8, 0xa7, 0x61, 0xcd, 0xfe, 0x8f, 0x0c, 0x34, 0xf5, 0x88,
-	0x38, 0xc2, 0xbc, 0xd3, 0xdb, 0x63, 0x07, 0xed, 0x51, 0x1e, 0xd4, 0x61, 0x5e, 0xae, 0xe2, 0x32,
-	0x00, 0x45, 0x47, 0x1d, 0xac, 0x06, 0x3c, 0xf0, 0xda, 0xae, 0x1c, 0x8d, 0x7a, 0x3a, 0x78, 0xed,
-	0x1f, 0xc4, 0x70, 0x5c, 0x85, 0x7c, 0x1f, 0x5d, 0xb9, 0x94, 0x5c, 0x32, 0xbd, 0x0c, 0x3e, 0xe8,
-	0xa3, 0x2b, 0x87, 0x5c, 0x32, 0xb9, 0xa8, 0xfb, 0x4c, 0x6e, 0xe0, 0x6d, 0x3f, 0x0c, 0x48, 0x97,
-	0xc9, 0xe7, 0xcf, 0x3b, 0x65, 0x0d, 0x3f, 0x55, 0xa8, 0xa8, 0x35, 0x2a, 0xcb, 0xc8, 0x7c, 0xdc,
-	0xbc, 0x53, 0xa4, 0x46, 0x6d, 0xd9, 0xcf, 0x60, 0x75, 0x86, 0xcd, 0xfa, 0xf5, 0x9e, 0xc0, 0x82,
-	0x2a, 0x0d, 0xfd, 0x6c, 0x96, 0xfe, 0x9c, 0xf8, 0x51, 0xfc, 0xd5, 0x65, 0xa0, 0x39, 0xec, 0x3f,
-	0x67, 0xe0, 0xa3, 0xb4, 0xa4, 0xbd, 0x20, 0x10, 0x0b, 0x18, 0xfb, 0xf0, 0x21, 0x98, 0xf2, 0x6c,
-	0x6e, 0x86, 0x67, 0xc7, 0xb0, 0x7e, 0x9d, 0x3d, 0xf7, 0x70, 0xef, 0xbb, 0xc9, 0xb7, 0xdd, 0x8b,
-	0xa2, 0x9b, 0x1d, 0x33, 0xed, 0xcf, 0xa6, 0xec, 0x9f, 0x0e, 0xba, 0x14, 0x76, 0x0f, 0xab, 0xc4,
-	0x60, 0x0b, 0xd0, 0x00, 0xab, 0x5d, 0x23, 0x49, 0xd0, 0x23, 0xa8, 0xa5, 0x50, 0x2d, 0x78, 0x47,
-	0x6c, 0x1c, 0xa3, 0x2d, 0xa5, 0xb0, 0xdb, 0x68, 0x4d, 0x7e, 0x2f, 0xeb, 0x0b, 0x9a, 0x4d, 0x4c,
-	0x92, 0xef, 0x11, 0xe3, 0x98, 0x26, 0x9d, 0x39, 0x51, 0xf0, 0x05, 0xac, 0x4c, 0x12, 0xb4, 0x8e,
-	0x35, 0xc8, 0x4f, 0xb4, 0xf6, 0xd1, 0x59, 0xdc, 0x7a, 0x83, 0x7c, 0x7e, 0x44, 0x26, 0xe5, 0xdd,
-	0x78, 0x6b, 0x15, 0x1a, 0x53, 0xb7, 0x74, 0xc1, 0x59, 0x50, 0x39, 0xe5, 0x24, 0x92, 0xbe, 0x26,
-	0xa6, 0xd5, 0xa0, 0x6a, 0x60, 0x9a, 0xf1, 0x8f, 0xd0, 0x18, 0x81, 0xdf, 0xfb, 0xa1, 0xdf, 0x8f,
-	0xfb, 0xb7, 0x50, 0x6d, 0x6d, 0x81, 0x9c, 0x4b, 0x2e, 0xf7, 0xfb, 0x38, 0x59, 0xe0, 0x72, 0x4e,
-	0x41, 0x60, 0xaf, 0x14, 0x64, 0x7f, 0x09, 0xcd, 0x69, 0xc9, 0xb7, 0x88, 0x85, 0x34, 0x13, 0x51,
-	0x9e, 0xb2, 0x5d, 0xbc, 0xa6, 0x01, 0x6a, 0xe3, 0xff, 0x04, 0x0f, 0xc7, 0xe8, 0x59, 0xc8, 0xfd,
-	0x60, 0x4f, 0xb4, 0xb3, 0x0f, 0xe4, 0xc0, 0x3a, 0xfc, 0x62, 0xb6, 0x74, 0xad, 0xfd, 0x00, 0xb6,
-	0xd4, 0xb2, 0x72, 0x78, 0x25, 0x86, 0x3e, 0x0a, 0xc4, 0xa6, 0x14, 0x21, 0x8a, 0x43, 0x8e, 0xbd,
-	0xc4, 0x06, 0xb9, 0x04, 0x2b, 0xb2, 0xeb, 0x27, 0x1f, 0x14, 0x90, 0x40, 0x2f, 0x3c, 0xfb, 0x31,
-	0xd8, 0x37, 0x49, 0xd1, 0xba, 0x36, 0x61, 0x7d, 0x92, 0xeb, 0x30, 0xc0, 0x9d, 0xb1, 0x22, 0x7b,
-	0x0b, 0x36, 0xae, 0xe5, 0x18, 0x27, 0x85, 0xd8, 0x63, 0x85, 0x3b, 0xa3, 0x82, 0xf8, 0x95, 0xda,
-	0x6d, 0x35, 0xa6, 0x9f, 0xa7, 0x0e, 0xf3, 0xc8, 0xf3, 0x68, 0xb2, 0x31, 0xa8, 0x83, 0x48, 0x37,
-	0x07, 0x33, 0xb1, 0xe8, 0x8d, 0x4a, 0x23, 0x91, 0xb2, 0x06, 0xcd, 0x69, 0x92, 0xd6, 0xba, 0x03,
-	0x8d, 0xd7, 0x06, 0x2e, 0xaa, 0x7b, 0x66, 0x77, 0x58, 0xd4, 0xdd, 0xc1, 0x3e, 0x82, 0xe6, 0xf4,
-	0x85, 0x7b, 0xf5, 0xa5, 0x8f, 0x4c, 0x39, 0xe3, 0x52, 0x49, 0xd4, 0x97, 0x21, 0xab, 0x9f, 0x24,
-	0xe7, 0x64, 0x7d, 0x2f, 0x95, 0x2f, 0xd9, 0x89, 0xac, 0xdc, 0x84, 0xf5, 0xeb, 0x84, 0x69, 0x3f,
-	0x6b, 0x50, 0x7d, 0x11, 0xfa, 0x5c, 0x55, 0x7f, 0x12, 0x98, 0x4f, 0xc1, 0x32, 0xc1, 0x5b, 0xa4,
-	0xff, 0xbb, 0x0c, 0xac, 0x9f, 0x90, 0x28, 0x0e, 0xe4, 0xe2, 0xaa, 0x12, 0xe1, 0x5b, 0x12, 0x8b,
-	0x17, 0x4d, 0xec, 0xfe, 0x25, 0x2c, 0x89, 0xb4, 0x75, 0x3b, 0x14, 0x23, 0x8e, 0x3d, 0x37, 0x4c,
-	0x3e, 0xae, 0x4a, 0x02, 0xde, 0x57, 0xe8, 0x0f, 0x4c, 0xe4, 0x1e, 0xea, 0x08, 0xa1, 0xe6, 0x0c,
-	0x01, 0x05, 0xc9, 0x39, 0xf2, 0x15, 0x14, 0xfb, 0xd2, 0x32, 0x17, 0x05, 0x3e, 0x52, 0xb3, 0xa4,
-	0xb0, 0xbb, 0x3c, 0xb9, 0x8c, 0xef, 0x09, 0xa2, 0x53, 0x50, 0xac, 0xf2, 0x60, 0x7d, 0x06, 0x75,
-	0xa3, 0x43, 0x8e, 0x77, 0xd6, 0x39, 0xa9, 0xa3, 0x66, 0xd0, 0x46, 0xab, 0xeb, 0x16, 0x6c, 0x5c,
-	0xeb, 0x97, 0x0e, 0xe1, 0x5f, 0x32, 0x50, 0x11, 0xe1, 0x32, 0x4b, 0xdf, 0xfa, 0x0d, 0x2c, 0x28,
-	0x6e, 0xfd, 0xe4, 0xd7, 0x98, 0xa7, 0x99, 0xae, 0xb5, 0x2c, 0x7b, 0xad, 0x65, 0xb3, 0xe2, 0x99,
-	0x9b, 0x11, 0xcf, 0xe4, 0x85, 0xd3, 0x3d, 0x68, 0x19, 0x6a, 0x07, 0xb8, 0x4f, 0x38, 0x4e, 0x3f,
-	0xfc, 0x2e, 0xd4, 0xd3, 0xf0, 0x2d, 0x9e, 0x7e, 0x15, 0x1a, 0x67, 0xa1, 0x47, 0x66, 0x89, 0x5b,
-	0x83, 0xe6, 0x34, 0x49, 0x5b, 0xf0, 0x0d, 0x6c, 0x9c, 0x50, 0x22, 0x08, 0xd2, 0xb2, 0x37, 0x3d,
-	0x1c, 0xee, 0xa3, 0xb8, 0xdb, 0xe3, 0x67, 0xd1, 0x6d, 0xa6, 0xc8, 0xef, 0x61, 0xf3, 0xfa, 0xeb,
-	0xb7, 0xb3, 0x5a, 0x5d, 0x44, 0x4c, 0xcb, 0xf1, 0x0c, 0xab, 0xa7, 0x49, 0xda, 0xea, 0x7f, 0x66,
-	0xa0, 0x72, 0x8a, 0xd3, 0xe5, 0x72, 0xd7, 0xb7, 0x9e, 0xf1, 0x70, 0xd9, 0x59, 0x85, 0x30, 0xf5,
-	0x69, 0x35, 0x37, 0xfd, 0x69, 0x65, 0x3d, 0x81, 0xaa, 0xfc, 0xde, 0x70, 0x99, 0x68, 0xfa, 0x2e,
-	0x13, 0x86, 0xeb, 0xcf, 0x8c, 0x25, 0x49, 0x18, 0x0f, 0x03, 0x39, 0xa3, 0xf0, 0x44, 0x55, 0xdb,
-	0x2f, 0xc6, 0xde, 0x3a, 0x58, 0x0a, 0x19, 0x8f, 0x81, 0xbb, 0x39, 0x26, 0xbe, 0x1f, 0x67, 0x88,
-	0xd2, 0x7a, 0x1e, 0x83, 0x2d, 0x06, 0xab, 0xd1, 0x8d, 0xf6, 0x42, 0x4f, 0x34, 0xf1, 0xd4, 0xa6,
-	0xf3, 0x1a, 0x1e, 0xdd, 0xc8, 0x75, 0xdf, 0xcd, 0x67, 0x19, 0x6a, 0x66, 0xba, 0x18, 0xf9, 0x9e,
-	0x86, 0x6f, 0x91, 0x39, 0xa7, 0x50, 0x7a, 0x8a, 0x3a, 0x17, 0xf1, 0x28, 0x4d, 0x37, 0xa1, 0xd0,
-	0x21, 0x61, 0x27, 0xa6, 0x14, 0x87, 0x9d, 0xa1, 0x6e, 0x6a, 0x26, 0x24, 0x38, 0xe4, 0x27, 0x9f,
-	0x0a, 0xbd, 0xfe, 0x4e, 0x34, 0x21, 0xfb, 0x4b, 0x28, 0x27, 0x42, 0xb5, 0x09, 0x8f, 0x61, 0x1e,
-	0x0f, 0xc6, 0xa1, 0x2f, 0xb7, 0x92, 0x7f, 0x7a, 0x1c, 0x0a, 0xd4, 0x51, 0x44, 0x3d, 0xc2, 0x38,
-	0xa1, 0xf8, 0x88, 0x92, 0x7e, 0xca, 0x2e, 0x7b, 0x0f, 0x56, 0x67, 0xd0, 0xee, 0x22, 0xfe, 0xe9,
-	0xa7, 0x3f, 0xb5, 0x06, 0x3e, 0xc7, 0x8c, 0xb5, 0x7c, 0xb2, 0xa3, 0x7e, 0xed, 0x74, 0xc9, 0xce,
-	0x80, 0xef, 0xc8, 0x7f, 0xbd, 0xec, 0x4c, 0x7d, 0xab, 0xb5, 0x17, 0x24, 0xe1, 0xf3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x80, 0x1f, 0x06, 0x04, 0x1a, 0x00, 0x00,
+	// 2448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x49, 0x73, 0xe3, 0xc6,
+	0xf5, 0x2f, 0x52, 0xcb, 0x48, 0x8f, 0x8b, 0x48, 0x68, 0xa3, 0x34, 0x7f, 0x6b, 0xc1, 0x8c, 0xff,
+	0x1e, 0x3b, 0x15, 0xca, 0x96, 0x27, 0x2e, 0xc7, 0x4b, 0x2a, 0xb2, 0x96, 0xf1, 0xd8, 0x33, 0xb6,
+	0x0c, 0xcd, 0x8c, 0x1d, 0x57, 0xaa, 0x50, 0x4d, 0xa2, 0x45, 0x22, 0x02, 0xd1, 0x50, 0x77, 0x83,
+	0x12, 0xbf, 0x44, 0x3e, 0x41, 0x6e, 0xa9, 0x4a, 0xee, 0x39, 0xe6, 0x3b, 0xe4, 0xea, 0x1c, 0x72,
+	0xc8, 0xc7, 0xc8, 0x21, 0x87, 0xa4, 0xba, 0xfb, 0x81, 0x04, 0x48, 0x48, 0x96, 0x94, 0xa9, 0x54,
+	0x2e, 0x2a, 0xf6, 0xaf, 0xdf, 0xde, 0xaf, 0xdf, 0x7b, 0x68, 0xc1, 0xaa, 0x24, 0xad, 0x80, 0xca,
+	0x1e, 0x09, 0x49, 0x87, 0x72, 0x8f, 0x48, 0xd2, 0x8c, 0x38, 0x93, 0xcc, 0xaa, 0x4f, 0x6c, 0xac,
+	0x97, 0xce, 0x63, 0xca, 0x07, 0x66, 0x7f, 0xbd, 0x2a, 0x59, 0xc4, 0x46, 0xf4, 0xeb, 0xcb, 0x9c,
+	0x46, 0x81, 0xdf, 0x26, 0xd2, 0x67, 0x61, 0x0a, 0xae, 0x04, 0xac, 0x13, 0x4b, 0x3f, 0xc0, 0x65,
+	0xb9, 0x2f, 0xa5, 0xdf, 0xa3, 0x66, 0x65, 0xff, 0xab, 0x00, 0x0b, 0x2f, 0x94, 0x9a, 0x03, 0x7a,
+	0xea, 0x87, 0xbe, 0x62, 0xb5, 0x2c, 0x98, 0x0e, 0x49, 0x8f, 0x36, 0x0a, 0x5b, 0x85, 0x47, 0xf3,
+	0x8e, 0xfe, 0x6d, 0xad, 0xc0, 0xac, 0x68, 0x77, 0x69, 0x8f, 0x34, 0x8a, 0x1a, 0xc5, 0x95, 0xd5,
+	0x80, 0x7b, 0x6d, 0x16, 0xc4, 0xbd, 0x50, 0x34, 0xa6, 0xb6, 0xa6, 0x1e, 0xcd, 0x3b, 0xc9, 0xd2,
+	0x6a, 0xc2, 0x62, 0xc4, 0xfd, 0x1e, 0xe1, 0x03, 0xf7, 0x8c, 0x0e, 0xdc, 0x84, 0x6a, 0x5a, 0x53,
+	0xd5, 0x71, 0xeb, 0x4b, 0x3a, 0xd8, 0x47, 0x7a, 0x0b, 0xa6, 0xe5, 0x20, 0xa2, 0x8d, 0x19, 0xa3,
+	0x55, 0xfd, 0xb6, 0x36, 0xa1, 0xa4, 0x1c, 0x71, 0x03, 0x1a, 0x76, 0x64, 0xb7, 0x31, 0xbb, 0x55,
+	0x78, 0x34, 0xed, 0x80, 0x82, 0x9e, 0x69, 0xc4, 0xba, 0x0f, 0xf3, 0x9c, 0x5d, 0xb8, 0x6d, 0x16,
+	0x87, 0xb2, 0x71, 0x4f, 0x6f, 0xcf, 0x71, 0x76, 0xb1, 0xaf, 0xd6, 0xd6, 0x43, 0x98, 0x3d, 0xf5,
+	0x69, 0xe0, 0x89, 0xc6, 0xdc, 0xd6, 0xd4, 0xa3, 0xd2, 0x6e, 0xb9, 0x69, 0xa2, 0x77, 0xa4, 0x40,
+	0x07, 0xf7, 0xec, 0x3f, 0x14, 0xa0, 0x76, 0xa2, 0x9d, 0x49, 0x85, 0xe0, 0x2d, 0x58, 0x50, 0x5a,
+	0x5a, 0x44, 0x50, 0x17, 0xfd, 0x36, 0xd1, 0xa8, 0x26, 0xb0, 0x61, 0xb1, 0xbe, 0x06, 0x73, 0x4a,
+	0xae, 0x37, 0x64, 0x16, 0x8d, 0xa2, 0x56, 0x67, 0x37, 0x27, 0x0f, 0x76, 0x2c, 0xd4, 0x4e, 0x4d,
+	0x66, 0x01, 0xa1, 0x02, 0xda, 0xa7, 0x5c, 0xf8, 0x2c, 0x6c, 0x4c, 0x69, 0x8d, 0xc9, 0x52, 0x19,
+	0x6a, 0x19, 0xad, 0xfb, 0x5d, 0x12, 0x76, 0xa8, 0x43, 0x45, 0x1c, 0x48, 0xeb, 0x73, 0xa8, 0xb4,
+	0xe8, 0x29, 0xe3, 0x19, 0x43, 0x4b, 0xbb, 0x0f, 0x72, 0xb4, 0x8f, 0xbb, 0xe9, 0x94, 0x0d, 0x27,
+	0xfa, 0x72, 0x04, 0x65, 0x72, 0x2a, 0x29, 0x77, 0x53, 0x27, 0x7d, 0x43, 0x41, 0x25, 0xcd, 0x68,
+	0x60, 0xfb, 0x1f, 0x05, 0xa8, 0xbe, 0x14, 0x94, 0x1f, 0x53, 0xde, 0xf3, 0x85, 0xc0, 0x94, 0xea,
+	0x32, 0x21, 0x93, 0x94, 0x52, 0xbf, 0x15, 0x16, 0x0b, 0xca, 0x31, 0xa1, 0xf4, 0x6f, 0xeb, 0x27,
+	0x50, 0x8f, 0x88, 0x10, 0x17, 0x8c, 0x7b, 0x6e, 0xbb, 0x4b, 0xdb, 0x67, 0x22, 0xee, 0xe9, 0x38,
+	0x4c, 0x3b, 0xb5, 0x64, 0x63, 0x1f, 0x71, 0xeb, 0x1b, 0x80, 0x88, 0xfb, 0x7d, 0x3f, 0xa0, 0x1d,
+	0x6a, 0x12, 0xab, 0xb4, 0xfb, 0x5e, 0x8e, 0xb5, 0x59, 0x5b, 0x9a, 0xc7, 0x43, 0x9e, 0xc3, 0x50,
+	0xf2, 0x81, 0x93, 0x12, 0xb2, 0xfe, 0x29, 0x2c, 0x8c, 0x6d, 0x5b, 0x35, 0x98, 0x3a, 0xa3, 0x03,
+	0xb4, 0x5c, 0xfd, 0xb4, 0x96, 0x60, 0xa6, 0x4f, 0x82, 0x98, 0xa2, 0xe5, 0x66, 0xf1, 0x51, 0xf1,
+	0xc3, 0x82, 0xfd, 0x43, 0x01, 0xca, 0x07, 0xad, 0x1f, 0xf1, 0xbb, 0x0a, 0x45, 0xaf, 0x85, 0xbc,
+	0x45, 0xaf, 0x35, 0x8c, 0xc3, 0x54, 0x2a, 0x0e, 0x5f, 0xe7, 0xb8, 0xb6, 0x93, 0xe3, 0xda, 0x41,
+	0xeb, 0xbf, 0xe3, 0xd8, 0xef, 0x0b, 0x50, 0x1a, 0x69, 0x12, 0xd6, 0x33, 0xa8, 0x29, 0x3b, 0xdd,
+	0x68, 0x84, 0x35, 0x0a, 0xda, 0xca, 0xed, 0x1f, 0x3d, 0x00, 0x67, 0x21, 0xce, 0xac, 0x85, 0x75,
+	0x04, 0x55, 0xaf, 0x95, 0x91, 0x65, 0x6e, 0xd0, 0xe6, 0x8f, 0x78, 0xec, 0x54, 0xbc, 0xd4, 0x4a,
+	0xd8, 0x6f, 0x41, 0xe9, 0xd8, 0x0f, 0x3b, 0x0e, 0x3d, 0x8f, 0xa9, 0x90, 0xea, 0x2a, 0x45, 0x64,
+	0x10, 0x30, 0xe2, 0xa1, 0x93, 0xc9, 0xd2, 0x7e, 0x04, 0x65, 0x43, 0x28, 0x22, 0x16, 0x0a, 0x7a,
+	0x0d, 0xe5, 0x3b, 0x50, 0x3e, 0x09, 0x28, 0x8d, 0x12, 0x99, 0xeb, 0x30, 0xe7, 0xc5, 0x5c, 0x97,
+	0x58, 0x4d, 0x3a, 0xe5, 0x0c, 0xd7, 0xf6, 0x02, 0x54, 0x90, 0xd6, 0x88, 0xb5, 0xff, 0x5a, 0x00,
+	0xeb, 0xf0, 0x92, 0xb6, 0x63, 0x49, 0x3f, 0x67, 0xec, 0x2c, 0x91, 0x91, 0x57, 0x5f, 0x37, 0x00,
+	0x22, 0xc2, 0x49, 0x8f, 0x4a, 0xca, 0x8d, 0xfb, 0xf3, 0x4e, 0x0a, 0xb1, 0x8e, 0x61, 0x9e, 0x5e,
+	0x4a, 0x4e, 0x5c, 0x1a, 0xf6, 0x75, 0xa5, 0x2d, 0xed, 0xbe, 0x9f, 0x13, 0x9d, 0x49, 0x6d, 0xcd,
+	0x43, 0xc5, 0x76, 0x18, 0xf6, 0x4d, 0x4e, 0xcc, 0x51, 0x5c, 0xae, 0x7f, 0x0c, 0x95, 0xcc, 0xd6,
+	0xad, 0xf2, 0xe1, 0x14, 0x16, 0x33, 0xaa, 0x30, 0x8e, 0x9b, 0x50, 0xa2, 0x97, 0xbe, 0x74, 0x85,
+	0x24, 0x32, 0x16, 0x18, 0x20, 0x50, 0xd0, 0x89, 0x46, 0x74, 0x1b, 0x91, 0x1e, 0x8b, 0xe5, 0xb0,
+	0x8d, 0xe8, 0x15, 0xe2, 0x94, 0x27, 0xb7, 0x00, 0x57, 0x76, 0x1f, 0x6a, 0x4f, 0xa8, 0x34, 0x75,
+	0x25, 0x09, 0xdf, 0x0a, 0xcc, 0x6a, 0xc7, 0x4d, 0xc6, 0xcd, 0x3b, 0xb8, 0xb2, 0x1e, 0x40, 0xc5,
+	0x0f, 0xdb, 0x41, 0xec, 0x51, 0xb7, 0xef, 0xd3, 0x0b, 0xa1, 0x55, 0xcc, 0x39, 0x65, 0x04, 0x5f,
+	0x29, 0xcc, 0x7a, 0x13, 0xaa, 0xf4, 0xd2, 0x10, 0xa1, 0x10, 0xd3, 0xb6, 0x2a, 0x88, 0xea, 0x02,
+	0x2d, 0x6c, 0x0a, 0xf5, 0x94, 0x5e, 0xf4, 0xee, 0x18, 0xea, 0xa6, 0x32, 0xa6, 0x8a, 0xfd, 0x6d,
+	0xaa, 0x6d, 0x4d, 0x8c, 0x21, 0xf6, 0x2a, 0x2c, 0x3f, 0xa1, 0x32, 0x95, 0xc2, 0xe8, 0xa3, 0xfd,
+	0x3d, 0xac, 0x8c, 0x6f, 0xa0, 0x11, 0xbf, 0x84, 0x52, 0xf6, 0xd2, 0x29, 0xf5, 0x1b, 0x39, 0xea,
+	0xd3, 0xcc, 0x69, 0x16, 0x7b, 0x09, 0xac, 0x13, 0x2a, 0x1d, 0x4a, 0xbc, 0xaf, 0xc3, 0x60, 0x90,
+	0x68, 0x5c, 0x86, 0xc5, 0x0c, 0x8a, 0x29, 0x3c, 0x82, 0xbf, 0xe5, 0xbe, 0xa4, 0x09, 0xf5, 0x0a,
+	0x2c, 0x65, 0x61, 0x24, 0xff, 0x02, 0xea, 0xa6, 0x39, 0xbd, 0x18, 0x44, 0x09, 0xb1, 0xf5, 0x33,
+	0x28, 0x19, 0xf3, 0x5c, 0xdd, 0xe0, 0x95, 0xc9, 0xd5, 0xdd, 0xa5, 0xe6, 0x70, 0x7a, 0xd1, 0x31,
+	0x97, 0x9a, 0x03, 0xe4, 0xf0, 0xb7, 0xb2, 0x33, 0x2d, 0x6b, 0x64, 0x90, 0x43, 0x4f, 0x39, 0x15,
+	0x5d, 0x95, 0x52, 0x69, 0x83, 0xb2, 0x30, 0x92, 0xaf, 0xc2, 0xb2, 0x13, 0x87, 0x9f, 0x53, 0x12,
+	0xc8, 0xae, 0x6e, 0x1c, 0x09, 0x43, 0x03, 0x56, 0xc6, 0x37, 0x90, 0xe5, 0x31, 0x34, 0x9e, 0x76,
+	0x42, 0xc6, 0xa9, 0xd9, 0x3c, 0xe4, 0x9c, 0xf1, 0x4c, 0x49, 0x91, 0x92, 0xf2, 0x70, 0x54, 0x28,
+	0xf4, 0xd2, 0xbe, 0x0f, 0x6b, 0x39, 0x5c, 0x28, 0xf2, 0x23, 0x65, 0xb4, 0xaa, 0x27, 0xd9, 0x4c,
+	0x7e, 0x00, 0x95, 0x0b, 0xe2, 0x4b, 0x37, 0x62, 0x62, 0x94, 0x4c, 0xf3, 0x4e, 0x59, 0x81, 0xc7,
+	0x88, 0x19, 0xcf, 0xd2, 0xbc, 0x28, 0x73, 0x17, 0x56, 0x8e, 0x39, 0x3d, 0x0d, 0xfc, 0x4e, 0x77,
+	0xec, 0x82, 0xa8, 0x99, 0x4c, 0x07, 0x2e, 0xb9, 0x21, 0xc9, 0xd2, 0xee, 0xc0, 0xea, 0x04, 0x0f,
+	0xe6, 0xd5, 0x33, 0xa8, 0x1a, 0x2a, 0x97, 0xeb, 0xb9, 0x22, 0xa9, 0xe7, 0x6f, 0x5e, 0x99, 0xd9,
+	0xe9, 0x29, 0xc4, 0xa9, 0xb4, 0x53, 0x2b, 0x61, 0xff, 0xb3, 0x00, 0xd6, 0x5e, 0x14, 0x05, 0x83,
+	0xac, 0x65, 0x35, 0x98, 0x12, 0xe7, 0x41, 0x52, 0x62, 0xc4, 0x79, 0xa0, 0x4a, 0xcc, 0x29, 0xe3,
+	0x6d, 0x8a, 0x97, 0xd5, 0x2c, 0xd4, 0x18, 0x40, 0x82, 0x80, 0x5d, 0xb8, 0xa9, 0x89, 0x56, 0x57,
+	0x86, 0x39, 0xa7, 0xa6, 0x37, 0x9c, 0x11, 0x3e, 0x39, 0x00, 0x4d, 0xbf, 0xae, 0x01, 0x68, 0xe6,
+	0x8e, 0x03, 0xd0, 0x1f, 0x0b, 0xb0, 0x98, 0xf1, 0x1e, 0x63, 0xfc, 0xbf, 0x37, 0xaa, 0x2d, 0x42,
+	0xfd, 0x19, 0x6b, 0x9f, 0x99, 0xaa, 0x97, 0x5c, 0x8d, 0x25, 0xb0, 0xd2, 0xe0, 0xe8, 0xe2, 0xbd,
+	0x0c, 0x83, 0x09, 0xe2, 0x15, 0x58, 0xca, 0xc2, 0x48, 0xfe, 0xa7, 0x02, 0x34, 0xb0, 0x45, 0x1c,
+	0x51, 0xd9, 0xee, 0xee, 0x89, 0x83, 0xd6, 0x30, 0x0f, 0x96, 0x60, 0x46, 0x8f, 0xe2, 0x3a, 0x00,
+	0x65, 0xc7, 0x2c, 0xac, 0x55, 0xb8, 0xe7, 0xb5, 0x5c, 0xdd, 0x1a, 0xb1, 0x3b, 0x78, 0xad, 0xaf,
+	0x54, 0x73, 0x5c, 0x83, 0xb9, 0x1e, 0xb9, 0x74, 0x39, 0xbb, 0x10, 0x38, 0x0c, 0xde, 0xeb, 0x91,
+	0x4b, 0x87, 0x5d, 0x08, 0x3d, 0xa8, 0xfb, 0x42, 0x4f, 0xe0, 0x2d, 0x3f, 0x0c, 0x58, 0x47, 0xe8,
+	0xe3, 0x9f, 0x73, 0xaa, 0x08, 0x7f, 0x66, 0x50, 0x75, 0xd7, 0xb8, 0xbe, 0x46, 0xe9, 0xc3, 0x9d,
+	0x73, 0xca, 0x3c, 0x75, 0xb7, 0xec, 0x27, 0xb0, 0x96, 0x63, 0x33, 0x9e, 0xde, 0x3b, 0x30, 0x6b,
+	0xae, 0x06, 0x1e, 0x9b, 0x85, 0x9f, 0x13, 0xdf, 0xa8, 0xbf, 0x78, 0x0d, 0x90, 0xc2, 0xfe, 0x6d,
+	0x01, 0xde, 0xc8, 0x4a, 0xda, 0x0b, 0x02, 0x35, 0x80, 0x89, 0xd7, 0x1f, 0x82, 0x09, 0xcf, 0xa6,
+	0x73, 0x3c, 0x7b, 0x06, 0x1b, 0x57, 0xd9, 0x73, 0x07, 0xf7, 0xbe, 0x1c, 0x3f, 0xdb, 0xbd, 0x28,
+	0xba, 0xde, 0xb1, 0xb4, 0xfd, 0xc5, 0x8c, 0xfd, 0x93, 0x41, 0xd7, 0xc2, 0xee, 0x60, 0x95, 0x6a,
+	0x6c, 0x01, 0xe9, 0x53, 0x33, 0x6b, 0x24, 0x09, 0x7a, 0x04, 0x8b, 0x19, 0x14, 0x05, 0xef, 0xa8,
+	0x89, 0x63, 0x38, 0xa5, 0x94, 0x76, 0x57, 0x9b, 0xe3, 0x5f, 0xcf, 0xc8, 0x80, 0x64, 0xaa, 0x93,
+	0x3c, 0x27, 0x42, 0x52, 0x9e, 0x54, 0xe6, 0x44, 0xc1, 0x63, 0x58, 0x19, 0xdf, 0x40, 0x1d, 0xeb,
+	0x30, 0x37, 0x56, 0xda, 0x87, 0x6b, 0xc5, 0xf5, 0x2d, 0xf1, 0xe5, 0x11, 0x1b, 0x97, 0x77, 0x2d,
+	0xd7, 0x1a, 0xac, 0x4e, 0x70, 0xe1, 0x85, 0xb3, 0xa0, 0x76, 0x22, 0x59, 0xa4, 0x7d, 0x4d, 0x4c,
+	0x5b, 0x84, 0x7a, 0x0a, 0x43, 0xc2, 0xef, 0x60, 0x75, 0x08, 0x3e, 0xf7, 0x43, 0xbf, 0x17, 0xf7,
+	0x6e, 0xa0, 0xda, 0xda, 0x06, 0xdd, 0x97, 0x5c, 0xe9, 0xf7, 0x68, 0x32, 0xc0, 0x4d, 0x39, 0x25,
+	0x85, 0xbd, 0x30, 0x90, 0xfd, 0x01, 0x34, 0x26, 0x25, 0xdf, 0x20, 0x16, 0xda, 0x4c, 0xc2, 0x65,
+	0xc6, 0x76, 0x75, 0x9a, 0x29, 0x10, 0x8d, 0xff, 0x35, 0xdc, 0x1f, 0xa1, 0x2f, 0x43, 0xe9, 0x07,
+	0x7b, 0xaa, 0x9c, 0xbd, 0x26, 0x07, 0x36, 0xe0, 0xff, 0xf2, 0xa5, 0xa3, 0xf6, 0x03, 0xd8, 0x36,
+	0xc3, 0xca, 0xe1, 0xa5, 0x6a, 0xfa, 0x24, 0x50, 0x93, 0x52, 0x44, 0x38, 0x0d, 0x25, 0xf5, 0x12,
+	0x1b, 0xf4, 0x10, 0x6c, 0xb6, 0x5d, 0x3f, 0xf9, 0xa0, 0x80, 0x04, 0x7a, 0xea, 0xd9, 0x0f, 0xc1,
+	0xbe, 0x4e, 0x0a, 0xea, 0xda, 0x82, 0x8d, 0x71, 0xaa, 0xc3, 0x80, 0xb6, 0x47, 0x8a, 0xec, 0x6d,
+	0xd8, 0xbc, 0x92, 0x62, 0x94, 0x14, 0x4f, 0xa8, 0x71, 0x67, 0x78, 0x21, 0xde, 0x86, 0x7a, 0x0a,
+	0xc3, 0xe3, 0x59, 0x82, 0x19, 0xe2, 0x79, 0x3c, 0x99, 0x18, 0xcc, 0x42, 0xa5, 0x9b, 0x43, 0x05,
+	0x95, 0xa9, 0x76, 0x9b, 0x48, 0x59, 0x87, 0xc6, 0xe4, 0x16, 0x6a, 0xfd, 0x00, 0x36, 0x9e, 0x86,
+	0xbf, 0xa1, 0x6d, 0x79, 0xd8, 0x8b, 0xe4, 0xe0, 0x05, 0x27, 0xa1, 0x20, 0x6d, 0x99, 0x9a, 0x6f,
+	0x95, 0xba, 0x8e, 0xf4, 0x3d, 0x81, 0xd1, 0x31, 0x0b, 0xe5, 0xd0, 0x95, 0x7c, 0x28, 0x7a, 0x07,
+	0x56, 0x5f, 0xa5, 0x54, 0xaa, 0xc2, 0x91, 0x5b, 0x78, 0xe6, 0xb1, 0xf0, 0xd8, 0x47, 0xd0, 0x98,
+	0x64, 0xb8, 0x53, 0xc9, 0x7b, 0x23, 0x2d, 0x67, 0x74, 0x0b, 0x13, 0xf5, 0x55, 0x28, 0xe2, 0x69,
+	0x4f, 0x39, 0x45, 0xdf, 0xcb, 0xa4, 0x62, 0x71, 0x2c, 0xe1, 0xb7, 0x60, 0xe3, 0x2a, 0x61, 0xe8,
+	0xe7, 0x22, 0xd4, 0x9f, 0x86, 0xbe, 0x34, 0x85, 0x25, 0x89, 0xf9, 0xbb, 0x60, 0xa5, 0xc1, 0x1b,
+	0xdc, 0xac, 0x1f, 0x0a, 0xb0, 0x71, 0xcc, 0xa2, 0x38, 0xd0, 0x33, 0xb1, 0xc9, 0xb1, 0x2f, 0x58,
+	0xac, 0x92, 0x25, 0xb1, 0xfb, 0xff, 0x61, 0x41, 0xdd, 0x08, 0xb7, 0xcd, 0x29, 0x91, 0xd4, 0x73,
+	0xc3, 0xe4, 0xbb, 0xad, 0xa2, 0xe0, 0x7d, 0x83, 0x7e, 0x25, 0x54, 0x5a, 0x9b, 0xc3, 0x48, 0xb7,
+	0x27, 0x30, 0x90, 0x6e, 0x51, 0x1f, 0x42, 0xb9, 0xa7, 0x2d, 0x73, 0x49, 0xe0, 0x13, 0xd3, 0xa6,
+	0x4a, 0xbb, 0xcb, 0xe3, 0x73, 0xfe, 0x9e, 0xda, 0x74, 0x4a, 0x86, 0x54, 0x2f, 0xac, 0xf7, 0x60,
+	0x29, 0x55, 0x7c, 0x47, 0xe3, 0xf0, 0xb4, 0xd6, 0xb1, 0x98, 0xda, 0x1b, 0x4e, 0xc5, 0xdb, 0xb0,
+	0x79, 0xa5, 0x5f, 0x18, 0xc2, 0xdf, 0x15, 0xa0, 0xa6, 0xc2, 0x95, 0xae, 0x2a, 0xd6, 0x4f, 0x61,
+	0xd6, 0x50, 0x37, 0x0a, 0xd7, 0x99, 0x87, 0x44, 0x57, 0x5a, 0x56, 0xbc, 0xd2, 0xb2, 0xbc, 0x78,
+	0x4e, 0xe5, 0xc4, 0x33, 0x39, 0xe1, 0x6c, 0x79, 0x5b, 0x86, 0xc5, 0x03, 0xda, 0x63, 0x92, 0x66,
+	0x0f, 0x7e, 0x17, 0x96, 0xb2, 0xf0, 0x0d, 0x8e, 0x7e, 0x0d, 0x56, 0x5f, 0x86, 0x1e, 0xcb, 0x13,
+	0xb7, 0x0e, 0x8d, 0xc9, 0x2d, 0xb4, 0xe0, 0x53, 0xd8, 0x3c, 0xe6, 0x4c, 0x6d, 0x68, 0xcb, 0xbe,
+	0xed, 0xd2, 0x70, 0x9f, 0xc4, 0x9d, 0xae, 0x7c, 0x19, 0xdd, 0xa4, 0x41, 0xfd, 0x02, 0xb6, 0xae,
+	0x66, 0xbf, 0x99, 0xd5, 0x86, 0x91, 0x08, 0x94, 0xe3, 0xa5, 0xac, 0x9e, 0xdc, 0x42, 0xab, 0xff,
+	0xac, 0x1e, 0x71, 0x69, 0xf6, 0xba, 0xdc, 0xf6, 0xac, 0x73, 0x0e, 0xae, 0x98, 0x77, 0x11, 0x26,
+	0xbe, 0xda, 0xa6, 0x27, 0xbf, 0xda, 0xac, 0x77, 0xa0, 0xae, 0x3f, 0x65, 0xd4, 0x53, 0x08, 0x97,
+	0xae, 0x50, 0x86, 0xe3, 0x17, 0xcc, 0x82, 0xde, 0x18, 0xf5, 0x19, 0xdd, 0xfe, 0xe8, 0xd8, 0xad,
+	0xb6, 0x9f, 0x8e, 0xbc, 0x75, 0xa8, 0x16, 0x42, 0xbd, 0xbb, 0x39, 0xa6, 0x3e, 0x4d, 0x73, 0x44,
+	0xa1, 0x9e, 0x87, 0x60, 0xab, 0x9e, 0x9d, 0xaa, 0x46, 0x7b, 0xa1, 0xa7, 0xfa, 0x43, 0x66, 0x88,
+	0x7a, 0x05, 0x0f, 0xae, 0xa5, 0xba, 0xeb, 0x50, 0xb5, 0x0c, 0x8b, 0xe9, 0x74, 0x49, 0xe5, 0x7b,
+	0x16, 0xbe, 0x41, 0xe6, 0x9c, 0x40, 0xe5, 0x33, 0xd2, 0x3e, 0x8b, 0x87, 0x69, 0xba, 0x05, 0xa5,
+	0x36, 0x0b, 0xdb, 0x31, 0xe7, 0x34, 0x6c, 0x0f, 0xb0, 0xa8, 0xa5, 0x21, 0x45, 0xa1, 0xbf, 0x26,
+	0x4d, 0xe8, 0xf1, 0x13, 0x34, 0x0d, 0xd9, 0x1f, 0x40, 0x35, 0x11, 0x8a, 0x26, 0x3c, 0x84, 0x19,
+	0xda, 0x1f, 0x85, 0xbe, 0xda, 0x4c, 0xfe, 0xbb, 0x72, 0xa8, 0x50, 0xc7, 0x6c, 0xda, 0x7d, 0xdd,
+	0x1d, 0x25, 0xe3, 0xf4, 0x88, 0xb3, 0x5e, 0xd6, 0xae, 0xc7, 0xb0, 0xc0, 0xcd, 0x9e, 0x2b, 0x99,
+	0x9e, 0x46, 0x50, 0x56, 0xb9, 0x89, 0xff, 0x9a, 0x51, 0xe3, 0x88, 0x53, 0x41, 0xa2, 0x17, 0x4c,
+	0x2d, 0xad, 0x87, 0x50, 0x4d, 0x71, 0x45, 0x4c, 0x60, 0x0d, 0x2a, 0x0f, 0xc9, 0x8e, 0x99, 0xb0,
+	0xf7, 0x60, 0x2d, 0x47, 0xef, 0xad, 0x4c, 0x7f, 0x0c, 0xab, 0x27, 0x54, 0x1a, 0xd6, 0xe7, 0xe4,
+	0xd2, 0x19, 0x3d, 0xb2, 0x0c, 0x87, 0x78, 0x22, 0x29, 0x86, 0x53, 0x0f, 0xf1, 0x44, 0x52, 0xd5,
+	0x66, 0x27, 0xb9, 0x86, 0x6d, 0xb6, 0x1e, 0x71, 0xda, 0xf7, 0x59, 0x2c, 0xdc, 0x31, 0xfe, 0x85,
+	0x64, 0x03, 0x79, 0xec, 0x77, 0xa1, 0xf1, 0x84, 0xca, 0xe7, 0x03, 0x71, 0x1e, 0xbc, 0x22, 0xdc,
+	0x4f, 0x7f, 0x6a, 0xaa, 0x06, 0xaf, 0x5a, 0xcf, 0x70, 0x46, 0xd1, 0x0b, 0xfb, 0x2f, 0x45, 0x58,
+	0xcb, 0x61, 0x41, 0xdd, 0xbf, 0x82, 0xf9, 0x7e, 0x02, 0xe2, 0x8b, 0xc6, 0xc7, 0x39, 0x5f, 0xc9,
+	0x57, 0x0a, 0x68, 0x0e, 0x11, 0xf3, 0x7e, 0x3a, 0x92, 0xa6, 0x44, 0x47, 0x94, 0x0b, 0x5f, 0x48,
+	0xea, 0x35, 0x8a, 0x77, 0x10, 0x7d, 0x9c, 0x70, 0xa3, 0xe8, 0xa1, 0xb4, 0xf5, 0x4f, 0xa0, 0x9a,
+	0xd5, 0x7b, 0x9b, 0xc7, 0x59, 0xc5, 0x9d, 0x15, 0x7d, 0x1b, 0x6e, 0xfb, 0x6f, 0x05, 0x58, 0xd7,
+	0x8f, 0x17, 0xf9, 0x87, 0xf0, 0xfd, 0x64, 0x40, 0x3f, 0xc9, 0xf1, 0xfa, 0x6a, 0x09, 0xd7, 0x44,
+	0xf4, 0x01, 0x54, 0x92, 0x67, 0x1f, 0x5d, 0xa6, 0x92, 0x17, 0x5c, 0x0d, 0x62, 0xe9, 0xfa, 0xcf,
+	0x62, 0x63, 0xff, 0xbd, 0x00, 0xf7, 0x73, 0x6d, 0xc3, 0x7c, 0xf9, 0x0e, 0xe6, 0x92, 0x94, 0xbc,
+	0xad, 0x77, 0xc9, 0xa9, 0x22, 0x3b, 0xbe, 0xb7, 0x27, 0xd2, 0xac, 0xb7, 0xa1, 0x86, 0x6e, 0xb9,
+	0x9c, 0x9e, 0xc7, 0x3e, 0xc7, 0xac, 0x99, 0x77, 0x16, 0x10, 0x77, 0x10, 0x56, 0x4f, 0xf3, 0x19,
+	0x29, 0xb7, 0xf2, 0xf0, 0xe7, 0xea, 0xbd, 0x50, 0xcb, 0xd3, 0x06, 0x0e, 0x9b, 0xc6, 0x36, 0x94,
+	0xe3, 0xa8, 0xc3, 0x89, 0x47, 0xdd, 0x2e, 0x63, 0x67, 0x28, 0xac, 0x84, 0x98, 0x7a, 0xc6, 0xd7,
+	0x8f, 0xa5, 0x59, 0x56, 0xe3, 0xd3, 0x67, 0xef, 0x7e, 0xdf, 0xec, 0xfb, 0x92, 0x0a, 0xd1, 0xf4,
+	0xd9, 0x8e, 0xf9, 0xb5, 0xd3, 0x61, 0x3b, 0x7d, 0xb9, 0xa3, 0xff, 0x8b, 0xbc, 0x33, 0x11, 0xa1,
+	0xd6, 0xac, 0xde, 0x78, 0xff, 0xdf, 0x03, 0x00, 0xb6, 0xbe, 0x30, 0x32, 0xdd, 0x1e, 0x00, 0x00,
 }
@@ -29,73 +29,79 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
+	// 1145 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0xb1, 0x04, 0x95, 0x58, 0x1e, 0xbb, 0xaa, 0x28, 0x0a, 0x12, 0x4f, 0x6d, 0x78, 0x48,
-	0x51, 0xdc, 0x34, 0x94, 0xf7, 0x6e, 0x9a, 0xb4, 0x41, 0x8d, 0x30, 0x76, 0x43, 0x10, 0x48, 0x48,
-	0x1b, 0x7b, 0x62, 0x1f, 0x39, 0xef, 0x1e, 0xbb, 0x7b, 0x56, 0xf3, 0x0a, 0x09, 0x89, 0x57, 0x48,
-	0x7c, 0x11, 0xbe, 0x24, 0xba, 0x87, 0xdd, 0x9b, 0x3d, 0xcf, 0xad, 0xed, 0x77, 0x91, 0xff, 0xbf,
-	0x99, 0xd9, 0x87, 0x99, 0xd9, 0xc9, 0xb1, 0x1d, 0x2b, 0x2e, 0x53, 0xb0, 0x0b, 0x21, 0xc5, 0x0c,
-	0xb4, 0x01, 0xbd, 0x4c, 0x26, 0xb0, 0x9f, 0x69, 0x65, 0x15, 0xbf, 0x43, 0x69, 0x3b, 0x77, 0x83,
-	0x5f, 0xa7, 0xc2, 0x8a, 0x0a, 0x7f, 0xf4, 0xdf, 0x2e, 0x7b, 0xe7, 0x65, 0xa9, 0x9d, 0x55, 0x1a,
-	0x3f, 0x65, 0xaf, 0x0f, 0x13, 0x39, 0xe3, 0x1f, 0xef, 0xaf, 0xda, 0x14, 0xc2, 0x08, 0xfe, 0xc8,
-	0xc1, 0xd8, 0x9d, 0x4f, 0x3a, 0x75, 0x93, 0x29, 0x69, 0xe0, 0xf3, 0xd7, 0xf8, 0x0b, 0xf6, 0xc6,
-	0x38, 0x05, 0xc8, 0x38, 0xc5, 0x96, 0x8a, 0x73, 0xf6, 0x69, 0x37, 0xe0, 0xbd, 0xfd, 0xc6, 0xde,
-	0x3a, 0x7e, 0x05, 0x93, 0xdc, 0xc2, 0x73, 0xa5, 0xae, 0xf9, 0x2e, 0x61, 0x82, 0x74, 0xe7, 0xf9,
-	0x8b, 0x75, 0x98, 0xf7, 0xff, 0x33, 0x7b, 0xf3, 0x19, 0xd8, 0xf1, 0x64, 0x0e, 0x0b, 0xc1, 0xef,
-	0x11, 0x66, 0x5e, 0x75, 0xbe, 0xef, 0xc7, 0x21, 0xef, 0x79, 0xc6, 0xde, 0x7d, 0x06, 0x76, 0x08,
-	0x7a, 0x91, 0x18, 0x93, 0x28, 0x69, 0xf8, 0x57, 0xb4, 0x25, 0x42, 0x5c, 0x8c, 0xaf, 0x37, 0x20,
-	0xf1, 0x11, 0x8d, 0xc1, 0x8e, 0x40, 0x4c, 0x7f, 0x90, 0xe9, 0x0d, 0x79, 0x44, 0x48, 0x8f, 0x1d,
-	0x51, 0x80, 0x79, 0xff, 0x82, 0xbd, 0x5d, 0x0b, 0x17, 0x3a, 0xb1, 0xc0, 0x23, 0x96, 0x25, 0xe0,
-	0x22, 0x7c, 0xb9, 0x96, 0xf3, 0x21, 0x7e, 0x65, 0xec, 0x68, 0x2e, 0xe4, 0x0c, 0x5e, 0xde, 0x64,
-	0xc0, 0xa9, 0x13, 0x6e, 0x64, 0xe7, 0x7e, 0x77, 0x0d, 0x85, 0xd7, 0x3f, 0x82, 0x2b, 0x0d, 0x66,
-	0x3e, 0xb6, 0xa2, 0x63, 0xfd, 0x18, 0x88, 0xad, 0x3f, 0xe4, 0xf0, 0x5d, 0x8f, 0x72, 0xf9, 0x1c,
-	0x44, 0x6a, 0xe7, 0x47, 0x73, 0x98, 0x5c, 0x93, 0x77, 0x1d, 0x22, 0xb1, 0xbb, 0x6e, 0x93, 0x3e,
-	0x50, 0xc6, 0x6e, 0x9f, 0xce, 0xa4, 0xd2, 0x50, 0xc9, 0xc7, 0x5a, 0x2b, 0xcd, 0x1f, 0x10, 0x1e,
-	0x56, 0x28, 0x17, 0xee, 0x9b, 0xcd, 0xe0, 0xf0, 0xf4, 0x52, 0x25, 0xa6, 0x75, 0x8d, 0xd0, 0xa7,
-	0xd7, 0x00, 0xf1, 0xd3, 0xc3, 0x9c, 0x0f, 0xf1, 0x3b, 0x7b, 0x6f, 0xa8, 0xe1, 0x2a, 0x4d, 0x66,
-	0x73, 0x57, 0x89, 0xd4, 0xa1, 0xb4, 0x18, 0x17, 0x68, 0x6f, 0x13, 0x14, 0x17, 0xcb, 0x20, 0xcb,
-	0xd2, 0x9b, 0x3a, 0x0e, 0x95, 0x44, 0x48, 0x8f, 0x15, 0x4b, 0x80, 0xe1, 0x4c, 0x7e, 0xa1, 0x26,
-	0xd7, 0x65, 0x77, 0x35, 0x64, 0x26, 0x37, 0x72, 0x2c, 0x93, 0x31, 0x85, 0xef, 0xe2, 0x5c, 0xa6,
-	0x8d, 0x7b, 0x6a, 0x59, 0x18, 0x88, 0xdd, 0x45, 0xc8, 0xe1, 0x04, 0xab, 0x1b, 0xe5, 0x09, 0xd8,
-	0xc9, 0x7c, 0x60, 0x9e, 0x5e, 0x0a, 0x32, 0xc1, 0x56, 0xa8, 0x58, 0x82, 0x11, 0xb0, 0x8f, 0xf8,
-	0x27, 0xfb, 0x20, 0x94, 0x07, 0x69, 0x3a, 0xd4, 0xc9, 0xd2, 0xf0, 0x87, 0x6b, 0x3d, 0x39, 0xd4,
-	0xc5, 0x3e, 0xd8, 0xc2, 0xa2, 0x7b, 0xcb, 0x83, 0x2c, 0xdb, 0x60, 0xcb, 0x83, 0x2c, 0xdb, 0x7c,
-	0xcb, 0x25, 0x1c, 0x74, 0xec, 0x54, 0x2c, 0xa1, 0x68, 0x23, 0xb9, 0xa1, 0x3b, 0x76, 0xa3, 0x47,
-	0x3b, 0x36, 0xc6, 0x70, 0x3b, 0x3a, 0x13, 0xc6, 0x82, 0x1e, 0x2a, 0x93, 0xd8, 0x44, 0x49, 0xb2,
-	0x1d, 0x85, 0x48, 0xac, 0x1d, 0xb5, 0x49, 0x5c, 0xb9, 0x17, 0x22, 0xb1, 0x27, 0xaa, 0x89, 0x44,
-	0xd9, 0xb7, 0x98, 0x58, 0xe5, 0xae, 0xa0, 0xf8, 0xa5, 0x1e, 0x5b, 0x95, 0x95, 0x3b, 0x26, 0x5f,
-	0x6a, 0xaf, 0xc6, 0x5e, 0x6a, 0x04, 0x79, 0xcf, 0x0b, 0xf6, 0xbe, 0xff, 0xf9, 0x2c, 0x91, 0xc9,
-	0x22, 0x5f, 0xf0, 0xbd, 0x98, 0x6d, 0x0d, 0xb9, 0x38, 0x0f, 0x36, 0x62, 0x71, 0x8b, 0x18, 0x5b,
-	0xa1, 0x6d, 0xb5, 0x13, 0x7a, 0x91, 0x4e, 0x8e, 0xb5, 0x08, 0x4c, 0x79, 0xe7, 0x37, 0xec, 0x4e,
-	0xf3, 0xfb, 0xb9, 0xb4, 0x49, 0x3a, 0xb8, 0xb2, 0xa0, 0xf9, 0x7e, 0xd4, 0x41, 0x03, 0xba, 0x80,
-	0xfd, 0x8d, 0x79, 0x1f, 0xfa, 0x9f, 0x1e, 0xdb, 0xa9, 0xa6, 0xca, 0xe3, 0x57, 0x16, 0xb4, 0x14,
-	0x69, 0x31, 0x46, 0x64, 0x42, 0x83, 0xb4, 0x30, 0xe5, 0xdf, 0x12, 0x1e, 0xbb, 0x71, 0xb7, 0x8e,
-	0xc7, 0x5b, 0x5a, 0xf9, 0xd5, 0xfc, 0xd5, 0x63, 0x77, 0xdb, 0xe0, 0x71, 0x0a, 0x93, 0x62, 0x29,
-	0x07, 0x1b, 0x38, 0xad, 0x59, 0xb7, 0x8e, 0x47, 0xdb, 0x98, 0xb4, 0xa7, 0xcb, 0xe2, 0xc8, 0x4c,
-	0xe7, 0x74, 0x59, 0xaa, 0xeb, 0xa6, 0xcb, 0x1a, 0xc2, 0x39, 0xfb, 0xd3, 0x08, 0xb2, 0x34, 0x99,
-	0x88, 0xa2, 0x4e, 0x8a, 0x6e, 0x43, 0xe6, 0x6c, 0x1b, 0x8a, 0xe5, 0xec, 0x2a, 0x8b, 0x9b, 0x34,
-	0x56, 0x9b, 0x2a, 0x25, 0x9b, 0x34, 0x8d, 0xc6, 0x9a, 0x74, 0x97, 0x05, 0xde, 0xef, 0x08, 0x4c,
-	0x31, 0x3d, 0x7a, 0x8e, 0xdc, 0x6f, 0x1b, 0x8a, 0xed, 0x77, 0x95, 0xc5, 0x35, 0x7a, 0x2a, 0x13,
-	0x5b, 0x35, 0x3e, 0xb2, 0x46, 0x1b, 0x39, 0x56, 0xa3, 0x98, 0x0a, 0x52, 0x73, 0xa8, 0xb2, 0x3c,
-	0x2d, 0x87, 0xc8, 0x2a, 0x77, 0xbf, 0x57, 0x79, 0x91, 0x44, 0x64, 0x6a, 0x76, 0xb0, 0xb1, 0xd4,
-	0xec, 0x34, 0xc1, 0xa9, 0x59, 0x2c, 0xae, 0xbb, 0x9d, 0x7a, 0x35, 0x96, 0x9a, 0x08, 0xc2, 0x53,
-	0xca, 0x53, 0x58, 0x28, 0x0b, 0xf5, 0xe9, 0x51, 0xef, 0x16, 0x06, 0x62, 0x53, 0x4a, 0xc8, 0xe1,
-	0x6c, 0x38, 0x97, 0x53, 0x15, 0x84, 0xd9, 0x23, 0x87, 0x9c, 0x10, 0x8a, 0x65, 0xc3, 0x2a, 0xeb,
-	0xc3, 0xfd, 0xdd, 0x63, 0x1f, 0x0e, 0xb5, 0x2a, 0xb4, 0x72, 0xb3, 0x17, 0x73, 0x90, 0x47, 0x22,
-	0x9f, 0xcd, 0xed, 0x79, 0xc6, 0xc9, 0xe3, 0xef, 0x80, 0x5d, 0xfc, 0xc3, 0xad, 0x6c, 0x82, 0x87,
-	0xaa, 0x94, 0x85, 0xa9, 0xe9, 0x29, 0xfd, 0x50, 0xb5, 0xa0, 0xe8, 0x43, 0xb5, 0xc2, 0x06, 0x2f,
-	0x2e, 0xb8, 0x1a, 0xb8, 0x47, 0xff, 0x37, 0x17, 0x9e, 0xeb, 0xfd, 0x38, 0x84, 0x47, 0x2e, 0x17,
-	0x77, 0x04, 0xa6, 0x78, 0x56, 0x60, 0xca, 0x63, 0xab, 0xf3, 0x54, 0x6c, 0xe4, 0x22, 0x60, 0x1f,
-	0xf1, 0xdf, 0x1e, 0xfb, 0xa8, 0x78, 0x93, 0x51, 0xb9, 0x0f, 0xe4, 0xb4, 0xe8, 0xac, 0xd5, 0x0c,
-	0xf6, 0xb8, 0xe3, 0x0d, 0xef, 0xe0, 0xdd, 0x32, 0xbe, 0xdb, 0xd6, 0x0c, 0x57, 0x09, 0xbe, 0x71,
-	0xb2, 0x4a, 0x30, 0x10, 0xab, 0x92, 0x90, 0xf3, 0x21, 0x7e, 0x64, 0xb7, 0x9e, 0x88, 0xc9, 0x75,
-	0x9e, 0x71, 0xea, 0x4b, 0x4b, 0x25, 0x39, 0xb7, 0x9f, 0x45, 0x08, 0xe7, 0xf0, 0x61, 0x8f, 0x6b,
-	0x76, 0xbb, 0x38, 0x5d, 0xa5, 0xe1, 0x44, 0xab, 0x45, 0xed, 0xbd, 0xa3, 0xb7, 0x86, 0x54, 0xec,
-	0xe2, 0x08, 0xb8, 0x89, 0xf9, 0xe4, 0xf0, 0x97, 0x83, 0x65, 0x62, 0xc1, 0x98, 0xfd, 0x44, 0xf5,
-	0xab, 0xbf, 0xfa, 0x33, 0xd5, 0x5f, 0xda, 0x7e, 0xf9, 0x35, 0xab, 0x4f, 0x7d, 0xfb, 0xba, 0xbc,
-	0x55, 0x6a, 0x87, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xa2, 0x81, 0x89, 0x36, 0x13, 0x00,
-	0x00,
+	0x10, 0xc7, 0x89, 0x04, 0x95, 0x58, 0x28, 0xd0, 0xa3, 0xa2, 0x28, 0x48, 0x3c, 0xb6, 0xf4, 0x21,
+	0x10, 0x37, 0x0d, 0xe5, 0xbd, 0x9b, 0x26, 0x69, 0x50, 0x2d, 0x8c, 0x9d, 0x34, 0x08, 0x24, 0xa4,
+	0xcd, 0xdd, 0xc4, 0xbe, 0xe6, 0xbc, 0x7b, 0xdd, 0x5d, 0x5b, 0xf1, 0x2b, 0x24, 0x24, 0x5e, 0x21,
+	0xf1, 0x55, 0xf9, 0x0a, 0xe8, 0x1e, 0x76, 0x6f, 0xee, 0x3c, 0xb7, 0x3e, 0xbf, 0xb3, 0x3c, 0xbf,
+	0x99, 0xff, 0x3e, 0xcc, 0xcc, 0x8e, 0xcd, 0xb6, 0x0d, 0xbf, 0x48, 0xc0, 0xcc, 0xb8, 0xe0, 0x13,
+	0x50, 0x1a, 0xd4, 0x22, 0x0e, 0x61, 0x37, 0x55, 0xd2, 0xc8, 0xe0, 0x36, 0x65, 0xdb, 0xbe, 0x53,
+	0xfb, 0x36, 0xe2, 0x86, 0x17, 0xf8, 0x93, 0xff, 0x1e, 0xb2, 0x9b, 0xa7, 0xb9, 0x6d, 0x50, 0xd8,
+	0x82, 0x13, 0xf6, 0xf6, 0x30, 0x16, 0x93, 0xe0, 0xf3, 0xdd, 0x55, 0x9f, 0xcc, 0x30, 0x82, 0x37,
+	0x73, 0xd0, 0x66, 0xfb, 0x8b, 0x56, 0xbb, 0x4e, 0xa5, 0xd0, 0xf0, 0xf5, 0x5b, 0xc1, 0x4b, 0xf6,
+	0xce, 0x38, 0x01, 0x48, 0x03, 0x8a, 0xcd, 0x2d, 0x36, 0xd8, 0x97, 0xed, 0x80, 0x8b, 0xf6, 0x07,
+	0x7b, 0xef, 0xf0, 0x1a, 0xc2, 0xb9, 0x81, 0x17, 0x52, 0x5e, 0x05, 0xf7, 0x08, 0x17, 0x64, 0xb7,
+	0x91, 0xbf, 0x5d, 0x87, 0xb9, 0xf8, 0xbf, 0xb2, 0x77, 0x8f, 0xc1, 0x8c, 0xc3, 0x29, 0xcc, 0x78,
+	0xf0, 0x0d, 0xe1, 0xe6, 0xac, 0x36, 0xf6, 0x5d, 0x3f, 0xe4, 0x22, 0x4f, 0xd8, 0x07, 0xc7, 0x60,
+	0x86, 0xa0, 0x66, 0xb1, 0xd6, 0xb1, 0x14, 0x3a, 0x78, 0x40, 0x7b, 0x22, 0xc4, 0x6a, 0x3c, 0xec,
+	0x40, 0xe2, 0x23, 0x1a, 0x83, 0x19, 0x01, 0x8f, 0x7e, 0x16, 0xc9, 0x92, 0x3c, 0x22, 0x64, 0xf7,
+	0x1d, 0x51, 0x0d, 0x73, 0xf1, 0x39, 0x7b, 0xbf, 0x34, 0x9c, 0xab, 0xd8, 0x40, 0xe0, 0xf1, 0xcc,
+	0x01, 0xab, 0x70, 0x7f, 0x2d, 0xe7, 0x24, 0x7e, 0x67, 0xec, 0x60, 0xca, 0xc5, 0x04, 0x4e, 0x97,
+	0x29, 0x04, 0xd4, 0x09, 0x57, 0x66, 0x1b, 0xfe, 0xde, 0x1a, 0x0a, 0xaf, 0x7f, 0x04, 0x97, 0x0a,
+	0xf4, 0x74, 0x6c, 0x78, 0xcb, 0xfa, 0x31, 0xe0, 0x5b, 0x7f, 0x9d, 0xc3, 0x77, 0x3d, 0x9a, 0x8b,
+	0x17, 0xc0, 0x13, 0x33, 0x3d, 0x98, 0x42, 0x78, 0x45, 0xde, 0x75, 0x1d, 0xf1, 0xdd, 0x75, 0x93,
+	0x74, 0x42, 0x29, 0xbb, 0x75, 0x32, 0x11, 0x52, 0x41, 0x61, 0x3e, 0x54, 0x4a, 0xaa, 0x60, 0x87,
+	0x88, 0xb0, 0x42, 0x59, 0xb9, 0xef, 0xba, 0xc1, 0xf5, 0xd3, 0x4b, 0x24, 0x8f, 0xca, 0x1a, 0xa1,
+	0x4f, 0xaf, 0x02, 0xfc, 0xa7, 0x87, 0x39, 0x27, 0xf1, 0x9a, 0x7d, 0x38, 0x54, 0x70, 0x99, 0xc4,
+	0x93, 0xa9, 0xad, 0x44, 0xea, 0x50, 0x1a, 0x8c, 0x15, 0x7a, 0xd4, 0x05, 0xc5, 0xc5, 0xd2, 0x4f,
+	0xd3, 0x64, 0x59, 0xea, 0x50, 0x49, 0x84, 0xec, 0xbe, 0x62, 0xa9, 0x61, 0x38, 0x93, 0x5f, 0xca,
+	0xf0, 0x2a, 0xef, 0xae, 0x9a, 0xcc, 0xe4, 0xca, 0xec, 0xcb, 0x64, 0x4c, 0xe1, 0xbb, 0x38, 0x13,
+	0x49, 0x15, 0x9e, 0x5a, 0x16, 0x06, 0x7c, 0x77, 0x51, 0xe7, 0x70, 0x82, 0x95, 0x8d, 0xf2, 0x08,
+	0x4c, 0x38, 0xed, 0xeb, 0xe7, 0x17, 0x9c, 0x4c, 0xb0, 0x15, 0xca, 0x97, 0x60, 0x04, 0xec, 0x14,
+	0xff, 0x64, 0x9f, 0xd4, 0xcd, 0xfd, 0x24, 0x19, 0xaa, 0x78, 0xa1, 0x83, 0xc7, 0x6b, 0x23, 0x59,
+	0xd4, 0x6a, 0xef, 0x6d, 0xe0, 0xd1, 0xbe, 0xe5, 0x7e, 0x9a, 0x76, 0xd8, 0x72, 0x3f, 0x4d, 0xbb,
+	0x6f, 0x39, 0x87, 0x6b, 0x1d, 0x3b, 0xe1, 0x0b, 0x18, 0x1b, 0x6e, 0xe6, 0x9a, 0xee, 0xd8, 0x95,
+	0xdd, 0xdb, 0xb1, 0x31, 0x86, 0xdb, 0xd1, 0x80, 0x6b, 0x03, 0x6a, 0x28, 0x75, 0x6c, 0x62, 0x29,
+	0xc8, 0x76, 0x54, 0x47, 0x7c, 0xed, 0xa8, 0x49, 0xe2, 0xca, 0x3d, 0xe7, 0xb1, 0x39, 0x92, 0x95,
+	0x12, 0xe5, 0xdf, 0x60, 0x7c, 0x95, 0xbb, 0x82, 0xe2, 0x97, 0x7a, 0x6c, 0x64, 0x9a, 0xef, 0x98,
+	0x7c, 0xa9, 0x9d, 0xd5, 0xf7, 0x52, 0x23, 0xc8, 0x45, 0x9e, 0xb1, 0x8f, 0xdc, 0xd7, 0x83, 0x58,
+	0xc4, 0xb3, 0xf9, 0x2c, 0x78, 0xe4, 0xf3, 0x2d, 0x21, 0xab, 0xb3, 0xd3, 0x89, 0xc5, 0x2d, 0x62,
+	0x6c, 0xb8, 0x32, 0xc5, 0x4e, 0xe8, 0x45, 0x5a, 0xb3, 0xaf, 0x45, 0x60, 0xca, 0x05, 0x5f, 0xb2,
+	0xdb, 0xd5, 0xf7, 0x67, 0xc2, 0xc4, 0x49, 0xff, 0xd2, 0x80, 0x0a, 0x76, 0xbd, 0x01, 0x2a, 0xd0,
+	0x0a, 0xf6, 0x3a, 0xf3, 0x4e, 0xfa, 0x9f, 0x2d, 0xb6, 0x5d, 0x4c, 0x95, 0x87, 0xd7, 0x06, 0x94,
+	0xe0, 0x49, 0x36, 0x46, 0xa4, 0x5c, 0x81, 0x30, 0x10, 0x05, 0x3f, 0x10, 0x11, 0xdb, 0x71, 0xbb,
+	0x8e, 0xa7, 0x1b, 0x7a, 0xb9, 0xd5, 0xfc, 0xb5, 0xc5, 0xee, 0x34, 0xc1, 0xc3, 0x04, 0xc2, 0x6c,
+	0x29, 0x7b, 0x1d, 0x82, 0x96, 0xac, 0x5d, 0xc7, 0x93, 0x4d, 0x5c, 0x9a, 0xd3, 0x65, 0x76, 0x64,
+	0xba, 0x75, 0xba, 0xcc, 0xad, 0xeb, 0xa6, 0xcb, 0x12, 0xc2, 0x39, 0xfb, 0x6a, 0x04, 0x69, 0x12,
+	0x87, 0x3c, 0xab, 0x93, 0xac, 0xdb, 0x90, 0x39, 0xdb, 0x84, 0x7c, 0x39, 0xbb, 0xca, 0xe2, 0x26,
+	0x8d, 0xad, 0x55, 0x95, 0x92, 0x4d, 0x9a, 0x46, 0x7d, 0x4d, 0xba, 0xcd, 0x03, 0xef, 0x77, 0x04,
+	0x1a, 0x0c, 0xe2, 0xc8, 0xfd, 0x36, 0x21, 0xdf, 0x7e, 0x57, 0xd9, 0x5a, 0xf6, 0x9c, 0x88, 0xd7,
+	0x10, 0x9a, 0xc3, 0x59, 0x6a, 0x96, 0xa7, 0x8a, 0x0b, 0xcd, 0x43, 0x93, 0x8f, 0xf1, 0xd4, 0xfa,
+	0x5b, 0x58, 0x5f, 0xf6, 0xb4, 0xba, 0xe0, 0x46, 0x71, 0x22, 0x62, 0x53, 0x74, 0x5f, 0xb2, 0x51,
+	0x54, 0x66, 0x5f, 0xa3, 0xc0, 0x54, 0x6d, 0x87, 0x43, 0x99, 0xce, 0x13, 0x6e, 0xc0, 0x16, 0xd0,
+	0x4f, 0x72, 0x9e, 0x65, 0x32, 0xb9, 0xc3, 0x16, 0xd6, 0xb7, 0xc3, 0x56, 0x17, 0x5c, 0x1f, 0xd9,
+	0xe2, 0xda, 0x7b, 0xba, 0xb3, 0xfa, 0xea, 0x03, 0x41, 0x78, 0x54, 0x7a, 0x0e, 0x33, 0x69, 0xa0,
+	0x3c, 0x3d, 0xea, 0xf1, 0xc4, 0x80, 0x6f, 0x54, 0xaa, 0x73, 0x38, 0x25, 0xcf, 0x44, 0x24, 0x6b,
+	0x32, 0x8f, 0xc8, 0x49, 0x2b, 0x92, 0x94, 0xd4, 0x4e, 0x27, 0xd6, 0xc9, 0xfd, 0xbd, 0xc5, 0x3e,
+	0x1d, 0x2a, 0x99, 0xd9, 0xf2, 0xcd, 0x9e, 0x4f, 0x41, 0x1c, 0xf0, 0xf9, 0x64, 0x6a, 0xce, 0xd2,
+	0x80, 0x3c, 0xfe, 0x16, 0xd8, 0xea, 0xef, 0x6f, 0xe4, 0x53, 0x7b, 0x2d, 0x73, 0x33, 0xd7, 0x25,
+	0x1d, 0xd1, 0xaf, 0x65, 0x03, 0xf2, 0xbe, 0x96, 0x2b, 0x6c, 0xed, 0xd9, 0x07, 0x5b, 0x03, 0xe4,
+	0xb3, 0x0f, 0x8d, 0x12, 0xb8, 0xeb, 0x87, 0xf0, 0xdc, 0x67, 0x75, 0x47, 0xa0, 0x0d, 0x57, 0xd9,
+	0x4e, 0x7c, 0xab, 0x73, 0x94, 0x6f, 0xee, 0x23, 0x60, 0xa7, 0xf8, 0xef, 0x16, 0xfb, 0x2c, 0x1b,
+	0x0c, 0x50, 0xcf, 0xe9, 0x8b, 0x28, 0x6b, 0xef, 0xc5, 0x20, 0xf8, 0xb4, 0x65, 0x90, 0x68, 0xe1,
+	0xed, 0x32, 0x7e, 0xdc, 0xd4, 0x0d, 0x57, 0x09, 0xbe, 0x71, 0xb2, 0x4a, 0x30, 0xe0, 0xab, 0x92,
+	0x3a, 0xe7, 0x24, 0x7e, 0x61, 0x37, 0x9e, 0xf1, 0xf0, 0x6a, 0x9e, 0x06, 0xd4, 0xdf, 0x3d, 0x85,
+	0xc9, 0x86, 0xfd, 0xca, 0x43, 0xd8, 0x80, 0x8f, 0xb7, 0x02, 0xc5, 0x6e, 0x65, 0xa7, 0x2b, 0x15,
+	0x1c, 0x29, 0x39, 0x2b, 0xa3, 0xb7, 0x34, 0xf8, 0x3a, 0xe5, 0xbb, 0x38, 0x02, 0x46, 0x9a, 0x59,
+	0xd6, 0x83, 0x29, 0x0c, 0x03, 0x7e, 0x3d, 0xe2, 0x06, 0xe8, 0xac, 0x6f, 0x40, 0xde, 0xac, 0x5f,
+	0x61, 0x71, 0x6e, 0x1e, 0x83, 0x19, 0x2c, 0xf5, 0x9b, 0xe4, 0x15, 0x57, 0x71, 0xf1, 0x73, 0x6f,
+	0x87, 0x9e, 0x0d, 0xea, 0x94, 0x6f, 0x8b, 0x04, 0xec, 0x14, 0x17, 0xec, 0xe3, 0xfc, 0x17, 0x6d,
+	0x43, 0xf3, 0xfb, 0xb6, 0x5f, 0xbe, 0xb4, 0xea, 0x6e, 0x57, 0xdc, 0xe9, 0x46, 0xec, 0x66, 0x59,
+	0x2a, 0x39, 0x12, 0x05, 0xf7, 0x5b, 0xee, 0xc6, 0x11, 0x56, 0xeb, 0xc1, 0x7a, 0xd0, 0xaa, 0x3c,
+	0xdb, 0xff, 0x6d, 0x6f, 0x11, 0x1b, 0xd0, 0x7a, 0x37, 0x96, 0xbd, 0xe2, 0x53, 0x6f, 0x22, 0x7b,
+	0x0b, 0xd3, 0xcb, 0xff, 0x11, 0xed, 0x51, 0xff, 0x9f, 0x5e, 0xdc, 0xc8, 0x6d, 0xfb, 0xff, 0x0f,
+	0x00, 0xe0, 0x02, 0x19, 0xb3, 0x7a, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -184,6 +190,9 @@ type TabletManagerClient interface {
 	VReplicationWaitForPos(ctx context.Context, in *tabletmanagerdata.VReplicationWaitForPosRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error)
+	// InjectEmptyTransactions commits an empty transaction on the master
+	// for each of the GTIDs
+	InjectEmptyTransactions(ctx context.Context, in *tabletmanagerdata.InjectEmptyTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error)
 	// InitMaster initializes the tablet as a master
 	InitMaster(ctx context.Context, in *tabletmanagerdata.InitMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitMasterResponse, error)
 	// PopulateReparentJournal tells the tablet to add an entry to its
@@ -212,6 +221,17 @@ type TabletManagerClient interface {
 	Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error)
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
+	// SetBackupMaxRate changes the rate limit of the backups and restores
+	// of the tablet, including the running ones.
+	SetBackupMaxRate(ctx context.Context, in *tabletmanagerdata.SetBackupMaxRateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetBackupMaxRateResponse, error)
+	// GetMysqlVariables returns the global variables of mysqld.
+	GetMysqlVariables(ctx context.Context, in *tabletmanagerdata.GetMysqlVariablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetMysqlVariablesResponse, error)
+	// ApplyMysqlVariables changes global variables of mysqld, and
+	// persists them in its my.cnf.
+	ApplyMysqlVariables(ctx context.Context, in *tabletmanagerdata.ApplyMysqlVariablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyMysqlVariablesResponse, error)
+	// RestartMysqld stops and starts mysqld, to apply the variables which
+	// are not dynamic or to upgrade it. The tablet must not be a master.
+	RestartMysqld(ctx context.Context, in *tabletmanagerdata.RestartMysqldRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RestartMysqldResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -510,6 +530,15 @@ func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletma
 	return out, nil
 }
 
+func (c *tabletManagerClient) InjectEmptyTransactions(ctx context.Context, in *tabletmanagerdata.InjectEmptyTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error) {
+	out := new(tabletmanagerdata.InjectEmptyTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InjectEmptyTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) InitMaster(ctx context.Context, in *tabletmanagerdata.InitMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitMasterResponse, error) {
 	out := new(tabletmanagerdata.InitMasterResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InitMaster", in, out, opts...)
@@ -673,6 +702,42 @@ func (x *tabletManagerRestoreFromBackupClient) Recv() (*tabletmanagerdata.Restor
 	return m, nil
 }
 
+func (c *tabletManagerClient) SetBackupMaxRate(ctx context.Context, in *tabletmanagerdata.SetBackupMaxRateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetBackupMaxRateResponse, error) {
+	out := new(tabletmanagerdata.SetBackupMaxRateResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetBackupMaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetMysqlVariables(ctx context.Context, in *tabletmanagerdata.GetMysqlVariablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetMysqlVariablesResponse, error) {
+	out := new(tabletmanagerdata.GetMysqlVariablesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetMysqlVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ApplyMysqlVariables(ctx context.Context, in *tabletmanagerdata.ApplyMysqlVariablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyMysqlVariablesResponse, error) {
+	out := new(tabletmanagerdata.ApplyMysqlVariablesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplyMysqlVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RestartMysqld(ctx context.Context, in *tabletmanagerdata.RestartMysqldRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RestartMysqldResponse, error) {
+	out := new(tabletmanagerdata.RestartMysqldResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RestartMysqld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -749,6 +814,9 @@ type TabletManagerServer interface {
 	VReplicationWaitForPos(context.Context, *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error)
+	// InjectEmptyTransactions commits an empty transaction on the master
+	// for each of the GTIDs
+	InjectEmptyTransactions(context.Context, *tabletmanagerdata.InjectEmptyTransactionsRequest) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error)
 	// InitMaster initializes the tablet as a master
 	InitMaster(context.Context, *tabletmanagerdata.InitMasterRequest) (*tabletmanagerdata.InitMasterResponse, error)
 	// PopulateReparentJournal tells the tablet to add an entry to its
@@ -777,6 +845,17 @@ type TabletManagerServer interface {
 	Backup(*tabletmanagerdata.BackupRequest, TabletManager_BackupServer) error
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
+	// SetBackupMaxRate changes the rate limit of the backups and restores
+	// of the tablet, including the running ones.
+	SetBackupMaxRate(context.Context, *tabletmanagerdata.SetBackupMaxRateRequest) (*tabletmanagerdata.SetBackupMaxRateResponse, error)
+	// GetMysqlVariables returns the global variables of mysqld.
+	GetMysqlVariables(context.Context, *tabletmanagerdata.GetMysqlVariablesRequest) (*tabletmanagerdata.GetMysqlVariablesResponse, error)
+	// ApplyMysqlVariables changes global variables of mysqld, and
+	// persists them in its my.cnf.
+	ApplyMysqlVariables(context.Context, *tabletmanagerdata.ApplyMysqlVariablesRequest) (*tabletmanagerdata.ApplyMysqlVariablesResponse, error)
+	// RestartMysqld stops and starts mysqld, to apply the variables which
+	// are not dynamic or to upgrade it. The tablet must not be a master.
+	RestartMysqld(context.Context, *tabletmanagerdata.RestartMysqldRequest) (*tabletmanagerdata.RestartMysqldResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -879,6 +958,9 @@ func (*UnimplementedTabletManagerServer) VReplicationWaitForPos(ctx context.Cont
 func (*UnimplementedTabletManagerServer) ResetReplication(ctx context.Context, req *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetReplication not implemented")
 }
+func (*UnimplementedTabletManagerServer) InjectEmptyTransactions(ctx context.Context, req *tabletmanagerdata.InjectEmptyTransactionsRequest) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectEmptyTransactions not implemented")
+}
 func (*UnimplementedTabletManagerServer) InitMaster(ctx context.Context, req *tabletmanagerdata.InitMasterRequest) (*tabletmanagerdata.InitMasterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitMaster not implemented")
 }
@@ -918,6 +1000,18 @@ func (*UnimplementedTabletManagerServer) Backup(req *tabletmanagerdata.BackupReq
 func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdata.RestoreFromBackupRequest, srv TabletManager_RestoreFromBackupServer) error {
 	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
 }
+func (*UnimplementedTabletManagerServer) SetBackupMaxRate(ctx context.Context, req *tabletmanagerdata.SetBackupMaxRateRequest) (*tabletmanagerdata.SetBackupMaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBackupMaxRate not implemented")
+}
+func (*UnimplementedTabletManagerServer) GetMysqlVariables(ctx context.Context, req *tabletmanagerdata.GetMysqlVariablesRequest) (*tabletmanagerdata.GetMysqlVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMysqlVariables not implemented")
+}
+func (*UnimplementedTabletManagerServer) ApplyMysqlVariables(ctx context.Context, req *tabletmanagerdata.ApplyMysqlVariablesRequest) (*tabletmanagerdata.ApplyMysqlVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyMysqlVariables not implemented")
+}
+func (*UnimplementedTabletManagerServer) RestartMysqld(ctx context.Context, req *tabletmanagerdata.RestartMysqldRequest) (*tabletmanagerdata.RestartMysqldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartMysqld not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -1499,6 +1593,24 @@ func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_InjectEmptyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.InjectEmptyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).InjectEmptyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/InjectEmptyTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).InjectEmptyTransactions(ctx, req.(*tabletmanagerdata.InjectEmptyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_InitMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.InitMasterRequest)
 	if err := dec(in); err != nil {
@@ -1739,6 +1851,78 @@ func (x *tabletManagerRestoreFromBackupServer) Send(m *tabletmanagerdata.Restore
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TabletManager_SetBackupMaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetBackupMaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetBackupMaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetBackupMaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetBackupMaxRate(ctx, req.(*tabletmanagerdata.SetBackupMaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetMysqlVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetMysqlVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetMysqlVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetMysqlVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetMysqlVariables(ctx, req.(*tabletmanagerdata.GetMysqlVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ApplyMysqlVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ApplyMysqlVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ApplyMysqlVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ApplyMysqlVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ApplyMysqlVariables(ctx, req.(*tabletmanagerdata.ApplyMysqlVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RestartMysqld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RestartMysqldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RestartMysqld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RestartMysqld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RestartMysqld(ctx, req.(*tabletmanagerdata.RestartMysqldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1871,6 +2055,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetReplication",
 			Handler:    _TabletManager_ResetReplication_Handler,
 		},
+		{
+			MethodName: "InjectEmptyTransactions",
+			Handler:    _TabletManager_InjectEmptyTransactions_Handler,
+		},
 		{
 			MethodName: "InitMaster",
 			Handler:    _TabletManager_InitMaster_Handler,
@@ -1915,6 +2103,22 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PromoteSlave",
 			Handler:    _TabletManager_PromoteSlave_Handler,
 		},
+		{
+			MethodName: "SetBackupMaxRate",
+			Handler:    _TabletManager_SetBackupMaxRate_Handler,
+		},
+		{
+			MethodName: "GetMysqlVariables",
+			Handler:    _TabletManager_GetMysqlVariables_Handler,
+		},
+		{
+			MethodName: "ApplyMysqlVariables",
+			Handler:    _TabletManager_ApplyMysqlVariables_Handler,
+		},
+		{
+			MethodName: "RestartMysqld",
+			Handler:    _TabletManager_RestartMysqld_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
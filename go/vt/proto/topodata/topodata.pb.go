@@ -298,10 +298,14 @@ type Tablet struct {
 	// replication-management commands like PlannedReparentShard,
 	// EmergencyReparentShard, and TabletExternallyReparented.
 	//
-	MasterTermStartTime  *vttime.Time `protobuf:"bytes,14,opt,name=master_term_start_time,json=masterTermStartTime,proto3" json:"master_term_start_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	MasterTermStartTime *vttime.Time `protobuf:"bytes,14,opt,name=master_term_start_time,json=masterTermStartTime,proto3" json:"master_term_start_time,omitempty"`
+	// master_delay is the MASTER_DELAY of the replication of the tablet,
+	// in seconds. Tablets with a delay are delayed replicas: they don't
+	// serve queries, and are never promoted to master.
+	MasterDelay          int32    `protobuf:"varint,15,opt,name=master_delay,json=masterDelay,proto3" json:"master_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Tablet) Reset()         { *m = Tablet{} }
@@ -413,6 +417,13 @@ func (m *Tablet) GetMasterTermStartTime() *vttime.Time {
 	return nil
 }
 
+func (m *Tablet) GetMasterDelay() int32 {
+	if m != nil {
+		return m.MasterDelay
+	}
+	return 0
+}
+
 // A Shard contains data about a subset of the data whithin a keyspace.
 type Shard struct {
 	// master_alias is the tablet alias of the master for the shard.
@@ -752,10 +763,17 @@ type Keyspace struct {
 	// snapshot_time (in UTC) is a property of snapshot
 	// keyspaces which tells us what point in time
 	// the snapshot is of
-	SnapshotTime         *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SnapshotTime *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	// durability_policy says how the writes of the masters of the
+	// keyspace are made durable: "none", "semi_sync" (acknowledged by
+	// a REPLICA tablet) or "cross_cell" (acknowledged by a REPLICA tablet
+	// in another cell). It drives which tablets acknowledge semi-sync,
+	// and which tablets the reparent operations can promote.
+	// Empty means the tablets use their -enable_semi_sync flag.
+	DurabilityPolicy     string   `protobuf:"bytes,8,opt,name=durability_policy,json=durabilityPolicy,proto3" json:"durability_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -825,6 +843,13 @@ func (m *Keyspace) GetSnapshotTime() *vttime.Time {
 	return nil
 }
 
+func (m *Keyspace) GetDurabilityPolicy() string {
+	if m != nil {
+		return m.DurabilityPolicy
+	}
+	return ""
+}
+
 // ServedFrom indicates a relationship between a TabletType and the
 // keyspace name that's serving it.
 type Keyspace_ServedFrom struct {
@@ -1376,90 +1401,92 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
+	// 1386 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
-	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0xaa, 0x35, 0x95, 0xd2, 0x1f, 0x33, 0x6d, 0xaf, 0xed, 0x82, 0x79, 0xc8, 0x66, 0xd4, 0x0b, 0x2e,
-	0x18, 0xd9, 0x80, 0xbc, 0x90, 0x1e, 0x97, 0x8e, 0xb1, 0x65, 0xd4, 0x2d, 0xaa, 0x15, 0x62, 0x43,
-	0x96, 0x05, 0x43, 0x27, 0x83, 0x36, 0x25, 0xd6, 0x1e, 0x41, 0xa9, 0xef, 0x9d, 0x8d, 0x98, 0x6c,
-	0x8e, 0x7c, 0x4f, 0x10, 0x02, 0xb9, 0x01, 0x1b, 0x8d, 0x30, 0xaa, 0x48, 0x51, 0x56, 0x41, 0x57,
-	0xbe, 0x0e, 0x2a, 0x53, 0x25, 0xd6, 0xfe, 0xce, 0x41, 0x41, 0x47, 0x91, 0x4f, 0x21, 0xef, 0xa9,
-	0x48, 0x8c, 0x28, 0xed, 0xde, 0x6f, 0x24, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
-	0x09, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x9a, 0xe8, 0x64, 0x0f, 0xcc, 0x49, 0xc8, 0xa5,
-	0x3b, 0xf6, 0x26, 0x4e, 0x6e, 0x2b, 0x5b, 0x2f, 0xed, 0x3e, 0x58, 0xce, 0xd5, 0xe8, 0x86, 0x5c,
-	0x1e, 0x7b, 0x93, 0x76, 0x20, 0xf9, 0x8c, 0xae, 0x4d, 0xb4, 0xa6, 0xb2, 0x5e, 0xb2, 0x99, 0x98,
-	0x78, 0x03, 0xe6, 0xe4, 0x75, 0xd6, 0x58, 0x47, 0x18, 0xde, 0x78, 0x7c, 0xe8, 0x14, 0xf0, 0x40,
-	0x2b, 0x64, 0x07, 0x8a, 0x97, 0x6c, 0xe6, 0x72, 0x85, 0x94, 0xb3, 0x86, 0x85, 0x93, 0xf9, 0xc7,
-	0x62, 0x0c, 0x31, 0x8d, 0x46, 0xb3, 0x0e, 0x39, 0x39, 0x9b, 0x30, 0xc7, 0xdc, 0x32, 0xea, 0x95,
-	0xdd, 0x8d, 0xe5, 0xc2, 0xfa, 0xb3, 0x09, 0xa3, 0xe8, 0x41, 0xea, 0x60, 0x0f, 0xcf, 0x5c, 0xd5,
-	0x91, 0x1b, 0x4e, 0x19, 0xe7, 0xfe, 0x90, 0x39, 0x45, 0xfc, 0x76, 0x65, 0x78, 0xd6, 0xf1, 0xc6,
-	0xec, 0x24, 0xb2, 0x92, 0x06, 0xe4, 0xa4, 0x77, 0x21, 0x1c, 0xc0, 0x66, 0xab, 0x2b, 0xcd, 0xf6,
-	0xbd, 0x0b, 0xa1, 0x3b, 0x45, 0x3f, 0xf2, 0x10, 0x2a, 0xe3, 0x99, 0x78, 0x3b, 0x72, 0x13, 0x08,
-	0x2d, 0xcc, 0x5b, 0x46, 0xeb, 0xcb, 0x18, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0xc1, 0xe3, 0x94, 0xb7,
-	0x8c, 0x7a, 0x9e, 0x16, 0xd1, 0xa2, 0xd0, 0x23, 0x4d, 0xd8, 0x1c, 0x7b, 0x42, 0x32, 0xee, 0x4a,
-	0xc6, 0xc7, 0x2e, 0xd2, 0xc2, 0x55, 0x1c, 0x72, 0x2a, 0x88, 0x83, 0xd5, 0x88, 0x28, 0xd5, 0xf7,
-	0xc7, 0x8c, 0xde, 0xd3, 0xbe, 0x7d, 0xc6, 0xc7, 0x3d, 0xe5, 0xa9, 0x8c, 0xd5, 0xa7, 0x60, 0xa5,
-	0x2f, 0x42, 0xf1, 0xe3, 0x92, 0xcd, 0x22, 0xca, 0x28, 0x51, 0xa1, 0x3e, 0xf5, 0x46, 0x57, 0xfa,
-	0x92, 0xf3, 0x54, 0x2b, 0x4f, 0x33, 0x7b, 0x46, 0xf5, 0x2b, 0x28, 0x26, 0x7d, 0xfd, 0x5b, 0x60,
-	0x31, 0x15, 0xf8, 0x2a, 0x67, 0x66, 0xed, 0xdc, 0xab, 0x9c, 0x59, 0xb2, 0xad, 0xda, 0xef, 0x05,
-	0xc8, 0xf7, 0xf0, 0x22, 0xf7, 0xc0, 0x8a, 0xba, 0xb9, 0x01, 0x09, 0x4b, 0xda, 0x55, 0x13, 0xfd,
-	0x7a, 0x1c, 0xcc, 0x1b, 0xe2, 0xb0, 0xc8, 0xa2, 0xcc, 0x0d, 0x58, 0xf4, 0x0d, 0x58, 0x82, 0xf1,
-	0x29, 0x1b, 0xba, 0x8a, 0x2a, 0xc2, 0xc9, 0x2e, 0xdf, 0x3c, 0x36, 0xd5, 0xe8, 0xa1, 0x0f, 0x72,
-	0xaa, 0x24, 0x12, 0x59, 0x90, 0x67, 0x50, 0x16, 0xe1, 0x15, 0x1f, 0x30, 0x17, 0x59, 0x2c, 0xa2,
-	0x31, 0xf9, 0xdf, 0x4a, 0x3c, 0x3a, 0xa1, 0x4c, 0x2d, 0x31, 0x57, 0x04, 0x79, 0x01, 0xeb, 0x12,
-	0x01, 0x71, 0x07, 0x61, 0x20, 0x79, 0x38, 0x12, 0x4e, 0x61, 0x79, 0xd4, 0x74, 0x0e, 0x8d, 0x5b,
-	0x4b, 0x7b, 0xd1, 0x8a, 0x4c, 0xab, 0x82, 0x6c, 0xc3, 0x5d, 0x5f, 0xb8, 0x11, 0x7e, 0xaa, 0x44,
-	0x3f, 0xb8, 0xc0, 0x39, 0x32, 0xe9, 0xba, 0x2f, 0x8e, 0xd1, 0xde, 0xd3, 0xe6, 0xea, 0x6b, 0x80,
-	0x79, 0x43, 0xe4, 0x09, 0x94, 0xa2, 0x0a, 0x70, 0x9e, 0x8c, 0xf7, 0xcc, 0x13, 0xc8, 0x44, 0x56,
-	0xbc, 0x50, 0xab, 0x48, 0x38, 0x99, 0xad, 0xac, 0xe2, 0x05, 0x2a, 0xd5, 0x5f, 0x0d, 0x28, 0xa5,
-	0x9a, 0x8d, 0x17, 0x95, 0x91, 0x2c, 0xaa, 0x85, 0xd5, 0x90, 0xb9, 0x6e, 0x35, 0x64, 0xaf, 0x5d,
-	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x48, 0xab, 0xfe,
-	0x66, 0x40, 0x79, 0x01, 0xc5, 0x5b, 0xed, 0x9d, 0x7c, 0x06, 0xe4, 0x6c, 0xe4, 0x0d, 0x2e, 0x47,
-	0xbe, 0x90, 0x8a, 0x50, 0xba, 0x84, 0x1c, 0xba, 0xdc, 0x4d, 0x9d, 0x60, 0x52, 0xa1, 0xaa, 0x3c,
-	0xe7, 0xe1, 0x8f, 0x2c, 0xc0, 0x0d, 0x69, 0xd2, 0x48, 0x4b, 0xc6, 0x2a, 0x6f, 0x17, 0x6a, 0x7f,
-	0x64, 0xf1, 0xfd, 0xd0, 0xe8, 0x7c, 0x0e, 0x1b, 0x08, 0x88, 0x1f, 0x5c, 0xb8, 0x83, 0x70, 0x74,
-	0x35, 0x0e, 0x70, 0xa9, 0x45, 0xc3, 0x4a, 0xe2, 0xb3, 0x16, 0x1e, 0xa9, 0xbd, 0x46, 0x5e, 0xad,
-	0x46, 0x60, 0x9f, 0x19, 0xec, 0xd3, 0x59, 0x00, 0x11, 0xbf, 0x71, 0xa0, 0x39, 0xbe, 0x94, 0x0b,
-	0x7b, 0x7e, 0x96, 0x4c, 0xca, 0x39, 0x0f, 0xc7, 0x62, 0xf5, 0x41, 0x88, 0x73, 0x44, 0xc3, 0xf2,
-	0x82, 0x87, 0xe3, 0x78, 0x58, 0x94, 0x2c, 0xc8, 0xd7, 0x50, 0x8e, 0x6f, 0x5a, 0x97, 0x91, 0xc7,
-	0x32, 0x36, 0x57, 0x53, 0x60, 0x11, 0xd6, 0x65, 0x4a, 0x23, 0x1f, 0x43, 0xf9, 0xcc, 0x13, 0xcc,
-	0x4d, 0xb8, 0xa3, 0x5f, 0x0f, 0x4b, 0x19, 0x13, 0x84, 0xbe, 0x80, 0xb2, 0x08, 0xbc, 0x89, 0x78,
-	0x13, 0x46, 0x8b, 0x63, 0xed, 0x1d, 0x8b, 0xc3, 0x8a, 0x5d, 0x70, 0x73, 0x5e, 0xc5, 0xb3, 0xa0,
-	0x6a, 0xbc, 0x5d, 0x3e, 0xa4, 0x99, 0x9e, 0x5d, 0x64, 0xba, 0xbe, 0xe4, 0xda, 0x4f, 0x06, 0xd8,
-	0x7a, 0x29, 0xb0, 0xc9, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
-	0x6a, 0x73, 0x2a, 0x84, 0x3f, 0x5a, 0xda, 0x03, 0x29, 0xd7, 0x46, 0x27, 0x1c, 0x32, 0xaa, 0xbd,
-	0xab, 0xcf, 0x20, 0xa7, 0x54, 0xb5, 0x7f, 0xa3, 0x16, 0x6e, 0xb2, 0x7f, 0xe5, 0x5c, 0xa9, 0x9d,
-	0x42, 0x25, 0xfa, 0xc2, 0x39, 0xe3, 0x2c, 0x18, 0x30, 0xf5, 0xd3, 0x23, 0xc5, 0x30, 0x94, 0x3f,
-	0x78, 0xc5, 0xd6, 0x7e, 0x36, 0x80, 0x60, 0xde, 0xc5, 0xd1, 0xbb, 0x8d, 0xdc, 0xe4, 0x31, 0x6c,
-	0xbe, 0xbd, 0x62, 0x7c, 0xa6, 0x37, 0xde, 0x80, 0xb9, 0x43, 0x5f, 0xa8, 0xaf, 0xe8, 0x0d, 0x62,
-	0xd2, 0x0d, 0x3c, 0xed, 0xe9, 0xc3, 0xfd, 0xe8, 0xac, 0xf6, 0x57, 0x0e, 0x4a, 0x3d, 0x3e, 0x4d,
-	0x68, 0xf3, 0x2d, 0xc0, 0xc4, 0xe3, 0xd2, 0x57, 0x98, 0xc6, 0xb0, 0x7f, 0x92, 0x82, 0x7d, 0xee,
-	0x9a, 0x30, 0xb4, 0x1b, 0xfb, 0xd3, 0x54, 0xe8, 0xb5, 0x13, 0x9a, 0xf9, 0xe0, 0x09, 0xcd, 0xfe,
-	0x87, 0x09, 0x6d, 0x42, 0x29, 0x35, 0xa1, 0xd1, 0x80, 0x6e, 0xbd, 0xbb, 0x8f, 0xd4, 0x8c, 0xc2,
-	0x7c, 0x46, 0xab, 0x7f, 0x1a, 0x70, 0x77, 0xa5, 0x45, 0x35, 0x15, 0xa9, 0x47, 0xf2, 0xfd, 0x53,
-	0x31, 0x7f, 0x1d, 0x49, 0x0b, 0x6c, 0xac, 0xd2, 0xe5, 0x31, 0xa1, 0xf4, 0x80, 0x94, 0xd2, 0x7d,
-	0x2d, 0x32, 0x8e, 0xae, 0x8b, 0x05, 0x5d, 0x90, 0x2e, 0xdc, 0xd7, 0x49, 0x96, 0x5f, 0x49, 0xfd,
-	0x52, 0xff, 0x7f, 0x29, 0xd3, 0xe2, 0x23, 0x79, 0x4f, 0xac, 0xd8, 0x44, 0xd5, 0xbd, 0x8d, 0x89,
-	0x7f, 0xcf, 0x2b, 0x16, 0xad, 0xee, 0x43, 0x30, 0x5b, 0x6c, 0x34, 0x3a, 0x08, 0xce, 0x43, 0xf5,
-	0x3b, 0x11, 0x71, 0xe1, 0xae, 0x37, 0x1c, 0x72, 0x26, 0x44, 0xc4, 0xfa, 0xb2, 0xb6, 0x36, 0xb5,
-	0x51, 0x8d, 0x04, 0x0f, 0x43, 0x19, 0x25, 0x44, 0x39, 0x5a, 0x14, 0x35, 0x00, 0x95, 0x4c, 0xe8,
-	0x1f, 0x4a, 0xef, 0x5c, 0x37, 0xdb, 0x75, 0xb0, 0xd2, 0xfb, 0x93, 0x00, 0x14, 0x3a, 0x27, 0xf4,
-	0xb8, 0x79, 0x64, 0xdf, 0x21, 0x16, 0x98, 0xbd, 0x4e, 0xb3, 0xdb, 0x7b, 0x79, 0xd2, 0xb7, 0x8d,
-	0xed, 0x5d, 0xa8, 0x2c, 0xd2, 0x89, 0x14, 0x21, 0x7f, 0xda, 0xe9, 0xb5, 0xfb, 0xf6, 0x1d, 0x15,
-	0x76, 0x7a, 0xd0, 0xe9, 0x7f, 0xf9, 0xd8, 0x36, 0x94, 0xf9, 0xf9, 0xeb, 0x7e, 0xbb, 0x67, 0x67,
-	0xb6, 0x7f, 0x31, 0x00, 0xe6, 0x58, 0x90, 0x12, 0xac, 0x9d, 0x76, 0x0e, 0x3b, 0x27, 0xdf, 0x75,
-	0x74, 0xc8, 0x71, 0xb3, 0xd7, 0x6f, 0x53, 0xdb, 0x50, 0x07, 0xb4, 0xdd, 0x3d, 0x3a, 0x68, 0x35,
-	0xed, 0x8c, 0x3a, 0xa0, 0xfb, 0x27, 0x9d, 0xa3, 0xd7, 0x76, 0x16, 0x73, 0x35, 0xfb, 0xad, 0x97,
-	0x5a, 0xec, 0x75, 0x9b, 0xb4, 0x6d, 0xe7, 0x88, 0x0d, 0x56, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x1c,
-	0xb7, 0x3b, 0xfd, 0xe6, 0x91, 0x9d, 0x57, 0x31, 0xcf, 0x9b, 0xad, 0xc3, 0xd3, 0xae, 0x5d, 0xd0,
-	0xc9, 0x7a, 0xfd, 0x13, 0xda, 0xb6, 0xd7, 0x94, 0xb2, 0x4f, 0x9b, 0x07, 0x9d, 0xf6, 0xbe, 0x6d,
-	0x56, 0x33, 0xb6, 0xf1, 0x7c, 0x0f, 0xd6, 0xfd, 0xb0, 0x31, 0xf5, 0x25, 0x13, 0x42, 0xff, 0xdd,
-	0xfa, 0xe1, 0x61, 0xa4, 0xf9, 0xe1, 0x8e, 0x96, 0x76, 0x2e, 0xc2, 0x9d, 0xa9, 0xdc, 0xc1, 0xd3,
-	0x9d, 0xf8, 0x52, 0xcf, 0x0a, 0xa8, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0x2b,
-	0xc1, 0xc6, 0x0d, 0x00, 0x00,
+	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0xe9, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0xae, 0x8a,
+	0xa0, 0x86, 0x83, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x6c, 0xa5, 0x71, 0x6c, 0xcb,
+	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5c, 0x3b, 0x84, 0x29, 0x52, 0xe1, 0xae, 0x04, 0xa8,
+	0x6f, 0x50, 0xf4, 0xd0, 0x9e, 0x7b, 0xe9, 0xb9, 0xef, 0xd3, 0x17, 0x68, 0x9f, 0xa4, 0xd8, 0x59,
+	0x92, 0xa2, 0xa4, 0xc4, 0x75, 0x0a, 0xdf, 0x66, 0x66, 0x67, 0x86, 0x33, 0xb3, 0xbf, 0xdf, 0xac,
+	0x04, 0x35, 0x11, 0x8e, 0x42, 0xd7, 0x11, 0x4e, 0x73, 0x14, 0x85, 0x22, 0x24, 0x7a, 0xa2, 0xd7,
+	0x8d, 0x89, 0x10, 0xde, 0x90, 0x29, 0x7b, 0x63, 0x17, 0xf4, 0x23, 0x36, 0xa5, 0x4e, 0x70, 0xc9,
+	0xc8, 0x3a, 0x14, 0xb9, 0x70, 0x22, 0x61, 0x69, 0x9b, 0xda, 0x96, 0x41, 0x95, 0x42, 0x4c, 0xc8,
+	0xb3, 0xc0, 0xb5, 0x72, 0x68, 0x93, 0x62, 0xe3, 0x11, 0x54, 0xfa, 0xce, 0xb9, 0xcf, 0x44, 0xcb,
+	0xf7, 0x1c, 0x4e, 0x08, 0x14, 0x06, 0xcc, 0xf7, 0x31, 0xaa, 0x4c, 0x51, 0x96, 0x41, 0x63, 0x4f,
+	0x05, 0x55, 0xa9, 0x14, 0x1b, 0xbf, 0x17, 0xa1, 0xa4, 0xa2, 0xc8, 0x43, 0x28, 0x3a, 0x32, 0x12,
+	0x23, 0x2a, 0xbb, 0xf7, 0x9a, 0x69, 0xad, 0x99, 0xb4, 0x54, 0xf9, 0x90, 0x3a, 0xe8, 0x6f, 0x42,
+	0x2e, 0x02, 0x67, 0xc8, 0x30, 0x5d, 0x99, 0xa6, 0x3a, 0xd9, 0x03, 0x7d, 0x14, 0x46, 0xc2, 0x1e,
+	0x3a, 0x23, 0xab, 0xb0, 0x99, 0xdf, 0xaa, 0xec, 0xde, 0x5f, 0xcc, 0xd5, 0xec, 0x86, 0x91, 0x38,
+	0x71, 0x46, 0xed, 0x40, 0x44, 0x53, 0xba, 0x32, 0x52, 0x9a, 0xcc, 0x7a, 0xc5, 0xa6, 0x7c, 0xe4,
+	0x0c, 0x98, 0x55, 0x54, 0x59, 0x13, 0x1d, 0xc7, 0xf0, 0xc6, 0x89, 0x5c, 0xab, 0x84, 0x07, 0x4a,
+	0x21, 0x3b, 0x50, 0xbe, 0x62, 0x53, 0x3b, 0x92, 0x93, 0xb2, 0x56, 0xb0, 0x70, 0x32, 0xfb, 0x58,
+	0x32, 0x43, 0x4c, 0x83, 0x12, 0xd9, 0x82, 0x82, 0x98, 0x8e, 0x98, 0xa5, 0x6f, 0x6a, 0x5b, 0xb5,
+	0xdd, 0xf5, 0xc5, 0xc2, 0xfa, 0xd3, 0x11, 0xa3, 0xe8, 0x41, 0xb6, 0xc0, 0x74, 0xcf, 0x6d, 0xd9,
+	0x91, 0x1d, 0x4e, 0x58, 0x14, 0x79, 0x2e, 0xb3, 0xca, 0xf8, 0xed, 0x9a, 0x7b, 0xde, 0x71, 0x86,
+	0xec, 0x34, 0xb6, 0x92, 0x26, 0x14, 0x84, 0x73, 0xc9, 0x2d, 0xc0, 0x66, 0xeb, 0x4b, 0xcd, 0xf6,
+	0x9d, 0x4b, 0xae, 0x3a, 0x45, 0x3f, 0xf2, 0x00, 0x6a, 0xc3, 0x29, 0x7f, 0xeb, 0xdb, 0xe9, 0x08,
+	0x0d, 0xcc, 0x5b, 0x45, 0xeb, 0xcb, 0x64, 0x8e, 0xf7, 0x01, 0x94, 0x9b, 0x1c, 0x8f, 0x55, 0xdd,
+	0xd4, 0xb6, 0x8a, 0xb4, 0x8c, 0x16, 0x39, 0x3d, 0xd2, 0x82, 0x8d, 0xa1, 0xc3, 0x05, 0x8b, 0x6c,
+	0xc1, 0xa2, 0xa1, 0x8d, 0xb0, 0xb0, 0x25, 0x86, 0xac, 0x1a, 0xce, 0xc1, 0x68, 0xc6, 0x90, 0xea,
+	0x7b, 0x43, 0x46, 0xef, 0x2a, 0xdf, 0x3e, 0x8b, 0x86, 0x3d, 0xe9, 0x29, 0x8d, 0xe4, 0x63, 0x30,
+	0xe2, 0x14, 0x2e, 0xf3, 0x9d, 0xa9, 0xb5, 0x8a, 0xdf, 0xa8, 0x28, 0xdb, 0x81, 0x34, 0xd5, 0x9f,
+	0x82, 0x91, 0xbd, 0x2b, 0x09, 0xa1, 0x2b, 0x36, 0x8d, 0x51, 0x25, 0x45, 0x79, 0x31, 0x13, 0xc7,
+	0x1f, 0x2b, 0x1c, 0x14, 0xa9, 0x52, 0x9e, 0xe6, 0xf6, 0xb4, 0xfa, 0x57, 0x50, 0x4e, 0x5b, 0xff,
+	0xb7, 0xc0, 0x72, 0x26, 0xf0, 0x55, 0x41, 0xcf, 0x9b, 0x85, 0x57, 0x05, 0xbd, 0x62, 0x1a, 0x8d,
+	0x3f, 0x4b, 0x50, 0xec, 0xe1, 0x5d, 0xef, 0xa5, 0xd5, 0xde, 0x00, 0xa7, 0x71, 0x13, 0xa8, 0x5c,
+	0x33, 0x2a, 0xfd, 0xa6, 0xa3, 0x9a, 0x03, 0x5a, 0xee, 0x06, 0x40, 0xfb, 0x06, 0x0c, 0xce, 0xa2,
+	0x09, 0x73, 0x6d, 0x89, 0x26, 0x6e, 0xe5, 0x17, 0xc1, 0x81, 0x4d, 0x35, 0x7b, 0xe8, 0x83, 0xb0,
+	0xab, 0xf0, 0x54, 0xe6, 0xe4, 0x19, 0x54, 0x79, 0x38, 0x8e, 0x06, 0xcc, 0x46, 0xa0, 0xf3, 0x98,
+	0x49, 0xff, 0x5b, 0x8a, 0x47, 0x27, 0x94, 0xa9, 0xc1, 0x67, 0x0a, 0x27, 0x2f, 0x60, 0x55, 0xe0,
+	0x40, 0xec, 0x41, 0x18, 0x88, 0x28, 0xf4, 0xb9, 0x55, 0x5a, 0x64, 0xa3, 0xca, 0xa1, 0xe6, 0xb6,
+	0xaf, 0xbc, 0x68, 0x4d, 0x64, 0x55, 0x4e, 0xb6, 0x61, 0xcd, 0xe3, 0x76, 0x3c, 0x3f, 0x59, 0xa2,
+	0x17, 0x5c, 0x22, 0xd5, 0x74, 0xba, 0xea, 0xf1, 0x13, 0xb4, 0xf7, 0x94, 0xb9, 0xfe, 0x1a, 0x60,
+	0xd6, 0x10, 0x79, 0x02, 0x95, 0xb8, 0x02, 0xa4, 0x9c, 0x76, 0x0d, 0xe5, 0x40, 0xa4, 0xb2, 0xc4,
+	0x85, 0xdc, 0x56, 0xdc, 0xca, 0x6d, 0xe6, 0x25, 0x2e, 0x50, 0xa9, 0xff, 0xa6, 0x41, 0x25, 0xd3,
+	0x6c, 0xb2, 0xcb, 0xb4, 0x74, 0x97, 0xcd, 0x6d, 0x8f, 0xdc, 0xfb, 0xb6, 0x47, 0xfe, 0xbd, 0xdb,
+	0xa3, 0x70, 0x83, 0x4b, 0xdd, 0x80, 0x12, 0x16, 0xca, 0xad, 0x22, 0xd6, 0x16, 0x6b, 0xf5, 0x3f,
+	0x34, 0xa8, 0xce, 0x4d, 0xf1, 0x56, 0x7b, 0x27, 0x9f, 0x01, 0x39, 0xf7, 0x9d, 0xc1, 0x95, 0xef,
+	0x71, 0x21, 0x01, 0xa5, 0x4a, 0x28, 0xa0, 0xcb, 0x5a, 0xe6, 0x04, 0x93, 0x72, 0x59, 0xe5, 0x45,
+	0x14, 0xfe, 0xc8, 0x02, 0x5c, 0xa2, 0x3a, 0x8d, 0xb5, 0x94, 0x56, 0x45, 0xb3, 0xd4, 0xf8, 0xa9,
+	0x80, 0x4f, 0x8c, 0x9a, 0xce, 0xe7, 0xb0, 0x8e, 0x03, 0xf1, 0x82, 0x4b, 0x7b, 0x10, 0xfa, 0xe3,
+	0x61, 0x80, 0x7b, 0x2f, 0x26, 0x2b, 0x49, 0xce, 0xf6, 0xf1, 0x48, 0xae, 0x3e, 0xf2, 0x6a, 0x39,
+	0x02, 0xfb, 0xcc, 0x61, 0x9f, 0xd6, 0xdc, 0x10, 0xf1, 0x1b, 0x87, 0x0a, 0xe3, 0x0b, 0xb9, 0xb0,
+	0xe7, 0x67, 0x29, 0x53, 0x2e, 0xa2, 0x70, 0xc8, 0x97, 0xdf, 0x8c, 0x24, 0x47, 0x4c, 0x96, 0x17,
+	0x51, 0x38, 0x4c, 0xc8, 0x22, 0x65, 0x4e, 0xbe, 0x86, 0x6a, 0x72, 0xd3, 0xaa, 0x8c, 0x22, 0x96,
+	0xb1, 0xb1, 0x9c, 0x02, 0x8b, 0x30, 0xae, 0x32, 0x1a, 0xf9, 0x04, 0xaa, 0xe7, 0x0e, 0x67, 0x76,
+	0x8a, 0x1d, 0xf5, 0xc0, 0x18, 0xd2, 0x98, 0x4e, 0xe8, 0x0b, 0xa8, 0xf2, 0xc0, 0x19, 0xf1, 0x37,
+	0x61, 0xbc, 0x38, 0x56, 0xde, 0xb1, 0x38, 0x8c, 0xc4, 0x45, 0x6a, 0xe4, 0x21, 0xac, 0xb9, 0xe3,
+	0xc8, 0x39, 0xf7, 0x7c, 0x4f, 0x4c, 0xed, 0x51, 0xe8, 0x7b, 0x83, 0x29, 0xee, 0x9b, 0x32, 0x35,
+	0x67, 0x07, 0x5d, 0xb4, 0xd7, 0xc7, 0x09, 0x71, 0x64, 0x43, 0xb7, 0x0b, 0x9e, 0x2c, 0x2d, 0xf2,
+	0xf3, 0xb4, 0x50, 0x88, 0x68, 0xfc, 0xac, 0x81, 0xa9, 0x36, 0x08, 0x1b, 0xf9, 0xde, 0xc0, 0x11,
+	0x5e, 0x18, 0x90, 0x27, 0x50, 0x0c, 0x42, 0x97, 0xc9, 0x35, 0x2b, 0xaf, 0xe3, 0xa3, 0x85, 0xa5,
+	0x91, 0x71, 0x6d, 0x76, 0x42, 0x97, 0x51, 0xe5, 0x5d, 0x7f, 0x06, 0x05, 0xa9, 0xca, 0x65, 0x1d,
+	0xb7, 0x70, 0x93, 0x65, 0x2d, 0x66, 0x4a, 0xe3, 0x0c, 0x6a, 0xf1, 0x17, 0x2e, 0x58, 0xc4, 0x82,
+	0x01, 0x93, 0x3f, 0x65, 0x32, 0x70, 0x44, 0xf9, 0x83, 0xf7, 0x71, 0xe3, 0x17, 0x0d, 0x08, 0xe6,
+	0x9d, 0xe7, 0xe9, 0x6d, 0xe4, 0x26, 0x8f, 0x61, 0xe3, 0xed, 0x98, 0x45, 0x53, 0xb5, 0x1e, 0x07,
+	0xcc, 0x76, 0x3d, 0x2e, 0xbf, 0xa2, 0xd6, 0x8d, 0x4e, 0xd7, 0xf1, 0xb4, 0xa7, 0x0e, 0x0f, 0xe2,
+	0xb3, 0xc6, 0xdf, 0x05, 0xa8, 0xf4, 0xa2, 0x49, 0x8a, 0xb1, 0x6f, 0x01, 0x46, 0x4e, 0x24, 0x3c,
+	0x39, 0xd3, 0x64, 0xec, 0x9f, 0x66, 0xc6, 0x3e, 0x73, 0x4d, 0xe1, 0xdc, 0x4d, 0xfc, 0x69, 0x26,
+	0xf4, 0xbd, 0x74, 0xce, 0x7d, 0x30, 0x9d, 0xf3, 0xff, 0x81, 0xce, 0x2d, 0xa8, 0x64, 0xe8, 0x1c,
+	0xb3, 0x79, 0xf3, 0xdd, 0x7d, 0x64, 0x08, 0x0d, 0x33, 0x42, 0xd7, 0xff, 0xd2, 0x60, 0x6d, 0xa9,
+	0x45, 0xc9, 0x8a, 0xcc, 0x8b, 0x7a, 0x3d, 0x2b, 0x66, 0x4f, 0x29, 0xd9, 0x07, 0x13, 0xab, 0xb4,
+	0xa3, 0x04, 0x50, 0x8a, 0x20, 0x95, 0x6c, 0x5f, 0xf3, 0x88, 0xa3, 0xab, 0x7c, 0x4e, 0xe7, 0xa4,
+	0x0b, 0xf7, 0x54, 0x92, 0xc5, 0x27, 0x55, 0x3d, 0xeb, 0xff, 0x5f, 0xc8, 0x34, 0xff, 0xa2, 0xde,
+	0xe5, 0x4b, 0x36, 0x5e, 0xb7, 0x6f, 0x83, 0xf1, 0xd7, 0x3c, 0x79, 0xf1, 0x9e, 0x3f, 0x02, 0x7d,
+	0x9f, 0xf9, 0xfe, 0x61, 0x70, 0x11, 0xca, 0xdf, 0x9d, 0x38, 0x97, 0xc8, 0x76, 0x5c, 0x37, 0x62,
+	0x9c, 0xc7, 0xa8, 0xaf, 0x2a, 0x6b, 0x4b, 0x19, 0x25, 0x25, 0xa2, 0x30, 0x14, 0x71, 0x42, 0x94,
+	0xe3, 0x45, 0xd1, 0x00, 0x90, 0xc9, 0xb8, 0xfa, 0x55, 0xf5, 0xce, 0x75, 0xb3, 0xbd, 0x05, 0x46,
+	0x76, 0xd9, 0x12, 0x80, 0x52, 0xe7, 0x94, 0x9e, 0xb4, 0x8e, 0xcd, 0x3b, 0xc4, 0x00, 0xbd, 0xd7,
+	0x69, 0x75, 0x7b, 0x2f, 0x4f, 0xfb, 0xa6, 0xb6, 0xbd, 0x0b, 0xb5, 0x79, 0x38, 0x91, 0x32, 0x14,
+	0xcf, 0x3a, 0xbd, 0x76, 0xdf, 0xbc, 0x23, 0xc3, 0xce, 0x0e, 0x3b, 0xfd, 0x2f, 0x1f, 0x9b, 0x9a,
+	0x34, 0x3f, 0x7f, 0xdd, 0x6f, 0xf7, 0xcc, 0xdc, 0xf6, 0xaf, 0x1a, 0xc0, 0x6c, 0x16, 0xa4, 0x02,
+	0x2b, 0x67, 0x9d, 0xa3, 0xce, 0xe9, 0x77, 0x1d, 0x15, 0x72, 0xd2, 0xea, 0xf5, 0xdb, 0xd4, 0xd4,
+	0xe4, 0x01, 0x6d, 0x77, 0x8f, 0x0f, 0xf7, 0x5b, 0x66, 0x4e, 0x1e, 0xd0, 0x83, 0xd3, 0xce, 0xf1,
+	0x6b, 0x33, 0x8f, 0xb9, 0x5a, 0xfd, 0xfd, 0x97, 0x4a, 0xec, 0x75, 0x5b, 0xb4, 0x6d, 0x16, 0x88,
+	0x09, 0x46, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x9e, 0xb4, 0x3b, 0xfd, 0xd6, 0xb1, 0x59, 0x94, 0x31,
+	0xcf, 0x5b, 0xfb, 0x47, 0x67, 0x5d, 0xb3, 0xa4, 0x92, 0xf5, 0xfa, 0xa7, 0xb4, 0x6d, 0xae, 0x48,
+	0xe5, 0x80, 0xb6, 0x0e, 0x3b, 0xed, 0x03, 0x53, 0xaf, 0xe7, 0x4c, 0xed, 0xf9, 0x1e, 0xac, 0x7a,
+	0x61, 0x73, 0xe2, 0x09, 0xc6, 0xb9, 0xfa, 0xfb, 0xf6, 0xc3, 0x83, 0x58, 0xf3, 0xc2, 0x1d, 0x25,
+	0xed, 0x5c, 0x86, 0x3b, 0x13, 0xb1, 0x83, 0xa7, 0x3b, 0xc9, 0xa5, 0x9e, 0x97, 0x50, 0x7f, 0xf4,
+	0xcf, 0x00, 0xd1, 0xec, 0x26, 0x1a, 0x16, 0x0e, 0x00, 0x00,
 }
@@ -0,0 +1,1167 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: topoproxydata.proto
+
+package topoproxydata
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DirEntry_Type int32
+
+const (
+	DirEntry_DIRECTORY DirEntry_Type = 0
+	DirEntry_FILE      DirEntry_Type = 1
+)
+
+var DirEntry_Type_name = map[int32]string{
+	0: "DIRECTORY",
+	1: "FILE",
+}
+
+var DirEntry_Type_value = map[string]int32{
+	"DIRECTORY": 0,
+	"FILE":      1,
+}
+
+func (x DirEntry_Type) String() string {
+	return proto.EnumName(DirEntry_Type_name, int32(x))
+}
+
+func (DirEntry_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{1, 0}
+}
+
+// ListDirRequest is the payload for the ListDir RPC.
+type ListDirRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	DirPath              string   `protobuf:"bytes,2,opt,name=dir_path,json=dirPath,proto3" json:"dir_path,omitempty"`
+	Full                 bool     `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDirRequest) Reset()         { *m = ListDirRequest{} }
+func (m *ListDirRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDirRequest) ProtoMessage()    {}
+func (*ListDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{0}
+}
+
+func (m *ListDirRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDirRequest.Unmarshal(m, b)
+}
+func (m *ListDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDirRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDirRequest.Merge(m, src)
+}
+func (m *ListDirRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDirRequest.Size(m)
+}
+func (m *ListDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDirRequest proto.InternalMessageInfo
+
+func (m *ListDirRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *ListDirRequest) GetDirPath() string {
+	if m != nil {
+		return m.DirPath
+	}
+	return ""
+}
+
+func (m *ListDirRequest) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+// DirEntry is an entry returned by ListDir.
+type DirEntry struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 DirEntry_Type `protobuf:"varint,2,opt,name=type,proto3,enum=topoproxydata.DirEntry_Type" json:"type,omitempty"`
+	Ephemeral            bool          `protobuf:"varint,3,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DirEntry) Reset()         { *m = DirEntry{} }
+func (m *DirEntry) String() string { return proto.CompactTextString(m) }
+func (*DirEntry) ProtoMessage()    {}
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{1}
+}
+
+func (m *DirEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirEntry.Unmarshal(m, b)
+}
+func (m *DirEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirEntry.Marshal(b, m, deterministic)
+}
+func (m *DirEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirEntry.Merge(m, src)
+}
+func (m *DirEntry) XXX_Size() int {
+	return xxx_messageInfo_DirEntry.Size(m)
+}
+func (m *DirEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirEntry proto.InternalMessageInfo
+
+func (m *DirEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DirEntry) GetType() DirEntry_Type {
+	if m != nil {
+		return m.Type
+	}
+	return DirEntry_DIRECTORY
+}
+
+func (m *DirEntry) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+// ListDirResponse is returned by the ListDir RPC.
+type ListDirResponse struct {
+	Entries              []*DirEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListDirResponse) Reset()         { *m = ListDirResponse{} }
+func (m *ListDirResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDirResponse) ProtoMessage()    {}
+func (*ListDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{2}
+}
+
+func (m *ListDirResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDirResponse.Unmarshal(m, b)
+}
+func (m *ListDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDirResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDirResponse.Merge(m, src)
+}
+func (m *ListDirResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDirResponse.Size(m)
+}
+func (m *ListDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDirResponse proto.InternalMessageInfo
+
+func (m *ListDirResponse) GetEntries() []*DirEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// CreateRequest is the payload for the Create RPC.
+type CreateRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Contents             []byte   `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
+func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRequest) ProtoMessage()    {}
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{3}
+}
+
+func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+}
+func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequest.Merge(m, src)
+}
+func (m *CreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRequest.Size(m)
+}
+func (m *CreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+
+func (m *CreateRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+// CreateResponse is returned by the Create RPC.
+type CreateResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
+func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateResponse) ProtoMessage()    {}
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{4}
+}
+
+func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
+}
+func (m *CreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateResponse.Merge(m, src)
+}
+func (m *CreateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateResponse.Size(m)
+}
+func (m *CreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateResponse proto.InternalMessageInfo
+
+func (m *CreateResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// UpdateRequest is the payload for the Update RPC.
+type UpdateRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Contents             []byte   `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	Version              string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{5}
+}
+
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
+}
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(m, src)
+}
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
+}
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
+
+func (m *UpdateRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// UpdateResponse is returned by the Update RPC.
+type UpdateResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
+func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateResponse) ProtoMessage()    {}
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{6}
+}
+
+func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
+}
+func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResponse.Merge(m, src)
+}
+func (m *UpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateResponse.Size(m)
+}
+func (m *UpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
+
+func (m *UpdateResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// GetRequest is the payload for the Get RPC.
+type GetRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{7}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+// GetResponse is returned by the Get RPC.
+type GetResponse struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{8}
+}
+
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResponse.Size(m)
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *GetResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// DeleteRequest is the payload for the Delete RPC.
+type DeleteRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{9}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// DeleteResponse is returned by the Delete RPC.
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{10}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+// LockRequest is the payload for the Lock RPC.
+type LockRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	DirPath              string   `protobuf:"bytes,2,opt,name=dir_path,json=dirPath,proto3" json:"dir_path,omitempty"`
+	Contents             string   `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{11}
+}
+
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockRequest.Unmarshal(m, b)
+}
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+}
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
+}
+func (m *LockRequest) XXX_Size() int {
+	return xxx_messageInfo_LockRequest.Size(m)
+}
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+
+func (m *LockRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *LockRequest) GetDirPath() string {
+	if m != nil {
+		return m.DirPath
+	}
+	return ""
+}
+
+func (m *LockRequest) GetContents() string {
+	if m != nil {
+		return m.Contents
+	}
+	return ""
+}
+
+// LockResponse is streamed by the Lock RPC once the lock is taken.
+// The lock is released by Unlock, or when the stream is closed.
+type LockResponse struct {
+	LockId               string   `protobuf:"bytes,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockResponse) Reset()         { *m = LockResponse{} }
+func (m *LockResponse) String() string { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()    {}
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{12}
+}
+
+func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockResponse.Unmarshal(m, b)
+}
+func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+}
+func (m *LockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockResponse.Merge(m, src)
+}
+func (m *LockResponse) XXX_Size() int {
+	return xxx_messageInfo_LockResponse.Size(m)
+}
+func (m *LockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+
+func (m *LockResponse) GetLockId() string {
+	if m != nil {
+		return m.LockId
+	}
+	return ""
+}
+
+// CheckLockRequest is the payload for the CheckLock RPC.
+type CheckLockRequest struct {
+	LockId               string   `protobuf:"bytes,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckLockRequest) Reset()         { *m = CheckLockRequest{} }
+func (m *CheckLockRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckLockRequest) ProtoMessage()    {}
+func (*CheckLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{13}
+}
+
+func (m *CheckLockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckLockRequest.Unmarshal(m, b)
+}
+func (m *CheckLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckLockRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckLockRequest.Merge(m, src)
+}
+func (m *CheckLockRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckLockRequest.Size(m)
+}
+func (m *CheckLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckLockRequest proto.InternalMessageInfo
+
+func (m *CheckLockRequest) GetLockId() string {
+	if m != nil {
+		return m.LockId
+	}
+	return ""
+}
+
+// CheckLockResponse is returned by the CheckLock RPC.
+type CheckLockResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckLockResponse) Reset()         { *m = CheckLockResponse{} }
+func (m *CheckLockResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckLockResponse) ProtoMessage()    {}
+func (*CheckLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{14}
+}
+
+func (m *CheckLockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckLockResponse.Unmarshal(m, b)
+}
+func (m *CheckLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckLockResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckLockResponse.Merge(m, src)
+}
+func (m *CheckLockResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckLockResponse.Size(m)
+}
+func (m *CheckLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckLockResponse proto.InternalMessageInfo
+
+// UnlockRequest is the payload for the Unlock RPC.
+type UnlockRequest struct {
+	LockId               string   `protobuf:"bytes,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
+func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockRequest) ProtoMessage()    {}
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{15}
+}
+
+func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockRequest.Unmarshal(m, b)
+}
+func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRequest.Merge(m, src)
+}
+func (m *UnlockRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockRequest.Size(m)
+}
+func (m *UnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
+
+func (m *UnlockRequest) GetLockId() string {
+	if m != nil {
+		return m.LockId
+	}
+	return ""
+}
+
+// UnlockResponse is returned by the Unlock RPC.
+type UnlockResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
+func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockResponse) ProtoMessage()    {}
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{16}
+}
+
+func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockResponse.Unmarshal(m, b)
+}
+func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockResponse.Merge(m, src)
+}
+func (m *UnlockResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockResponse.Size(m)
+}
+func (m *UnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
+
+// WatchRequest is the payload for the Watch RPC.
+type WatchRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{17}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+// WatchResponse is streamed by the Watch RPC. The first one has the
+// current value of the file, the next ones its new values.
+type WatchResponse struct {
+	Contents             []byte   `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{18}
+}
+
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchResponse.Unmarshal(m, b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchResponse.Size(m)
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+func (m *WatchResponse) GetContents() []byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *WatchResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// WaitForMastershipRequest is the payload for the WaitForMastership RPC.
+type WaitForMastershipRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitForMastershipRequest) Reset()         { *m = WaitForMastershipRequest{} }
+func (m *WaitForMastershipRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitForMastershipRequest) ProtoMessage()    {}
+func (*WaitForMastershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{19}
+}
+
+func (m *WaitForMastershipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForMastershipRequest.Unmarshal(m, b)
+}
+func (m *WaitForMastershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForMastershipRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitForMastershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForMastershipRequest.Merge(m, src)
+}
+func (m *WaitForMastershipRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitForMastershipRequest.Size(m)
+}
+func (m *WaitForMastershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForMastershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForMastershipRequest proto.InternalMessageInfo
+
+func (m *WaitForMastershipRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *WaitForMastershipRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WaitForMastershipRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// WaitForMastershipResponse is streamed by the WaitForMastership RPC
+// once the caller is the master. The caller stays the master until
+// the stream is closed.
+type WaitForMastershipResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitForMastershipResponse) Reset()         { *m = WaitForMastershipResponse{} }
+func (m *WaitForMastershipResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitForMastershipResponse) ProtoMessage()    {}
+func (*WaitForMastershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{20}
+}
+
+func (m *WaitForMastershipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitForMastershipResponse.Unmarshal(m, b)
+}
+func (m *WaitForMastershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitForMastershipResponse.Marshal(b, m, deterministic)
+}
+func (m *WaitForMastershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForMastershipResponse.Merge(m, src)
+}
+func (m *WaitForMastershipResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitForMastershipResponse.Size(m)
+}
+func (m *WaitForMastershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForMastershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForMastershipResponse proto.InternalMessageInfo
+
+// GetCurrentMasterIDRequest is the payload for the GetCurrentMasterID RPC.
+type GetCurrentMasterIDRequest struct {
+	Cell                 string   `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentMasterIDRequest) Reset()         { *m = GetCurrentMasterIDRequest{} }
+func (m *GetCurrentMasterIDRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentMasterIDRequest) ProtoMessage()    {}
+func (*GetCurrentMasterIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{21}
+}
+
+func (m *GetCurrentMasterIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Unmarshal(m, b)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentMasterIDRequest.Merge(m, src)
+}
+func (m *GetCurrentMasterIDRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentMasterIDRequest.Size(m)
+}
+func (m *GetCurrentMasterIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentMasterIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentMasterIDRequest proto.InternalMessageInfo
+
+func (m *GetCurrentMasterIDRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *GetCurrentMasterIDRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetCurrentMasterIDResponse is returned by the GetCurrentMasterID RPC.
+type GetCurrentMasterIDResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentMasterIDResponse) Reset()         { *m = GetCurrentMasterIDResponse{} }
+func (m *GetCurrentMasterIDResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentMasterIDResponse) ProtoMessage()    {}
+func (*GetCurrentMasterIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d70ce58f367cf8, []int{22}
+}
+
+func (m *GetCurrentMasterIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Unmarshal(m, b)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentMasterIDResponse.Merge(m, src)
+}
+func (m *GetCurrentMasterIDResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentMasterIDResponse.Size(m)
+}
+func (m *GetCurrentMasterIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentMasterIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentMasterIDResponse proto.InternalMessageInfo
+
+func (m *GetCurrentMasterIDResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("topoproxydata.DirEntry_Type", DirEntry_Type_name, DirEntry_Type_value)
+	proto.RegisterType((*ListDirRequest)(nil), "topoproxydata.ListDirRequest")
+	proto.RegisterType((*DirEntry)(nil), "topoproxydata.DirEntry")
+	proto.RegisterType((*ListDirResponse)(nil), "topoproxydata.ListDirResponse")
+	proto.RegisterType((*CreateRequest)(nil), "topoproxydata.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "topoproxydata.CreateResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "topoproxydata.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "topoproxydata.UpdateResponse")
+	proto.RegisterType((*GetRequest)(nil), "topoproxydata.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "topoproxydata.GetResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "topoproxydata.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "topoproxydata.DeleteResponse")
+	proto.RegisterType((*LockRequest)(nil), "topoproxydata.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "topoproxydata.LockResponse")
+	proto.RegisterType((*CheckLockRequest)(nil), "topoproxydata.CheckLockRequest")
+	proto.RegisterType((*CheckLockResponse)(nil), "topoproxydata.CheckLockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "topoproxydata.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "topoproxydata.UnlockResponse")
+	proto.RegisterType((*WatchRequest)(nil), "topoproxydata.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "topoproxydata.WatchResponse")
+	proto.RegisterType((*WaitForMastershipRequest)(nil), "topoproxydata.WaitForMastershipRequest")
+	proto.RegisterType((*WaitForMastershipResponse)(nil), "topoproxydata.WaitForMastershipResponse")
+	proto.RegisterType((*GetCurrentMasterIDRequest)(nil), "topoproxydata.GetCurrentMasterIDRequest")
+	proto.RegisterType((*GetCurrentMasterIDResponse)(nil), "topoproxydata.GetCurrentMasterIDResponse")
+}
+
+func init() { proto.RegisterFile("topoproxydata.proto", fileDescriptor_a0d70ce58f367cf8) }
+
+var fileDescriptor_a0d70ce58f367cf8 = []byte{
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xfd, 0x9c, 0x44, 0x8d, 0x33, 0x89, 0xfd, 0x05, 0xf7, 0x50, 0xa7, 0xad, 0x44, 0xb4, 0x17,
+	0xa2, 0x50, 0x25, 0x50, 0xce, 0x08, 0x09, 0x3b, 0xad, 0x22, 0x05, 0x81, 0x4c, 0xab, 0x42, 0x85,
+	0x54, 0x19, 0x7b, 0x8a, 0x57, 0x71, 0xbd, 0x66, 0xbd, 0x89, 0xc8, 0xaf, 0xe0, 0x2f, 0x23, 0x3b,
+	0xbb, 0xc5, 0x46, 0x4d, 0x81, 0x54, 0xdc, 0x66, 0x27, 0x6f, 0xde, 0x7b, 0x33, 0x13, 0x0f, 0xec,
+	0x0a, 0x96, 0xb2, 0x94, 0xb3, 0x6f, 0xab, 0xd0, 0x17, 0xfe, 0x28, 0xe5, 0x4c, 0x30, 0xcb, 0xa8,
+	0x24, 0xc9, 0x7b, 0x30, 0x67, 0x34, 0x13, 0x2e, 0xe5, 0x1e, 0x7e, 0x5d, 0x60, 0x26, 0x2c, 0x0b,
+	0x1a, 0x01, 0xc6, 0xb1, 0xad, 0xf5, 0xb5, 0x41, 0xcb, 0x2b, 0x62, 0xab, 0x07, 0x7a, 0x48, 0xf9,
+	0x55, 0xea, 0x8b, 0xc8, 0xae, 0x15, 0xf9, 0x66, 0x48, 0xf9, 0x3b, 0x5f, 0x44, 0x39, 0xfc, 0x7a,
+	0x11, 0xc7, 0x76, 0xbd, 0xaf, 0x0d, 0x74, 0xaf, 0x88, 0xc9, 0x77, 0x0d, 0x74, 0x97, 0xf2, 0x49,
+	0x22, 0xf8, 0x2a, 0x07, 0x24, 0xfe, 0x0d, 0x2a, 0xbe, 0x3c, 0xb6, 0x9e, 0x41, 0x43, 0xac, 0x52,
+	0x2c, 0xb8, 0xcc, 0xe3, 0xc3, 0x51, 0xd5, 0xa8, 0x2a, 0x1d, 0x9d, 0xad, 0x52, 0xf4, 0x0a, 0xa4,
+	0x75, 0x08, 0x2d, 0x4c, 0x23, 0xbc, 0x41, 0xee, 0x2b, 0xad, 0x9f, 0x09, 0xf2, 0x18, 0x1a, 0x39,
+	0xd6, 0x32, 0xa0, 0xe5, 0x4e, 0xbd, 0x89, 0x73, 0xf6, 0xd6, 0xfb, 0xd8, 0xfd, 0xcf, 0xd2, 0xa1,
+	0x71, 0x32, 0x9d, 0x4d, 0xba, 0x1a, 0x71, 0xe1, 0xff, 0xdb, 0x36, 0xb3, 0x94, 0x25, 0x19, 0x5a,
+	0xcf, 0xa1, 0x89, 0x89, 0xe0, 0x14, 0x33, 0x5b, 0xeb, 0xd7, 0x07, 0xed, 0xe3, 0xbd, 0x0d, 0x36,
+	0x3c, 0x85, 0x23, 0x9f, 0xc0, 0x70, 0x38, 0xfa, 0x02, 0xef, 0x9b, 0xd5, 0x01, 0xb4, 0xae, 0x69,
+	0x8c, 0xe5, 0x61, 0xe9, 0x79, 0xa2, 0x98, 0xd6, 0x3e, 0xe8, 0x01, 0x4b, 0x04, 0x26, 0x22, 0x2b,
+	0xba, 0xe8, 0x78, 0xb7, 0x6f, 0x32, 0x04, 0x53, 0xb1, 0x4b, 0x8b, 0x36, 0x34, 0x97, 0xc8, 0x33,
+	0xca, 0x12, 0xa9, 0xa0, 0x9e, 0x64, 0x09, 0xc6, 0x79, 0x1a, 0xfe, 0x23, 0x27, 0x65, 0xdd, 0x46,
+	0x55, 0x77, 0x08, 0xa6, 0xd2, 0xfd, 0xad, 0xc7, 0x97, 0x00, 0xa7, 0x28, 0xb6, 0x35, 0x48, 0x1c,
+	0x68, 0x17, 0xe5, 0x52, 0xa7, 0xec, 0x57, 0xdb, 0xec, 0xb7, 0x56, 0xf5, 0x70, 0x09, 0x86, 0x8b,
+	0x31, 0x3e, 0x60, 0x4e, 0x25, 0xee, 0x7a, 0x95, 0xbb, 0x0b, 0xa6, 0xe2, 0x5e, 0x7b, 0x24, 0x1f,
+	0xa0, 0x3d, 0x63, 0xc1, 0x7c, 0xcb, 0x2f, 0xe9, 0xd7, 0x8d, 0xb4, 0x4a, 0xff, 0x8d, 0x27, 0xd0,
+	0x59, 0x33, 0xcb, 0x69, 0xec, 0x41, 0x33, 0x66, 0xc1, 0xfc, 0x8a, 0x86, 0x92, 0x7d, 0x27, 0x7f,
+	0x4e, 0x43, 0xf2, 0x14, 0xba, 0x4e, 0x84, 0xc1, 0xbc, 0xec, 0x63, 0x23, 0x78, 0x17, 0x1e, 0x95,
+	0xc0, 0xb2, 0x89, 0x01, 0x18, 0xe7, 0x49, 0xfc, 0x27, 0xe5, 0x5d, 0x30, 0x15, 0x52, 0xd6, 0xbe,
+	0x82, 0xce, 0x85, 0x2f, 0x82, 0x68, 0xeb, 0xa5, 0x4f, 0xc0, 0x90, 0x04, 0x0f, 0x5a, 0xbb, 0x07,
+	0xf6, 0x85, 0x4f, 0xc5, 0x09, 0xe3, 0x6f, 0xfc, 0x4c, 0x20, 0xcf, 0x22, 0x9a, 0xde, 0xe7, 0x49,
+	0xdd, 0xa8, 0x5a, 0xe9, 0x46, 0x99, 0x50, 0xa3, 0xa1, 0x5c, 0x44, 0x8d, 0x86, 0xe4, 0x00, 0x7a,
+	0x77, 0x70, 0xca, 0xc6, 0x1d, 0xe8, 0x9d, 0xa2, 0x70, 0x16, 0x9c, 0x63, 0x22, 0xd6, 0xbf, 0x4f,
+	0xdd, 0xbf, 0x54, 0x24, 0x47, 0xb0, 0x7f, 0x17, 0x89, 0x9c, 0xc4, 0xda, 0x8f, 0xa6, 0xfc, 0xbc,
+	0x3e, 0xba, 0x1c, 0x2e, 0xa9, 0xc0, 0x2c, 0x1b, 0x51, 0x36, 0x5e, 0x47, 0xe3, 0x2f, 0x6c, 0xbc,
+	0x14, 0xe3, 0xe2, 0xd0, 0x8f, 0x2b, 0xc7, 0xec, 0xf3, 0x4e, 0x91, 0x7c, 0xf1, 0x63, 0x00, 0xb9,
+	0xe9, 0x07, 0x01, 0x14, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: topoproxyservice.proto
+
+package topoproxyservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	topoproxydata "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("topoproxyservice.proto", fileDescriptor_0b9859721f7c1ede) }
+
+var fileDescriptor_0b9859721f7c1ede = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x15, 0xb5, 0xd2, 0x3d, 0xe9, 0x0a, 0x82, 0xb5, 0x55, 0xf1, 0x62, 0x3d, 0xd8, 0x88,
+	0xde, 0x3d, 0x98, 0xd2, 0xa0, 0x54, 0x28, 0xa2, 0x14, 0xbc, 0xc5, 0x74, 0xb0, 0x6b, 0x4b, 0x66,
+	0xdd, 0x9d, 0x16, 0x7d, 0x6e, 0x5f, 0x40, 0x9a, 0x38, 0x21, 0xdd, 0x66, 0xd1, 0x5b, 0xf9, 0xff,
+	0xaf, 0xdf, 0xec, 0x0e, 0x59, 0xb1, 0x4f, 0xa8, 0x51, 0x1b, 0xfc, 0xfc, 0xb2, 0x60, 0xe6, 0x2a,
+	0x81, 0x8e, 0x36, 0x48, 0x28, 0x77, 0xdc, 0xbc, 0xb1, 0x57, 0x24, 0xa3, 0x98, 0xe2, 0x1c, 0xbb,
+	0xfa, 0xae, 0x89, 0xfa, 0x13, 0x6a, 0x1c, 0x2c, 0x72, 0x79, 0x2f, 0xb6, 0xfb, 0xca, 0x52, 0x57,
+	0x19, 0xd9, 0xea, 0x2c, 0xe3, 0xbf, 0xf9, 0x23, 0x7c, 0xcc, 0xc0, 0x52, 0xe3, 0xc8, 0x57, 0x5b,
+	0x8d, 0xa9, 0x85, 0xd3, 0x35, 0x19, 0x89, 0x5a, 0x68, 0x20, 0x26, 0x90, 0x4d, 0x87, 0xcd, 0x63,
+	0x36, 0xb5, 0x3c, 0x6d, 0x59, 0xf4, 0xac, 0x47, 0x55, 0xa2, 0x3c, 0xf6, 0x89, 0xb8, 0x2d, 0x44,
+	0x37, 0x62, 0x23, 0x02, 0x92, 0x07, 0x0e, 0x17, 0x01, 0xb1, 0xa2, 0x51, 0x55, 0x95, 0x0f, 0xd2,
+	0x85, 0x29, 0x54, 0x1c, 0x24, 0x8f, 0x7d, 0x07, 0xe1, 0xb6, 0x10, 0x85, 0x62, 0xb3, 0x8f, 0xc9,
+	0x44, 0xba, 0xe3, 0x16, 0x21, 0x4b, 0x0e, 0x2b, 0x3b, 0x56, 0x5c, 0xae, 0xcb, 0x81, 0xa8, 0x87,
+	0x63, 0x48, 0x26, 0x99, 0xe9, 0xd8, 0x5d, 0x22, 0x37, 0xac, 0x3b, 0xf1, 0x03, 0x4b, 0x8b, 0x4e,
+	0xa7, 0x0b, 0xdd, 0xca, 0xa2, 0xd3, 0x69, 0xc9, 0xd5, 0xf2, 0xb4, 0x85, 0xa8, 0x27, 0xb6, 0x86,
+	0x31, 0x25, 0x63, 0xe9, 0x5e, 0x22, 0x4b, 0x59, 0xd3, 0xac, 0x2e, 0x4b, 0x57, 0x7c, 0x17, 0xbb,
+	0xc3, 0x58, 0x51, 0x0f, 0xcd, 0x43, 0x6c, 0x09, 0x8c, 0x1d, 0x2b, 0x2d, 0xcf, 0x56, 0xfe, 0xe6,
+	0x10, 0xec, 0x6f, 0xff, 0x0d, 0x96, 0x66, 0x4d, 0x84, 0x8c, 0x80, 0xc2, 0x99, 0x31, 0x90, 0x52,
+	0xce, 0xdc, 0x75, 0x65, 0x7b, 0xf5, 0x83, 0x70, 0x10, 0x9e, 0x76, 0xfe, 0x0f, 0x92, 0xc7, 0xdd,
+	0x06, 0x2f, 0x17, 0x73, 0x45, 0x60, 0x6d, 0x47, 0x61, 0x90, 0xff, 0x0a, 0xde, 0x30, 0x98, 0x53,
+	0x90, 0xbd, 0xca, 0xc0, 0x7d, 0xbb, 0xaf, 0xb5, 0x2c, 0xbf, 0xfe, 0x19, 0x00, 0x9e, 0x9e, 0x2a,
+	0xc7, 0xee, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TopoProxyClient is the client API for TopoProxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TopoProxyClient interface {
+	// ListDir returns the entries in a directory.
+	ListDir(ctx context.Context, in *topoproxydata.ListDirRequest, opts ...grpc.CallOption) (*topoproxydata.ListDirResponse, error)
+	// Create creates the initial version of a file.
+	Create(ctx context.Context, in *topoproxydata.CreateRequest, opts ...grpc.CallOption) (*topoproxydata.CreateResponse, error)
+	// Update updates the contents of a file.
+	Update(ctx context.Context, in *topoproxydata.UpdateRequest, opts ...grpc.CallOption) (*topoproxydata.UpdateResponse, error)
+	// Get returns the contents and version of a file.
+	Get(ctx context.Context, in *topoproxydata.GetRequest, opts ...grpc.CallOption) (*topoproxydata.GetResponse, error)
+	// Delete deletes a file.
+	Delete(ctx context.Context, in *topoproxydata.DeleteRequest, opts ...grpc.CallOption) (*topoproxydata.DeleteResponse, error)
+	// Lock takes a lock on a directory. The lock is held until
+	// Unlock is called, or the stream is closed.
+	Lock(ctx context.Context, in *topoproxydata.LockRequest, opts ...grpc.CallOption) (TopoProxy_LockClient, error)
+	// CheckLock checks a lock taken by Lock is still held.
+	CheckLock(ctx context.Context, in *topoproxydata.CheckLockRequest, opts ...grpc.CallOption) (*topoproxydata.CheckLockResponse, error)
+	// Unlock releases a lock taken by Lock.
+	Unlock(ctx context.Context, in *topoproxydata.UnlockRequest, opts ...grpc.CallOption) (*topoproxydata.UnlockResponse, error)
+	// Watch streams the values of a file.
+	Watch(ctx context.Context, in *topoproxydata.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error)
+	// WaitForMastership waits until the caller is the master of an
+	// election. The caller stays the master until the stream is closed.
+	WaitForMastership(ctx context.Context, in *topoproxydata.WaitForMastershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForMastershipClient, error)
+	// GetCurrentMasterID returns the id of the master of an election.
+	GetCurrentMasterID(ctx context.Context, in *topoproxydata.GetCurrentMasterIDRequest, opts ...grpc.CallOption) (*topoproxydata.GetCurrentMasterIDResponse, error)
+}
+
+type topoProxyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTopoProxyClient(cc *grpc.ClientConn) TopoProxyClient {
+	return &topoProxyClient{cc}
+}
+
+func (c *topoProxyClient) ListDir(ctx context.Context, in *topoproxydata.ListDirRequest, opts ...grpc.CallOption) (*topoproxydata.ListDirResponse, error) {
+	out := new(topoproxydata.ListDirResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/ListDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Create(ctx context.Context, in *topoproxydata.CreateRequest, opts ...grpc.CallOption) (*topoproxydata.CreateResponse, error) {
+	out := new(topoproxydata.CreateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Update(ctx context.Context, in *topoproxydata.UpdateRequest, opts ...grpc.CallOption) (*topoproxydata.UpdateResponse, error) {
+	out := new(topoproxydata.UpdateResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Get(ctx context.Context, in *topoproxydata.GetRequest, opts ...grpc.CallOption) (*topoproxydata.GetResponse, error) {
+	out := new(topoproxydata.GetResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Delete(ctx context.Context, in *topoproxydata.DeleteRequest, opts ...grpc.CallOption) (*topoproxydata.DeleteResponse, error) {
+	out := new(topoproxydata.DeleteResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Lock(ctx context.Context, in *topoproxydata.LockRequest, opts ...grpc.CallOption) (TopoProxy_LockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[0], "/topoproxyservice.TopoProxy/Lock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyLockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_LockClient interface {
+	Recv() (*topoproxydata.LockResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyLockClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyLockClient) Recv() (*topoproxydata.LockResponse, error) {
+	m := new(topoproxydata.LockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) CheckLock(ctx context.Context, in *topoproxydata.CheckLockRequest, opts ...grpc.CallOption) (*topoproxydata.CheckLockResponse, error) {
+	out := new(topoproxydata.CheckLockResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/CheckLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Unlock(ctx context.Context, in *topoproxydata.UnlockRequest, opts ...grpc.CallOption) (*topoproxydata.UnlockResponse, error) {
+	out := new(topoproxydata.UnlockResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoProxyClient) Watch(ctx context.Context, in *topoproxydata.WatchRequest, opts ...grpc.CallOption) (TopoProxy_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[1], "/topoproxyservice.TopoProxy/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WatchClient interface {
+	Recv() (*topoproxydata.WatchResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWatchClient) Recv() (*topoproxydata.WatchResponse, error) {
+	m := new(topoproxydata.WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) WaitForMastership(ctx context.Context, in *topoproxydata.WaitForMastershipRequest, opts ...grpc.CallOption) (TopoProxy_WaitForMastershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TopoProxy_serviceDesc.Streams[2], "/topoproxyservice.TopoProxy/WaitForMastership", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &topoProxyWaitForMastershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TopoProxy_WaitForMastershipClient interface {
+	Recv() (*topoproxydata.WaitForMastershipResponse, error)
+	grpc.ClientStream
+}
+
+type topoProxyWaitForMastershipClient struct {
+	grpc.ClientStream
+}
+
+func (x *topoProxyWaitForMastershipClient) Recv() (*topoproxydata.WaitForMastershipResponse, error) {
+	m := new(topoproxydata.WaitForMastershipResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *topoProxyClient) GetCurrentMasterID(ctx context.Context, in *topoproxydata.GetCurrentMasterIDRequest, opts ...grpc.CallOption) (*topoproxydata.GetCurrentMasterIDResponse, error) {
+	out := new(topoproxydata.GetCurrentMasterIDResponse)
+	err := c.cc.Invoke(ctx, "/topoproxyservice.TopoProxy/GetCurrentMasterID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TopoProxyServer is the server API for TopoProxy service.
+type TopoProxyServer interface {
+	// ListDir returns the entries in a directory.
+	ListDir(context.Context, *topoproxydata.ListDirRequest) (*topoproxydata.ListDirResponse, error)
+	// Create creates the initial version of a file.
+	Create(context.Context, *topoproxydata.CreateRequest) (*topoproxydata.CreateResponse, error)
+	// Update updates the contents of a file.
+	Update(context.Context, *topoproxydata.UpdateRequest) (*topoproxydata.UpdateResponse, error)
+	// Get returns the contents and version of a file.
+	Get(context.Context, *topoproxydata.GetRequest) (*topoproxydata.GetResponse, error)
+	// Delete deletes a file.
+	Delete(context.Context, *topoproxydata.DeleteRequest) (*topoproxydata.DeleteResponse, error)
+	// Lock takes a lock on a directory. The lock is held until
+	// Unlock is called, or the stream is closed.
+	Lock(*topoproxydata.LockRequest, TopoProxy_LockServer) error
+	// CheckLock checks a lock taken by Lock is still held.
+	CheckLock(context.Context, *topoproxydata.CheckLockRequest) (*topoproxydata.CheckLockResponse, error)
+	// Unlock releases a lock taken by Lock.
+	Unlock(context.Context, *topoproxydata.UnlockRequest) (*topoproxydata.UnlockResponse, error)
+	// Watch streams the values of a file.
+	Watch(*topoproxydata.WatchRequest, TopoProxy_WatchServer) error
+	// WaitForMastership waits until the caller is the master of an
+	// election. The caller stays the master until the stream is closed.
+	WaitForMastership(*topoproxydata.WaitForMastershipRequest, TopoProxy_WaitForMastershipServer) error
+	// GetCurrentMasterID returns the id of the master of an election.
+	GetCurrentMasterID(context.Context, *topoproxydata.GetCurrentMasterIDRequest) (*topoproxydata.GetCurrentMasterIDResponse, error)
+}
+
+// UnimplementedTopoProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedTopoProxyServer struct {
+}
+
+func (*UnimplementedTopoProxyServer) ListDir(ctx context.Context, req *topoproxydata.ListDirRequest) (*topoproxydata.ListDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDir not implemented")
+}
+func (*UnimplementedTopoProxyServer) Create(ctx context.Context, req *topoproxydata.CreateRequest) (*topoproxydata.CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedTopoProxyServer) Update(ctx context.Context, req *topoproxydata.UpdateRequest) (*topoproxydata.UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedTopoProxyServer) Get(ctx context.Context, req *topoproxydata.GetRequest) (*topoproxydata.GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedTopoProxyServer) Delete(ctx context.Context, req *topoproxydata.DeleteRequest) (*topoproxydata.DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedTopoProxyServer) Lock(req *topoproxydata.LockRequest, srv TopoProxy_LockServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedTopoProxyServer) CheckLock(ctx context.Context, req *topoproxydata.CheckLockRequest) (*topoproxydata.CheckLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLock not implemented")
+}
+func (*UnimplementedTopoProxyServer) Unlock(ctx context.Context, req *topoproxydata.UnlockRequest) (*topoproxydata.UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedTopoProxyServer) Watch(req *topoproxydata.WatchRequest, srv TopoProxy_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedTopoProxyServer) WaitForMastership(req *topoproxydata.WaitForMastershipRequest, srv TopoProxy_WaitForMastershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForMastership not implemented")
+}
+func (*UnimplementedTopoProxyServer) GetCurrentMasterID(ctx context.Context, req *topoproxydata.GetCurrentMasterIDRequest) (*topoproxydata.GetCurrentMasterIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentMasterID not implemented")
+}
+
+func RegisterTopoProxyServer(s *grpc.Server, srv TopoProxyServer) {
+	s.RegisterService(&_TopoProxy_serviceDesc, srv)
+}
+
+func _TopoProxy_ListDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.ListDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).ListDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/ListDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).ListDir(ctx, req.(*topoproxydata.ListDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Create(ctx, req.(*topoproxydata.CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Update(ctx, req.(*topoproxydata.UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Get(ctx, req.(*topoproxydata.GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Delete(ctx, req.(*topoproxydata.DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Lock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxydata.LockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).Lock(m, &topoProxyLockServer{stream})
+}
+
+type TopoProxy_LockServer interface {
+	Send(*topoproxydata.LockResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyLockServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyLockServer) Send(m *topoproxydata.LockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_CheckLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.CheckLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).CheckLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/CheckLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).CheckLock(ctx, req.(*topoproxydata.CheckLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).Unlock(ctx, req.(*topoproxydata.UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoProxy_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxydata.WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).Watch(m, &topoProxyWatchServer{stream})
+}
+
+type TopoProxy_WatchServer interface {
+	Send(*topoproxydata.WatchResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWatchServer) Send(m *topoproxydata.WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_WaitForMastership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(topoproxydata.WaitForMastershipRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TopoProxyServer).WaitForMastership(m, &topoProxyWaitForMastershipServer{stream})
+}
+
+type TopoProxy_WaitForMastershipServer interface {
+	Send(*topoproxydata.WaitForMastershipResponse) error
+	grpc.ServerStream
+}
+
+type topoProxyWaitForMastershipServer struct {
+	grpc.ServerStream
+}
+
+func (x *topoProxyWaitForMastershipServer) Send(m *topoproxydata.WaitForMastershipResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TopoProxy_GetCurrentMasterID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(topoproxydata.GetCurrentMasterIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoProxyServer).GetCurrentMasterID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topoproxyservice.TopoProxy/GetCurrentMasterID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoProxyServer).GetCurrentMasterID(ctx, req.(*topoproxydata.GetCurrentMasterIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TopoProxy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topoproxyservice.TopoProxy",
+	HandlerType: (*TopoProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDir",
+			Handler:    _TopoProxy_ListDir_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _TopoProxy_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TopoProxy_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TopoProxy_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TopoProxy_Delete_Handler,
+		},
+		{
+			MethodName: "CheckLock",
+			Handler:    _TopoProxy_CheckLock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _TopoProxy_Unlock_Handler,
+		},
+		{
+			MethodName: "GetCurrentMasterID",
+			Handler:    _TopoProxy_GetCurrentMasterID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Lock",
+			Handler:       _TopoProxy_Lock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _TopoProxy_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForMastership",
+			Handler:       _TopoProxy_WaitForMastership_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "topoproxyservice.proto",
+}
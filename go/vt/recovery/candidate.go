@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recovery
+
+import (
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topotools"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// candidate is a replica that can be promoted.
+type candidate struct {
+	alias  string
+	tablet *topodatapb.Tablet
+	// rank is the preference of its cell, lower is better.
+	rank int
+}
+
+// ChooseCandidate returns the replica to promote to replace a failed
+// master. statuses has the replication status of the reachable
+// replicas, indexed by alias. The candidate is the REPLICA tablet
+// with the most advanced position that satisfies the durability
+// policy. If several tablets qualify, the first tablet in the
+// preferred cells is returned, then the first tablet in the cell of
+// the failed master.
+func ChooseCandidate(master *topodatapb.Tablet, tablets map[string]*topo.TabletInfo, statuses map[string]*replicationdatapb.Status, policy string, preferredCells []string) (*topodatapb.Tablet, error) {
+	if policy == "" {
+		policy = topotools.DurabilityNone
+	}
+	if err := topotools.ValidateDurabilityPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]mysql.Position, len(statuses))
+	for alias, status := range statuses {
+		pos, err := mysql.DecodePosition(status.Position)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode the position %v of %v: %v", status.Position, alias, err)
+		}
+		positions[alias] = pos
+	}
+
+	// The candidates must be at least as advanced as all the
+	// replicas, or EmergencyReparentShard would refuse them.
+	var candidates []*candidate
+	for alias, pos := range positions {
+		ti, ok := tablets[alias]
+		if !ok || ti.Type != topodatapb.TabletType_REPLICA || ti.MasterDelay > 0 {
+			continue
+		}
+		mostAdvanced := true
+		for _, other := range positions {
+			if !pos.AtLeast(other) {
+				mostAdvanced = false
+				break
+			}
+		}
+		if mostAdvanced {
+			candidates = append(candidates, &candidate{
+				alias:  alias,
+				tablet: ti.Tablet,
+				rank:   cellRank(ti.Alias.Cell, master.Alias.Cell, preferredCells),
+			})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no reachable REPLICA tablet is at least as advanced as all the other replicas")
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].rank != candidates[j].rank {
+			return candidates[i].rank < candidates[j].rank
+		}
+		return candidates[i].alias < candidates[j].alias
+	})
+
+	for _, c := range candidates {
+		if isDurable(c, tablets, statuses, policy) {
+			return c.tablet, nil
+		}
+	}
+	return nil, fmt.Errorf("no candidate satisfies the %v durability policy", policy)
+}
+
+// cellRank returns the preference of a cell, lower is better.
+func cellRank(cell, masterCell string, preferredCells []string) int {
+	for i, c := range preferredCells {
+		if c == cell {
+			return i
+		}
+	}
+	if cell == masterCell {
+		return len(preferredCells)
+	}
+	return len(preferredCells) + 1
+}
+
+// isDurable returns true if the shard satisfies the
+// durability policy once the candidate is promoted.
+func isDurable(c *candidate, tablets map[string]*topo.TabletInfo, statuses map[string]*replicationdatapb.Status, policy string) bool {
+	if policy == topotools.DurabilityNone {
+		return true
+	}
+	for alias := range statuses {
+		ti, ok := tablets[alias]
+		if alias == c.alias || !ok || ti.Type != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		if policy == topotools.DurabilitySemiSync || ti.Alias.Cell != c.tablet.Alias.Cell {
+			return true
+		}
+	}
+	return false
+}
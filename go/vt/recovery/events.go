@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// The decisions recorded in the event log.
+const (
+	// DecisionMasterFailed is recorded when a master fails a check.
+	DecisionMasterFailed = "MasterFailed"
+	// DecisionMasterHealthy is recorded when a master that
+	// failed some checks passes a check.
+	DecisionMasterHealthy = "MasterHealthy"
+	// DecisionNotConfirmed is recorded when the replicas
+	// don't confirm the failure of the master.
+	DecisionNotConfirmed = "NotConfirmed"
+	// DecisionSkipped is recorded when a confirmed failure
+	// can't be recovered.
+	DecisionSkipped = "Skipped"
+	// DecisionDryRun is recorded instead of DecisionRecover
+	// with -recovery_dry_run.
+	DecisionDryRun = "DryRun"
+	// DecisionRecover is recorded before running
+	// EmergencyReparentShard.
+	DecisionRecover = "Recover"
+	// DecisionRecovered is recorded when EmergencyReparentShard worked.
+	DecisionRecovered = "Recovered"
+	// DecisionRecoveryFailed is recorded when EmergencyReparentShard failed.
+	DecisionRecoveryFailed = "RecoveryFailed"
+)
+
+// Event is a decision of the daemon.
+type Event struct {
+	Time     time.Time
+	Keyspace string
+	Shard    string
+	// Master is the alias of the master the decision is about.
+	Master   string
+	Decision string
+	Message  string
+}
+
+// record adds an event to the event log, and logs it.
+func (d *Daemon) record(keyspace, shard, master, decision, format string, args ...interface{}) {
+	ev := &Event{
+		Time:     time.Now(),
+		Keyspace: keyspace,
+		Shard:    shard,
+		Master:   master,
+		Decision: decision,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	log.Infof("recovery daemon: %v/%v master %v: %v: %v", keyspace, shard, master, decision, ev.Message)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.events = append(d.events, ev)
+	if len(d.events) > eventLogSize {
+		d.events = d.events[len(d.events)-eventLogSize:]
+	}
+}
+
+// Events returns the events of the event log, the newest last.
+func (d *Daemon) Events() []*Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]*Event, len(d.events))
+	copy(result, d.events)
+	return result
+}
+
+// ServeHTTP returns the events of the event log as JSON.
+func (d *Daemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.MONITORING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	data, err := json.MarshalIndent(d.Events(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(data)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package recovery contains a daemon that detects failed masters, and
+replaces them by running EmergencyReparentShard.
+
+The daemon checks the master of every shard at a regular interval:
+  - A master fails a check if its vttablet can't be reached, or can't
+    return its replication position, as happens when its mysqld is down.
+  - After -recovery_failure_threshold consecutive failed checks, the
+    replicas of the shard are asked for their replication status. The
+    failure is only confirmed if a majority of the reachable replicas
+    are not replicating from the master either, so a network partition
+    between the daemon and the master doesn't trigger a recovery.
+  - The replica to promote is the most advanced REPLICA tablet that
+    keeps the shard durable after the promotion, as per the durability
+    policy of the keyspace, or -recovery_durability_policy if the
+    keyspace has none, preferring the cells in
+    -recovery_preferred_cells, then the cell of the failed master.
+
+Every decision is recorded in an event log. With -recovery_dry_run,
+the recoveries are only recorded.
+*/
+package recovery
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	checkInterval       = flag.Duration("recovery_check_interval", 5*time.Second, "how often the recovery daemon checks the masters")
+	failureThreshold    = flag.Int("recovery_failure_threshold", 3, "number of consecutive checks a master has to fail before the recovery daemon asks its replicas to confirm the failure")
+	rpcTimeout          = flag.Duration("recovery_rpc_timeout", 5*time.Second, "timeout of the RPCs the recovery daemon sends to the tablets to check them")
+	waitReplicasTimeout = flag.Duration("recovery_wait_replicas_timeout", 30*time.Second, "time to wait for the replicas to catch up when the recovery daemon runs EmergencyReparentShard")
+	cooldown            = flag.Duration("recovery_cooldown", 10*time.Minute, "minimum time between two recoveries of the same shard")
+	dryRun              = flag.Bool("recovery_dry_run", false, "if set, the recovery daemon only records the recoveries it would run")
+	durabilityPolicy    = flag.String("recovery_durability_policy", topotools.DurabilityNone, "which replicas the recovery daemon can promote in the keyspaces that have no durability policy: 'none' promotes any replica, 'semi_sync' needs another replica to acknowledge the writes of the new master, 'cross_cell' needs that replica to be in another cell")
+
+	preferredCells flagutil.StringListValue
+	keyspaces      flagutil.StringListValue
+
+	recoveries = stats.NewCountersWithMultiLabels("RecoveryDaemonRecoveries", "Recoveries run by the recovery daemon, per shard and result", []string{"Keyspace", "ShardName", "Result"})
+)
+
+func init() {
+	flag.Var(&preferredCells, "recovery_preferred_cells", "comma separated list of the cells the recovery daemon promotes replicas in, in order of preference")
+	flag.Var(&keyspaces, "recovery_keyspaces", "comma separated list of the keyspaces the recovery daemon checks. Defaults to all keyspaces.")
+}
+
+// eventLogSize is the number of events the daemon keeps.
+const eventLogSize = 1000
+
+// Reparenter runs EmergencyReparentShard. It is implemented by
+// wrangler.Wrangler.
+type Reparenter interface {
+	EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error
+}
+
+// Daemon checks the masters, and recovers the failed ones.
+type Daemon struct {
+	ts         *topo.Server
+	tmc        tmclient.TabletManagerClient
+	reparenter Reparenter
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// shards is indexed by keyspace/shard.
+	shards map[string]*shardState
+	// events has the most recent events, the newest last.
+	events []*Event
+}
+
+// shardState is what the daemon knows about a shard.
+type shardState struct {
+	// failures is the number of consecutive failed checks of the master.
+	failures int
+	// lastRecovery is the time of the last recovery.
+	lastRecovery time.Time
+}
+
+// NewDaemon returns a new Daemon.
+func NewDaemon(ts *topo.Server, tmc tmclient.TabletManagerClient, reparenter Reparenter) *Daemon {
+	return &Daemon{
+		ts:         ts,
+		tmc:        tmc,
+		reparenter: reparenter,
+		shards:     make(map[string]*shardState),
+	}
+}
+
+// Run checks the masters until ctx is done.
+func (d *Daemon) Run(ctx context.Context) {
+	log.Infof("recovery daemon started")
+	defer log.Infof("recovery daemon stopped")
+
+	ticker := time.NewTicker(*checkInterval)
+	defer ticker.Stop()
+	for {
+		d.CheckAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll checks the masters of all the shards once.
+func (d *Daemon) CheckAll(ctx context.Context) {
+	names := []string(keyspaces)
+	if len(names) == 0 {
+		var err error
+		names, err = d.ts.GetKeyspaces(ctx)
+		if err != nil {
+			log.Errorf("recovery daemon: GetKeyspaces failed: %v", err)
+			return
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for _, keyspace := range names {
+		shards, err := d.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			log.Errorf("recovery daemon: GetShardNames(%v) failed: %v", keyspace, err)
+			continue
+		}
+		for _, shard := range shards {
+			wg.Add(1)
+			go func(keyspace, shard string) {
+				defer wg.Done()
+				d.checkShard(ctx, keyspace, shard)
+			}(keyspace, shard)
+		}
+	}
+	wg.Wait()
+}
+
+func (d *Daemon) state(keyspace, shard string) *shardState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := keyspace + "/" + shard
+	s, ok := d.shards[key]
+	if !ok {
+		s = &shardState{}
+		d.shards[key] = s
+	}
+	return s
+}
+
+// checkShard checks the master of a shard, and recovers it if its
+// failure is confirmed. The shards are checked in parallel, but each
+// shard is only checked by one goroutine at a time.
+func (d *Daemon) checkShard(ctx context.Context, keyspace, shard string) {
+	si, err := d.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		log.Errorf("recovery daemon: GetShard(%v/%v) failed: %v", keyspace, shard, err)
+		return
+	}
+	if !si.HasMaster() {
+		return
+	}
+	masterAlias := topoproto.TabletAliasString(si.MasterAlias)
+	state := d.state(keyspace, shard)
+
+	tablets, err := d.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		log.Errorf("recovery daemon: GetTabletMapForShard(%v/%v) failed: %v", keyspace, shard, err)
+		return
+	}
+	master, ok := tablets[masterAlias]
+	if !ok {
+		log.Warningf("recovery daemon: master %v of %v/%v is not in the topology", masterAlias, keyspace, shard)
+		return
+	}
+
+	err = d.checkMaster(ctx, master.Tablet)
+	if err == nil {
+		if state.failures > 0 {
+			d.record(keyspace, shard, masterAlias, DecisionMasterHealthy, "master is healthy again after %v failed checks", state.failures)
+			state.failures = 0
+		}
+		return
+	}
+	state.failures++
+	d.record(keyspace, shard, masterAlias, DecisionMasterFailed, "check %v/%v failed: %v", state.failures, *failureThreshold, err)
+	if state.failures < *failureThreshold {
+		return
+	}
+
+	// Ask the replicas if they can reach the master.
+	statuses := d.replicaStatuses(ctx, tablets, masterAlias)
+	disconnected := 0
+	for _, status := range statuses {
+		if !status.SlaveIoRunning {
+			disconnected++
+		}
+	}
+	if disconnected*2 <= len(statuses) {
+		d.record(keyspace, shard, masterAlias, DecisionNotConfirmed, "%v of the %v reachable replicas are not replicating from the master", disconnected, len(statuses))
+		return
+	}
+
+	if since := time.Since(state.lastRecovery); since < *cooldown {
+		d.record(keyspace, shard, masterAlias, DecisionSkipped, "the last recovery of the shard was %v ago, less than -recovery_cooldown", since)
+		return
+	}
+	policy, err := d.durabilityPolicy(ctx, keyspace)
+	if err != nil {
+		d.record(keyspace, shard, masterAlias, DecisionSkipped, "%v", err)
+		return
+	}
+	candidate, err := ChooseCandidate(master.Tablet, tablets, statuses, policy, preferredCells)
+	if err != nil {
+		d.record(keyspace, shard, masterAlias, DecisionSkipped, "%v", err)
+		return
+	}
+	candidateAlias := topoproto.TabletAliasString(candidate.Alias)
+	state.lastRecovery = time.Now()
+	state.failures = 0
+	if *dryRun {
+		d.record(keyspace, shard, masterAlias, DecisionDryRun, "%v of the %v reachable replicas confirmed the failure, would promote %v", disconnected, len(statuses), candidateAlias)
+		return
+	}
+
+	d.record(keyspace, shard, masterAlias, DecisionRecover, "%v of the %v reachable replicas confirmed the failure, promoting %v", disconnected, len(statuses), candidateAlias)
+	if err := d.reparenter.EmergencyReparentShard(ctx, keyspace, shard, candidate.Alias, *waitReplicasTimeout); err != nil {
+		recoveries.Add([]string{keyspace, shard, "Failed"}, 1)
+		d.record(keyspace, shard, masterAlias, DecisionRecoveryFailed, "EmergencyReparentShard to %v failed: %v", candidateAlias, err)
+		return
+	}
+	recoveries.Add([]string{keyspace, shard, "Succeeded"}, 1)
+	d.record(keyspace, shard, masterAlias, DecisionRecovered, "%v is the new master", candidateAlias)
+}
+
+// durabilityPolicy returns the durability policy of a keyspace,
+// or -recovery_durability_policy if the keyspace has none.
+func (d *Daemon) durabilityPolicy(ctx context.Context, keyspace string) (string, error) {
+	ki, err := d.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the durability policy of keyspace %v: %v", keyspace, err)
+	}
+	if ki.DurabilityPolicy != "" {
+		return ki.DurabilityPolicy, nil
+	}
+	return *durabilityPolicy, nil
+}
+
+// checkMaster returns an error if the master can't be reached,
+// or can't return its replication position.
+func (d *Daemon) checkMaster(ctx context.Context, master *topodatapb.Tablet) error {
+	ctx, cancel := context.WithTimeout(ctx, *rpcTimeout)
+	defer cancel()
+	if err := d.tmc.Ping(ctx, master); err != nil {
+		return fmt.Errorf("cannot reach the master: %v", err)
+	}
+	if _, err := d.tmc.MasterPosition(ctx, master); err != nil {
+		return fmt.Errorf("cannot get the master position: %v", err)
+	}
+	return nil
+}
+
+// replicaStatuses returns the replication status of the replicas
+// that can be reached, indexed by alias.
+func (d *Daemon) replicaStatuses(ctx context.Context, tablets map[string]*topo.TabletInfo, masterAlias string) map[string]*replicationdatapb.Status {
+	ctx, cancel := context.WithTimeout(ctx, *rpcTimeout)
+	defer cancel()
+
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	result := make(map[string]*replicationdatapb.Status)
+	for alias, ti := range tablets {
+		if alias == masterAlias || !topo.IsSlaveType(ti.Type) {
+			continue
+		}
+		wg.Add(1)
+		go func(alias string, tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			status, err := d.tmc.SlaveStatus(ctx, tablet)
+			if err != nil {
+				log.Warningf("recovery daemon: cannot get the replication status of %v: %v", alias, err)
+				return
+			}
+			mu.Lock()
+			result[alias] = status
+			mu.Unlock()
+		}(alias, ti.Tablet)
+	}
+	wg.Wait()
+	return result
+}
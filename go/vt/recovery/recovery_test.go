@@ -0,0 +1,308 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recovery
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMClient answers the checks of the daemon. Tablets that
+// are not in statuses or masters can't be reached.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu       sync.Mutex
+	masters  map[string]bool
+	statuses map[string]*replicationdatapb.Status
+}
+
+func (f *fakeTMClient) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.masters[topoproto.TabletAliasString(tablet.Alias)] && f.statuses[topoproto.TabletAliasString(tablet.Alias)] == nil {
+		return fmt.Errorf("unreachable")
+	}
+	return nil
+}
+
+func (f *fakeTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.masters[topoproto.TabletAliasString(tablet.Alias)] {
+		return "", fmt.Errorf("unreachable")
+	}
+	return "", nil
+}
+
+func (f *fakeTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	status, ok := f.statuses[topoproto.TabletAliasString(tablet.Alias)]
+	if !ok {
+		return nil, fmt.Errorf("unreachable")
+	}
+	return status, nil
+}
+
+// fakeReparenter records the EmergencyReparentShard calls.
+type fakeReparenter struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (f *fakeReparenter) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, fmt.Sprintf("%v/%v %v", keyspace, shard, topoproto.TabletAliasString(masterElectTabletAlias)))
+	return nil
+}
+
+func position(n int) string {
+	return fmt.Sprintf("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-%v", n)
+}
+
+// setup creates a shard with a master in cell1, and returns the
+// daemon and its fakes. All the tablets are healthy.
+func setup(t *testing.T, replicas map[string]topodatapb.TabletType) (*Daemon, *fakeTMClient, *fakeReparenter) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	tmc := &fakeTMClient{
+		masters:  map[string]bool{"cell1-0000000100": true},
+		statuses: make(map[string]*replicationdatapb.Status),
+	}
+	create := func(alias string, tabletType topodatapb.TabletType) {
+		ta, err := topoproto.ParseTabletAlias(alias)
+		if err != nil {
+			t.Fatalf("ParseTabletAlias failed: %v", err)
+		}
+		if err := ts.CreateTablet(ctx, &topodatapb.Tablet{
+			Alias:    ta,
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     tabletType,
+		}); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+	}
+	create("cell1-0000000100", topodatapb.TabletType_MASTER)
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	for alias, tabletType := range replicas {
+		create(alias, tabletType)
+		tmc.statuses[alias] = &replicationdatapb.Status{Position: position(10), SlaveIoRunning: true, SlaveSqlRunning: true}
+	}
+
+	reparenter := &fakeReparenter{}
+	return NewDaemon(ts, tmc, reparenter), tmc, reparenter
+}
+
+// killMaster makes the master unreachable, and stops the
+// replication of the replicas.
+func (f *fakeTMClient) killMaster() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.masters = nil
+	for _, status := range f.statuses {
+		status.SlaveIoRunning = false
+	}
+}
+
+func decisions(d *Daemon) []string {
+	var result []string
+	for _, ev := range d.Events() {
+		result = append(result, ev.Decision)
+	}
+	return result
+}
+
+func TestRecovery(t *testing.T) {
+	*failureThreshold = 2
+	ctx := context.Background()
+	d, tmc, reparenter := setup(t, map[string]topodatapb.TabletType{
+		"cell1-0000000101": topodatapb.TabletType_REPLICA,
+		"cell2-0000000200": topodatapb.TabletType_REPLICA,
+		"cell2-0000000201": topodatapb.TabletType_RDONLY,
+	})
+	preferredCells = []string{"cell2"}
+	defer func() { preferredCells = nil }()
+
+	// A healthy master is left alone.
+	d.CheckAll(ctx)
+	if len(d.Events()) != 0 || len(reparenter.calls) != 0 {
+		t.Fatalf("healthy master: events %v, calls %v", decisions(d), reparenter.calls)
+	}
+
+	// The most advanced replica is promoted, even if it's not in
+	// the preferred cell.
+	tmc.killMaster()
+	tmc.statuses["cell1-0000000101"].Position = position(11)
+	d.CheckAll(ctx)
+	if len(reparenter.calls) != 0 {
+		t.Fatalf("recovered after the first failed check")
+	}
+	d.CheckAll(ctx)
+	if len(reparenter.calls) != 1 || reparenter.calls[0] != "ks/0 cell1-0000000101" {
+		t.Fatalf("got calls %v, want one recovery to cell1-0000000101", reparenter.calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[MasterFailed MasterFailed Recover Recovered]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+
+	// The cooldown stops a second recovery.
+	d.CheckAll(ctx)
+	d.CheckAll(ctx)
+	if len(reparenter.calls) != 1 {
+		t.Fatalf("recovered during the cooldown: %v", reparenter.calls)
+	}
+	if events := d.Events(); events[len(events)-1].Decision != DecisionSkipped {
+		t.Errorf("got decisions %v, want the last one skipped", decisions(d))
+	}
+}
+
+func TestRecoveryNotConfirmed(t *testing.T) {
+	*failureThreshold = 1
+	ctx := context.Background()
+	d, tmc, reparenter := setup(t, map[string]topodatapb.TabletType{
+		"cell1-0000000101": topodatapb.TabletType_REPLICA,
+		"cell2-0000000200": topodatapb.TabletType_REPLICA,
+	})
+
+	// The daemon can't reach the master, but the replicas can.
+	tmc.masters = nil
+	d.CheckAll(ctx)
+	if got, want := fmt.Sprint(decisions(d)), "[MasterFailed NotConfirmed]"; got != want || len(reparenter.calls) != 0 {
+		t.Errorf("got decisions %v and calls %v, want %v and no calls", got, reparenter.calls, want)
+	}
+}
+
+func TestRecoveryDryRun(t *testing.T) {
+	*failureThreshold = 1
+	*dryRun = true
+	defer func() { *dryRun = false }()
+	ctx := context.Background()
+	d, tmc, reparenter := setup(t, map[string]topodatapb.TabletType{
+		"cell1-0000000101": topodatapb.TabletType_REPLICA,
+	})
+
+	tmc.killMaster()
+	d.CheckAll(ctx)
+	if got, want := fmt.Sprint(decisions(d)), "[MasterFailed DryRun]"; got != want || len(reparenter.calls) != 0 {
+		t.Errorf("got decisions %v and calls %v, want %v and no calls", got, reparenter.calls, want)
+	}
+}
+
+func TestChooseCandidate(t *testing.T) {
+	master := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}}
+	tablets := make(map[string]*topo.TabletInfo)
+	addTablet := func(cell string, uid uint32, tabletType topodatapb.TabletType) {
+		tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: cell, Uid: uid}, Type: tabletType}
+		tablets[topoproto.TabletAliasString(tablet.Alias)] = &topo.TabletInfo{Tablet: tablet}
+	}
+	addTablet("cell1", 101, topodatapb.TabletType_REPLICA)
+	addTablet("cell2", 200, topodatapb.TabletType_REPLICA)
+	addTablet("cell2", 201, topodatapb.TabletType_REPLICA)
+	addTablet("cell3", 300, topodatapb.TabletType_RDONLY)
+
+	testcases := []struct {
+		name           string
+		positions      map[string]int
+		policy         string
+		preferredCells []string
+		want           string
+	}{{
+		name:      "same cell as the master",
+		positions: map[string]int{"cell1-0000000101": 10, "cell2-0000000200": 10, "cell2-0000000201": 10},
+		policy:    topotools.DurabilityNone,
+		want:      "cell1-0000000101",
+	}, {
+		name:           "preferred cell",
+		positions:      map[string]int{"cell1-0000000101": 10, "cell2-0000000200": 10, "cell2-0000000201": 10},
+		policy:         topotools.DurabilityNone,
+		preferredCells: []string{"cell3", "cell2"},
+		want:           "cell2-0000000200",
+	}, {
+		name:           "most advanced",
+		positions:      map[string]int{"cell1-0000000101": 10, "cell2-0000000200": 10, "cell2-0000000201": 11},
+		policy:         topotools.DurabilityNone,
+		preferredCells: []string{"cell1"},
+		want:           "cell2-0000000201",
+	}, {
+		name:      "rdonly is the most advanced",
+		positions: map[string]int{"cell1-0000000101": 10, "cell3-0000000300": 11},
+		policy:    topotools.DurabilityNone,
+		want:      "error",
+	}, {
+		name:      "semi-sync needs another replica",
+		positions: map[string]int{"cell1-0000000101": 10, "cell3-0000000300": 10},
+		policy:    topotools.DurabilitySemiSync,
+		want:      "error",
+	}, {
+		name:      "cross cell needs a replica in another cell",
+		positions: map[string]int{"cell1-0000000101": 10, "cell2-0000000200": 10, "cell2-0000000201": 9},
+		policy:    topotools.DurabilityCrossCell,
+		want:      "cell1-0000000101",
+	}, {
+		name:           "cross cell in the preferred cell",
+		positions:      map[string]int{"cell2-0000000200": 10, "cell2-0000000201": 10, "cell1-0000000101": 10},
+		policy:         topotools.DurabilityCrossCell,
+		preferredCells: []string{"cell2"},
+		want:           "cell2-0000000200",
+	}, {
+		name:      "cross cell with a single cell",
+		positions: map[string]int{"cell2-0000000200": 10, "cell2-0000000201": 10},
+		policy:    topotools.DurabilityCrossCell,
+		want:      "error",
+	}}
+	for _, tcase := range testcases {
+		statuses := make(map[string]*replicationdatapb.Status)
+		for alias, n := range tcase.positions {
+			statuses[alias] = &replicationdatapb.Status{Position: position(n)}
+		}
+		got := "error"
+		tablet, err := ChooseCandidate(master, tablets, statuses, tcase.policy, tcase.preferredCells)
+		if err == nil {
+			got = topoproto.TabletAliasString(tablet.Alias)
+		}
+		if got != tcase.want {
+			t.Errorf("%v: got %v (%v), want %v", tcase.name, got, err, tcase.want)
+		}
+	}
+}
@@ -1121,7 +1121,7 @@ waitForBackup:
 // to become healthy and to catch up with replication.
 func (shardSwap *shardSchemaSwap) swapOnTablet(tablet *topodatapb.Tablet) error {
 	shardSwap.addPropagationLog(fmt.Sprintf("Restoring tablet %v from backup", tablet.Alias))
-	eventStream, err := shardSwap.parent.tabletClient.RestoreFromBackup(shardSwap.parent.ctx, tablet)
+	eventStream, err := shardSwap.parent.tabletClient.RestoreFromBackup(shardSwap.parent.ctx, tablet, time.Time{}, "")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,337 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// This file contains the audit log of the changes made to the
+// keyspaces, shards, SrvKeyspaces, VSchemas and routing rules.
+
+var (
+	auditSinkName   = flag.String("topo_audit_sink", "", "where to record the changes to keyspaces, shards, SrvKeyspaces, VSchemas and routing rules: 'topo' writes them to the global topo, 'log' logs them. Empty disables the audit log.")
+	auditMaxRecords = flag.Int("topo_audit_max_records", 1000, "maximum number of audit records the 'topo' audit sink keeps per keyspace, the oldest records are deleted in the background")
+
+	auditErrors = stats.NewCountersWithSingleLabel("TopologyAuditErrors", "Audit records that could not be recorded, per sink", "Sink")
+)
+
+// The kinds of objects that are audited.
+const (
+	AuditKeyspace     = "Keyspace"
+	AuditShard        = "Shard"
+	AuditSrvKeyspace  = "SrvKeyspace"
+	AuditVSchema      = "VSchema"
+	AuditRoutingRules = "RoutingRules"
+)
+
+// The actions that are audited.
+const (
+	AuditCreate = "create"
+	AuditUpdate = "update"
+	AuditDelete = "delete"
+)
+
+// AuditPath is the path of the audit records of the 'topo'
+// audit sink in the global cell.
+const AuditPath = "audit"
+
+// AuditRecord describes a change to a topo object.
+type AuditRecord struct {
+	Time time.Time
+	// Caller is the effective caller of the change, or its
+	// immediate caller if there is no effective caller.
+	Caller string
+	// Command is the command that made the change, as set by
+	// NewAuditContext.
+	Command string
+	Kind    string
+	Action  string
+	// Keyspace, Shard and Cell identify the object. They are
+	// empty when they don't apply to the kind of object.
+	Keyspace string
+	Shard    string
+	Cell     string
+	// Before and After are the text form of the object
+	// before and after the change. They are empty if the
+	// object doesn't exist.
+	Before string
+	After  string
+}
+
+// AuditSink records audit records. The 'topo' and 'log' sinks are
+// built in, and others can be registered with RegisterAuditSink.
+type AuditSink interface {
+	// Record records a change. Errors are counted and logged,
+	// but they don't fail the change, which is already made.
+	Record(ctx context.Context, ts *Server, record *AuditRecord) error
+}
+
+var (
+	auditSinksMu sync.Mutex
+	auditSinks   = map[string]AuditSink{
+		"topo": topoAuditSink{},
+		"log":  logAuditSink{},
+	}
+)
+
+// RegisterAuditSink registers an AuditSink, which is used if
+// -topo_audit_sink is set to its name.
+func RegisterAuditSink(name string, sink AuditSink) {
+	auditSinksMu.Lock()
+	defer auditSinksMu.Unlock()
+	if _, ok := auditSinks[name]; ok {
+		log.Fatalf("Duplicate topo.AuditSink registration for %v", name)
+	}
+	auditSinks[name] = sink
+}
+
+// auditSink returns the AuditSink to use, or nil if the
+// audit log is disabled.
+func auditSink() AuditSink {
+	if *auditSinkName == "" {
+		return nil
+	}
+	auditSinksMu.Lock()
+	defer auditSinksMu.Unlock()
+	sink, ok := auditSinks[*auditSinkName]
+	if !ok {
+		log.Errorf("unknown topo audit sink %v, changes are not recorded", *auditSinkName)
+		return nil
+	}
+	return sink
+}
+
+type auditCommandKey struct{}
+
+// NewAuditContext returns a context that records command
+// as the command of the changes made with it.
+func NewAuditContext(ctx context.Context, command string) context.Context {
+	return context.WithValue(ctx, auditCommandKey{}, command)
+}
+
+// auditBefore reads the current value of an object, to record it
+// as the value before the change. It returns nil if the audit log
+// is disabled, or the object can't be read.
+func auditBefore(ctx context.Context, conn Conn, filePath string, value proto.Message) proto.Message {
+	if auditSink() == nil {
+		return nil
+	}
+	data, _, err := conn.Get(ctx, filePath)
+	if err != nil {
+		return nil
+	}
+	if err := proto.Unmarshal(data, value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// audit records a change, if the audit log is enabled.
+func (ts *Server) audit(ctx context.Context, kind, action, keyspace, shard, cell string, before, after proto.Message) {
+	sink := auditSink()
+	if sink == nil {
+		return
+	}
+
+	record := &AuditRecord{
+		Time:     time.Now(),
+		Kind:     kind,
+		Action:   action,
+		Keyspace: keyspace,
+		Shard:    shard,
+		Cell:     cell,
+	}
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil {
+		record.Caller = ef.Principal
+	} else if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil {
+		record.Caller = im.Username
+	}
+	if command, ok := ctx.Value(auditCommandKey{}).(string); ok {
+		record.Command = command
+	}
+	if before != nil {
+		record.Before = proto.MarshalTextString(before)
+	}
+	if after != nil {
+		record.After = proto.MarshalTextString(after)
+	}
+
+	if err := sink.Record(ctx, ts, record); err != nil {
+		auditErrors.Add(*auditSinkName, 1)
+		log.Errorf("cannot record the %v of %v %v/%v: %v", action, kind, keyspace, shard, err)
+	}
+}
+
+// auditDir returns the directory of the audit records of a keyspace
+// in the global cell. The records of the objects that are not in a
+// keyspace, like the routing rules, are in the global directory.
+func auditDir(keyspace string) string {
+	if keyspace == "" {
+		return path.Join(AuditPath, GlobalCell)
+	}
+	return path.Join(AuditPath, KeyspacesPath, keyspace)
+}
+
+// topoAuditSink writes the audit records to the global cell.
+type topoAuditSink struct{}
+
+// Record is part of the AuditSink interface.
+func (topoAuditSink) Record(ctx context.Context, ts *Server, record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	dir := auditDir(record.Keyspace)
+	// The names of the records sort by time.
+	for n := record.Time.UnixNano(); ; n++ {
+		_, err = ts.globalCell.Create(ctx, path.Join(dir, fmt.Sprintf("%020d", n)), data)
+		if !IsErrType(err, NodeExists) {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	auditPrune(ts, dir, *auditMaxRecords)
+	return nil
+}
+
+var (
+	// auditPruningMu protects auditPruning.
+	auditPruningMu sync.Mutex
+	// auditPruning has the directories being pruned. The value
+	// is the maximum number of records of the next pruning, if
+	// records were written during the current one, or 0.
+	auditPruning = make(map[string]int)
+)
+
+// auditPrune deletes the oldest records of a directory in the
+// background, so the changes don't wait for the directory to be
+// listed. There is at most one pruning per directory at a time.
+func auditPrune(ts *Server, dir string, maxRecords int) {
+	auditPruningMu.Lock()
+	defer auditPruningMu.Unlock()
+	if _, ok := auditPruning[dir]; ok {
+		auditPruning[dir] = maxRecords
+		return
+	}
+	auditPruning[dir] = 0
+
+	go func() {
+		for {
+			if err := auditPruneOnce(ts, dir, maxRecords); err != nil {
+				auditErrors.Add("topo", 1)
+				log.Errorf("cannot prune the topo audit records in %v: %v", dir, err)
+			}
+
+			auditPruningMu.Lock()
+			maxRecords = auditPruning[dir]
+			if maxRecords == 0 {
+				delete(auditPruning, dir)
+			} else {
+				auditPruning[dir] = 0
+			}
+			auditPruningMu.Unlock()
+			if maxRecords == 0 {
+				return
+			}
+		}
+	}()
+}
+
+// auditPruneOnce deletes the oldest records of a directory, to keep
+// at most maxRecords.
+func auditPruneOnce(ts *Server, dir string, maxRecords int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), *RemoteOperationTimeout)
+	defer cancel()
+	entries, err := ts.globalCell.ListDir(ctx, dir, false /*full*/)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(entries)-maxRecords; i++ {
+		if err := ts.globalCell.Delete(ctx, path.Join(dir, entries[i].Name), nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+	}
+	return nil
+}
+
+// logAuditSink logs the audit records.
+type logAuditSink struct{}
+
+// Record is part of the AuditSink interface.
+func (logAuditSink) Record(ctx context.Context, ts *Server, record *AuditRecord) error {
+	log.Infof("topo audit: %v %v keyspace=%v shard=%v cell=%v caller=%v command=%q before={%v} after={%v}", record.Action, record.Kind, record.Keyspace, record.Shard, record.Cell, record.Caller, record.Command, record.Before, record.After)
+	return nil
+}
+
+// GetAuditRecords returns the audit records written by the 'topo'
+// audit sink for a keyspace, or for a shard if shard is set, from
+// the oldest to the newest. The records of the objects that are not
+// in a keyspace are returned if keyspace is empty. Only the records
+// since the given time are returned, unless it is zero.
+func (ts *Server) GetAuditRecords(ctx context.Context, keyspace, shard string, since time.Time) ([]*AuditRecord, error) {
+	dir := auditDir(keyspace)
+	entries, err := ts.globalCell.ListDir(ctx, dir, false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var result []*AuditRecord
+	for _, e := range entries {
+		data, _, err := ts.globalCell.Get(ctx, path.Join(dir, e.Name))
+		switch {
+		case IsErrType(err, NoNode):
+			// Deleted since we listed the directory.
+			continue
+		case err != nil:
+			return nil, err
+		}
+		record := &AuditRecord{}
+		if err := json.Unmarshal(data, record); err != nil {
+			return nil, fmt.Errorf("bad audit record %v: %v", e.Name, err)
+		}
+		if shard != "" && record.Shard != shard {
+			continue
+		}
+		if record.Time.Before(since) {
+			continue
+		}
+		result = append(result, record)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Time.Before(result[j].Time)
+	})
+	return result, nil
+}
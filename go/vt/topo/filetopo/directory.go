@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	st, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	// Directories only exist through the files they contain.
+	prefix := s.dirPrefix(dirPath)
+	children := make(map[string]topo.DirEntryType)
+	for key := range st.Files {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := key[len(prefix):]
+		if i := strings.IndexByte(name, '/'); i != -1 {
+			children[name[:i]] = topo.TypeDirectory
+			continue
+		}
+		children[name] = topo.TypeFile
+	}
+	if len(children) == 0 {
+		return nil, topo.NewError(topo.NoNode, dirPath)
+	}
+
+	result := make([]topo.DirEntry, 0, len(children))
+	for name, t := range children {
+		e := topo.DirEntry{
+			Name: name,
+		}
+		if full {
+			e.Type = t
+		}
+		result = append(result, e)
+	}
+	topo.DirEntriesSortByName(result)
+	return result, nil
+}
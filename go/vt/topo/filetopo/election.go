@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"path"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+const (
+	// Path components
+	electionsPath = "elections"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &fileMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// fileMasterParticipation implements topo.MasterParticipation.
+//
+// We use a lock on the election path, with the id as its contents.
+// Election locks don't need the directory to exist, so the election
+// path doesn't show up in ListDir.
+type fileMasterParticipation struct {
+	// s is our parent file topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *fileMasterParticipation) WaitForMastership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	default:
+	}
+
+	electionPath := mp.s.absPath(path.Join(electionsPath, mp.name))
+
+	// We use a cancelable context here. If stop is closed,
+	// we just cancel that context, and release the lock if
+	// we got it.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	locked := make(chan *fileLockDescriptor, 1)
+	go func() {
+		<-mp.stop
+		lockCancel()
+		if ld := <-locked; ld != nil {
+			if err := ld.Unlock(context.Background()); err != nil {
+				log.Errorf("failed to unlock LockDescriptor %v: %v", electionPath, err)
+			}
+		}
+		close(mp.done)
+	}()
+
+	// Try to get the mastership, by getting a lock.
+	ld, err := mp.s.lock(lockCtx, electionPath, mp.id)
+	locked <- ld
+	if err != nil {
+		// It can be that we were interrupted.
+		return nil, err
+	}
+
+	// We got the lock. Return the lockContext. If Stop() is called,
+	// it will cancel the lockCtx, and cancel the returned context.
+	return lockCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *fileMasterParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *fileMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	st, err := mp.s.read()
+	if err != nil {
+		return "", err
+	}
+	l := st.lockHeld(mp.s.absPath(path.Join(electionsPath, mp.name)))
+	if l == nil {
+		return "", nil
+	}
+	return l.Contents, nil
+}
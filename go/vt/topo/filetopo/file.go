@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Create is part of topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	key := s.absPath(filePath)
+	var version uint64
+	err := s.update(func(st *store) error {
+		if _, ok := st.Files[key]; ok {
+			return topo.NewError(topo.NodeExists, filePath)
+		}
+		if err := checkFilePath(st, key); err != nil {
+			return err
+		}
+		version = st.nextVersion()
+		st.Files[key] = &fileEntry{Contents: contents, Version: version}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return FileVersion(version), nil
+}
+
+// Update is part of topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	key := s.absPath(filePath)
+	var newVersion uint64
+	err := s.update(func(st *store) error {
+		fe, ok := st.Files[key]
+		switch {
+		case !ok && version != nil:
+			return topo.NewError(topo.NoNode, filePath)
+		case !ok:
+			if err := checkFilePath(st, key); err != nil {
+				return err
+			}
+		case version != nil && fe.Version != uint64(version.(FileVersion)):
+			return topo.NewError(topo.BadVersion, filePath)
+		}
+		newVersion = st.nextVersion()
+		st.Files[key] = &fileEntry{Contents: contents, Version: newVersion}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return FileVersion(newVersion), nil
+}
+
+// Get is part of topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	st, err := s.read()
+	if err != nil {
+		return nil, nil, err
+	}
+	fe, ok := st.Files[s.absPath(filePath)]
+	if !ok {
+		return nil, nil, topo.NewError(topo.NoNode, filePath)
+	}
+	return fe.contents(), FileVersion(fe.Version), nil
+}
+
+// Delete is part of topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	key := s.absPath(filePath)
+	return s.update(func(st *store) error {
+		fe, ok := st.Files[key]
+		if !ok {
+			return topo.NewError(topo.NoNode, filePath)
+		}
+		if version != nil && fe.Version != uint64(version.(FileVersion)) {
+			return topo.NewError(topo.BadVersion, filePath)
+		}
+		delete(st.Files, key)
+		return nil
+	})
+}
+
+// checkFilePath makes sure a file can be created with the given key:
+// it must not be a directory, nor be in a path that contains files.
+func checkFilePath(st *store, key string) error {
+	for dir := path.Dir(key); dir != "/"; dir = path.Dir(dir) {
+		if _, ok := st.Files[dir]; ok {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "trying to create file %v in a path that contains files", key)
+		}
+	}
+	for k := range st.Files {
+		if strings.HasPrefix(k, key+"/") {
+			return fmt.Errorf("cannot create file %v: it's a directory", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	// errLocked is returned by the update functions
+	// when a lock is held by someone else.
+	errLocked = errors.New("locked")
+
+	// nextOwner is used to generate unique lock owners.
+	nextOwner int64
+)
+
+// convertError converts a context error into a topo error.
+func convertError(err error, nodePath string) error {
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+	return err
+}
+
+// fileLockDescriptor implements topo.LockDescriptor.
+type fileLockDescriptor struct {
+	s     *Server
+	key   string
+	owner string
+
+	// stop is closed by Unlock, to stop refreshing the lock.
+	stop     chan struct{}
+	stopOnce sync.Once
+	// done is closed when the lock is not refreshed any more.
+	done chan struct{}
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		return nil, err
+	}
+	return s.lock(ctx, s.absPath(dirPath), contents)
+}
+
+// lock takes the lock with the given key. It polls the data file
+// until the lock is free or ctx is done.
+func (s *Server) lock(ctx context.Context, key, contents string) (*fileLockDescriptor, error) {
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%v-%v-%v", hostname, os.Getpid(), atomic.AddInt64(&nextOwner, 1))
+	for {
+		err := s.update(func(st *store) error {
+			if st.lockHeld(key) != nil {
+				return errLocked
+			}
+			st.Locks[key] = &lockEntry{
+				Contents: contents,
+				Owner:    owner,
+				Expires:  time.Now().Add(*lockTTL).UnixNano(),
+			}
+			return nil
+		})
+		switch err {
+		case nil:
+			ld := &fileLockDescriptor{
+				s:     s,
+				key:   key,
+				owner: owner,
+				stop:  make(chan struct{}),
+				done:  make(chan struct{}),
+			}
+			go ld.refresh()
+			return ld, nil
+		case errLocked:
+			// Someone else has the lock. Wait for it.
+			select {
+			case <-time.After(*pollInterval):
+			case <-ctx.Done():
+				return nil, convertError(ctx.Err(), key)
+			}
+		default:
+			return nil, err
+		}
+	}
+}
+
+// refresh pushes back the expiration of the lock until Unlock is called.
+func (ld *fileLockDescriptor) refresh() {
+	defer close(ld.done)
+	ticker := time.NewTicker(*lockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ld.stop:
+			return
+		case <-ticker.C:
+		}
+		err := ld.s.update(func(st *store) error {
+			l := st.lockHeld(ld.key)
+			if l == nil || l.Owner != ld.owner {
+				return topo.NewError(topo.NoNode, ld.key)
+			}
+			st.Locks[ld.key] = &lockEntry{
+				Contents: l.Contents,
+				Owner:    l.Owner,
+				Expires:  time.Now().Add(*lockTTL).UnixNano(),
+			}
+			return nil
+		})
+		if topo.IsErrType(err, topo.NoNode) {
+			log.Errorf("lost lock %v", ld.key)
+			return
+		}
+		if err != nil {
+			log.Errorf("failed to refresh lock %v: %v", ld.key, err)
+		}
+	}
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *fileLockDescriptor) Check(ctx context.Context) error {
+	st, err := ld.s.read()
+	if err != nil {
+		return err
+	}
+	if l := st.lockHeld(ld.key); l == nil || l.Owner != ld.owner {
+		return fmt.Errorf("lock %v was lost", ld.key)
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *fileLockDescriptor) Unlock(ctx context.Context) error {
+	ld.stopOnce.Do(func() {
+		close(ld.stop)
+	})
+	<-ld.done
+	return ld.s.update(func(st *store) error {
+		if l := st.lockHeld(ld.key); l == nil || l.Owner != ld.owner {
+			return fmt.Errorf("lock %v is not held", ld.key)
+		}
+		delete(st.Locks, ld.key)
+		return nil
+	})
+}
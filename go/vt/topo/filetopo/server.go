@@ -0,0 +1,319 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package filetopo implements topo.Server with a local file as the backend.
+It is meant for single-node and edge deployments, where running a
+consensus service like etcd or ZooKeeper is not worth it.
+
+The server address is the path of the data file, and all the processes
+of the cluster must run on the same host. Any number of processes can
+share the same data file. We follow these conventions within this package:
+  - The data file contains the whole store, and is replaced atomically
+    by renaming a temporary file. Readers never need to lock it. Every
+    write rewrites the whole store, so it is only meant for the small
+    topologies of these deployments.
+  - The temporary file and then the directory of the data file are
+    synced before a write returns, so written data survives a crash.
+  - Writers hold an exclusive flock on the "<data file>.lock" file while
+    they read, modify and write the store.
+  - Locks are entries of the store with an expiration time, refreshed by
+    the process that holds them, so a lock held by a dead process expires.
+  - Watches poll the data file, and only read it when it changed.
+*/
+package filetopo
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	pollInterval = flag.Duration("topo_file_poll_interval", 100*time.Millisecond, "how often the file topo implementation checks the data file for changes, for watches and locks")
+	lockTTL      = flag.Duration("topo_file_lock_ttl", 30*time.Second, "how long a lock of the file topo implementation is kept if the process that holds it stops refreshing it")
+)
+
+// Factory is the file topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// store is the contents of the data file.
+type store struct {
+	// Generation is used to generate unique incrementing versions.
+	Generation uint64
+	// Files is indexed by the absolute path of the file.
+	Files map[string]*fileEntry
+	// Locks is indexed by the absolute path of the locked directory.
+	Locks map[string]*lockEntry
+}
+
+type fileEntry struct {
+	Contents []byte
+	Version  uint64
+}
+
+// contents returns the contents of the file. Empty
+// contents are read back as nil from the data file.
+func (fe *fileEntry) contents() []byte {
+	if fe.Contents == nil {
+		return []byte{}
+	}
+	return fe.Contents
+}
+
+type lockEntry struct {
+	Contents string
+	// Owner identifies the lock descriptor that holds the lock.
+	Owner string
+	// Expires is the time the lock expires at, in Unix nanoseconds.
+	Expires int64
+}
+
+func newStore() *store {
+	return &store{
+		Files: make(map[string]*fileEntry),
+		Locks: make(map[string]*lockEntry),
+	}
+}
+
+// lockHeld returns the lock of a directory, or nil if it is
+// not locked.
+func (st *store) lockHeld(key string) *lockEntry {
+	l, ok := st.Locks[key]
+	if !ok || l.Expires < time.Now().UnixNano() {
+		return nil
+	}
+	return l
+}
+
+// Server is the implementation of topo.Server for a local file.
+type Server struct {
+	// dataPath is the path of the data file.
+	dataPath string
+	// root is the root path for this client.
+	root string
+	// lockFile is flock-ed by writers.
+	lockFile *os.File
+
+	// mu protects the following fields, and serializes the
+	// writes of this Server.
+	mu sync.Mutex
+	// cached is the last store read from the data file, and
+	// cachedInfo the information of the file it was read from.
+	cached     *store
+	cachedInfo os.FileInfo
+}
+
+// NewServer returns a new filetopo.Server. serverAddr is the path
+// of the data file, which is created if it doesn't exist.
+func NewServer(serverAddr, root string) (*Server, error) {
+	if serverAddr == "" {
+		return nil, fmt.Errorf("the file topo needs the path of its data file as server address")
+	}
+	if err := os.MkdirAll(filepath.Dir(serverAddr), 0755); err != nil {
+		return nil, err
+	}
+	lockFile, err := os.OpenFile(serverAddr+".lock", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		dataPath: serverAddr,
+		root:     root,
+		lockFile: lockFile,
+	}, nil
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the lock file, so any attempt to
+// write with this server will panic.
+func (s *Server) Close() {
+	s.lockFile.Close()
+	s.lockFile = nil
+}
+
+// absPath returns the key of a path relative to the root of the server.
+func (s *Server) absPath(relativePath string) string {
+	return path.Join("/", s.root, relativePath)
+}
+
+// dirPrefix returns the prefix of the keys of the files
+// in a directory relative to the root of the server.
+func (s *Server) dirPrefix(dirPath string) string {
+	prefix := s.absPath(dirPath)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// read returns the current store. It is shared, and must not be modified.
+func (s *Server) read() (*store, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.readLocked()
+}
+
+func (s *Server) readLocked() (*store, error) {
+	info, err := os.Stat(s.dataPath)
+	if os.IsNotExist(err) {
+		return newStore(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The data file is always replaced, never modified in place.
+	if s.cached != nil && os.SameFile(info, s.cachedInfo) && info.ModTime().Equal(s.cachedInfo.ModTime()) && info.Size() == s.cachedInfo.Size() {
+		return s.cached, nil
+	}
+
+	f, err := os.Open(s.dataPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	// Stat the file we read, in case it was replaced since.
+	if info, err = f.Stat(); err != nil {
+		return nil, err
+	}
+	st := newStore()
+	if err := json.NewDecoder(f).Decode(st); err != nil {
+		return nil, fmt.Errorf("cannot decode topo data file %v: %v", s.dataPath, err)
+	}
+	if st.Files == nil {
+		st.Files = make(map[string]*fileEntry)
+	}
+	if st.Locks == nil {
+		st.Locks = make(map[string]*lockEntry)
+	}
+	s.cached = st
+	s.cachedInfo = info
+	return st, nil
+}
+
+// update runs f on the current store, and writes the store back
+// if f succeeds. It holds the flock of the data file while doing so.
+func (s *Server) update(f func(st *store) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := syscall.Flock(int(s.lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		return err
+	}
+	defer syscall.Flock(int(s.lockFile.Fd()), syscall.LOCK_UN)
+
+	st, err := s.readLocked()
+	if err != nil {
+		return err
+	}
+	st = st.clone()
+	if err := f(st); err != nil {
+		return err
+	}
+	return s.writeLocked(st)
+}
+
+func (s *Server) writeLocked(st *store) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	tmpPath := s.dataPath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	f, err := os.Open(tmpPath)
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, s.dataPath); err != nil {
+		return err
+	}
+	// The rename is only durable once the directory is synced.
+	if err := syncDir(filepath.Dir(s.dataPath)); err != nil {
+		return err
+	}
+	info, err := os.Stat(s.dataPath)
+	if err != nil {
+		return err
+	}
+	s.cached = st
+	s.cachedInfo = info
+	return nil
+}
+
+// syncDir flushes the entries of a directory to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	d.Close()
+	return err
+}
+
+// clone returns a copy of the store that can be modified.
+// The entries are never modified, only replaced, so they are shared.
+func (st *store) clone() *store {
+	result := &store{
+		Generation: st.Generation,
+		Files:      make(map[string]*fileEntry, len(st.Files)),
+		Locks:      make(map[string]*lockEntry, len(st.Locks)),
+	}
+	for k, v := range st.Files {
+		result.Files[k] = v
+	}
+	for k, v := range st.Locks {
+		result.Locks[k] = v
+	}
+	return result
+}
+
+// nextVersion returns a new unique version.
+func (st *store) nextVersion() uint64 {
+	st.Generation++
+	return st.Generation
+}
+
+func init() {
+	topo.RegisterFactory("file", Factory{})
+}
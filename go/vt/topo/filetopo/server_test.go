@@ -0,0 +1,199 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestFileTopo(t *testing.T) {
+	*pollInterval = 10 * time.Millisecond
+	dataDir, err := ioutil.TempDir("", "filetopo")
+	if err != nil {
+		t.Fatalf("cannot create tempdir: %v", err)
+	}
+	defer os.RemoveAll(dataDir)
+	dataPath := path.Join(dataDir, "topo.json")
+
+	testIndex := 0
+	newServer := func() *topo.Server {
+		// Each test will use its own sub-directories.
+		testRoot := fmt.Sprintf("/test-%v", testIndex)
+		testIndex++
+
+		// Create the server on the new root.
+		ts, err := topo.OpenServer("file", dataPath, path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+
+		// Create the CellInfo.
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: dataPath,
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+
+		return ts
+	}
+
+	// Run the TopoServerTestSuite tests.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		return newServer()
+	})
+
+	// Run file-specific tests.
+	testSharedDataFile(t, dataPath)
+	testLockExpiration(t, dataPath)
+}
+
+// testSharedDataFile makes sure two servers using the same data file,
+// like two processes would, see each other's changes.
+func testSharedDataFile(t *testing.T, dataPath string) {
+	ctx := context.Background()
+	s1, err := NewServer(dataPath, "/shared")
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s1.Close()
+	s2, err := NewServer(dataPath, "/shared")
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s2.Close()
+
+	version, err := s1.Create(ctx, "dir/file", []byte("a"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	contents, got, err := s2.Get(ctx, "dir/file")
+	if err != nil || string(contents) != "a" || got.String() != version.String() {
+		t.Fatalf("Get: %s, %v, %v, want a, %v", contents, got, err, version)
+	}
+
+	// A watch on one server sees the changes made by the other.
+	_, changes, cancel := s2.Watch(ctx, "dir/file")
+	defer cancel()
+	if _, err := s1.Update(ctx, "dir/file", []byte("b"), version); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	select {
+	case wd := <-changes:
+		if wd.Err != nil || string(wd.Contents) != "b" {
+			t.Errorf("watch: %s, %v, want b", wd.Contents, wd.Err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for the watch")
+	}
+
+	// A lock taken by one server blocks the other.
+	ld, err := s1.Lock(ctx, "dir", "s1")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	fastCtx, fastCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer fastCancel()
+	if _, err := s2.Lock(fastCtx, "dir", "s2"); !topo.IsErrType(err, topo.Timeout) {
+		t.Errorf("Lock while locked by another server: %v, want a timeout", err)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+	ld, err = s2.Lock(ctx, "dir", "s2")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+
+	// The data survives a restart.
+	s3, err := NewServer(dataPath, "/shared")
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s3.Close()
+	if contents, _, err := s3.Get(ctx, "dir/file"); err != nil || string(contents) != "b" {
+		t.Errorf("Get after restart: %s, %v, want b", contents, err)
+	}
+}
+
+// testLockExpiration makes sure locks are refreshed while they are
+// held, and expire when their owner stops refreshing them.
+func testLockExpiration(t *testing.T, dataPath string) {
+	ctx := context.Background()
+	s, err := NewServer(dataPath, "/expiration")
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s.Close()
+	if _, err := s.Create(ctx, "dir/file", []byte("a")); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// Short TTL, make sure it doesn't expire.
+	savedTTL := *lockTTL
+	defer func() { *lockTTL = savedTTL }()
+	*lockTTL = 300 * time.Millisecond
+	ld, err := s.Lock(ctx, "dir", "short ttl")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	time.Sleep(time.Second)
+	if err := ld.Check(ctx); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+
+	// A lock left by a dead process expires.
+	if err := s.update(func(st *store) error {
+		st.Locks[s.absPath("dir")] = &lockEntry{
+			Contents: "dead",
+			Owner:    "dead",
+			Expires:  time.Now().Add(300 * time.Millisecond).UnixNano(),
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	start := time.Now()
+	ld, err = s.Lock(ctx, "dir", "after dead")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("Lock took %v, expected to wait for the expiration of the dead lock", elapsed)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// FileVersion is the version of a file in the data file.
+// It implements topo.Version.
+type FileVersion uint64
+
+// String is part of the topo.Version interface.
+func (v FileVersion) String() string {
+	return fmt.Sprintf("%v", uint64(v))
+}
+
+// VersionFromInt is used by old-style functions to create a proper
+// Version: if version is -1, returns nil. Otherwise returns the
+// FileVersion object.
+func VersionFromInt(version int64) topo.Version {
+	if version == -1 {
+		return nil
+	}
+	return FileVersion(version)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filetopo
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	st, err := s.read()
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+	key := s.absPath(filePath)
+	fe, ok := st.Files[key]
+	if !ok {
+		return &topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)}, nil, nil
+	}
+	current := &topo.WatchData{
+		Contents: fe.contents(),
+		Version:  FileVersion(fe.Version),
+	}
+
+	notifications := make(chan *topo.WatchData, 10)
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	go func() {
+		defer close(notifications)
+
+		// The data file is cached by the Server, so polling
+		// it only costs a stat until it changes.
+		version := fe.Version
+		ticker := time.NewTicker(*pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				notifications <- &topo.WatchData{Err: topo.NewError(topo.Interrupted, filePath)}
+				return
+			case <-ticker.C:
+			}
+
+			st, err := s.read()
+			if err != nil {
+				notifications <- &topo.WatchData{Err: err}
+				return
+			}
+			fe, ok := st.Files[key]
+			if !ok {
+				notifications <- &topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)}
+				return
+			}
+			if fe.Version == version {
+				continue
+			}
+			version = fe.Version
+			notifications <- &topo.WatchData{
+				Contents: fe.contents(),
+				Version:  FileVersion(fe.Version),
+			}
+		}
+	}()
+
+	cancel := func() {
+		stopOnce.Do(func() {
+			close(stop)
+		})
+	}
+	return current, notifications, cancel
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	response, err := s.client.ListDir(ctx, &topoproxydatapb.ListDirRequest{
+		Cell:    s.cell,
+		DirPath: dirPath,
+		Full:    full,
+	})
+	if err != nil {
+		return nil, convertError(ctx, err, dirPath)
+	}
+	result := make([]topo.DirEntry, len(response.Entries))
+	for i, e := range response.Entries {
+		result[i] = topo.DirEntry{
+			Name:      e.Name,
+			Type:      topo.TypeDirectory,
+			Ephemeral: e.Ephemeral,
+		}
+		if e.Type == topoproxydatapb.DirEntry_FILE {
+			result[i].Type = topo.TypeFile
+		}
+	}
+	return result, nil
+}
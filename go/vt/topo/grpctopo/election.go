@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &grpcMasterParticipation{
+		s:      s,
+		name:   name,
+		id:     id,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// grpcMasterParticipation implements topo.MasterParticipation.
+// The proxy runs the election for the duration of the
+// WaitForMastership stream.
+type grpcMasterParticipation struct {
+	s    *Server
+	name string
+	id   string
+
+	// ctx is canceled by Stop, and ends the stream.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// wg tracks the goroutine that watches the mastership.
+	wg sync.WaitGroup
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *grpcMasterParticipation) WaitForMastership() (context.Context, error) {
+	electionPath := "elections/" + mp.name
+	if mp.ctx.Err() != nil {
+		return nil, topo.NewError(topo.Interrupted, electionPath)
+	}
+
+	stream, err := mp.s.client.WaitForMastership(mp.ctx, &topoproxydatapb.WaitForMastershipRequest{
+		Cell: mp.s.cell,
+		Name: mp.name,
+		Id:   mp.id,
+	})
+	if err != nil {
+		return nil, convertError(mp.ctx, err, electionPath)
+	}
+	if _, err := stream.Recv(); err != nil {
+		return nil, convertError(mp.ctx, err, electionPath)
+	}
+
+	// We are the master until the stream ends.
+	masterCtx, masterCancel := context.WithCancel(context.Background())
+	mp.wg.Add(1)
+	go func() {
+		defer mp.wg.Done()
+		defer masterCancel()
+		stream.Recv()
+	}()
+	return masterCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface.
+func (mp *grpcMasterParticipation) Stop() {
+	mp.cancel()
+	mp.wg.Wait()
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface.
+func (mp *grpcMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	response, err := mp.s.client.GetCurrentMasterID(ctx, &topoproxydatapb.GetCurrentMasterIDRequest{
+		Cell: mp.s.cell,
+		Name: mp.name,
+	})
+	if err != nil {
+		return "", convertError(ctx, err, "elections/"+mp.name)
+	}
+	return response.Id, nil
+}
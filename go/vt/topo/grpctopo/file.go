@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// Create is part of topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	response, err := s.client.Create(ctx, &topoproxydatapb.CreateRequest{
+		Cell:     s.cell,
+		FilePath: filePath,
+		Contents: contents,
+	})
+	if err != nil {
+		return nil, convertError(ctx, err, filePath)
+	}
+	return ProxyVersion(response.Version), nil
+}
+
+// Update is part of topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	response, err := s.client.Update(ctx, &topoproxydatapb.UpdateRequest{
+		Cell:     s.cell,
+		FilePath: filePath,
+		Contents: contents,
+		Version:  versionString(version),
+	})
+	if err != nil {
+		return nil, convertError(ctx, err, filePath)
+	}
+	return ProxyVersion(response.Version), nil
+}
+
+// Get is part of topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	response, err := s.client.Get(ctx, &topoproxydatapb.GetRequest{
+		Cell:     s.cell,
+		FilePath: filePath,
+	})
+	if err != nil {
+		return nil, nil, convertError(ctx, err, filePath)
+	}
+	return fileContents(response.Contents), ProxyVersion(response.Version), nil
+}
+
+// Delete is part of topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	_, err := s.client.Delete(ctx, &topoproxydatapb.DeleteRequest{
+		Cell:     s.cell,
+		FilePath: filePath,
+		Version:  versionString(version),
+	})
+	if err != nil {
+		return convertError(ctx, err, filePath)
+	}
+	return nil
+}
+
+// fileContents returns the contents of a file sent by the proxy.
+// Empty contents are sent as nil by gRPC.
+func fileContents(contents []byte) []byte {
+	if contents == nil {
+		return []byte{}
+	}
+	return contents
+}
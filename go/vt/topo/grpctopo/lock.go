@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// lockDescriptor implements topo.LockDescriptor.
+type lockDescriptor struct {
+	s       *Server
+	dirPath string
+	lockID  string
+	// cancel ends the Lock stream. The proxy releases the lock
+	// if the stream ends before Unlock is called.
+	cancel context.CancelFunc
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// The stream has to outlive ctx, as it holds the lock.
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream, err := s.client.Lock(streamCtx, &topoproxydatapb.LockRequest{
+		Cell:     s.cell,
+		DirPath:  dirPath,
+		Contents: contents,
+	})
+	if err != nil {
+		cancel()
+		return nil, convertError(ctx, err, dirPath)
+	}
+
+	type result struct {
+		response *topoproxydatapb.LockResponse
+		err      error
+	}
+	results := make(chan result, 1)
+	go func() {
+		response, err := stream.Recv()
+		results <- result{response, err}
+	}()
+	select {
+	case r := <-results:
+		if r.err != nil {
+			cancel()
+			return nil, convertError(ctx, r.err, dirPath)
+		}
+		return &lockDescriptor{
+			s:       s,
+			dirPath: dirPath,
+			lockID:  r.response.LockId,
+			cancel:  cancel,
+		}, nil
+	case <-ctx.Done():
+		cancel()
+		return nil, convertError(ctx, ctx.Err(), dirPath)
+	}
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *lockDescriptor) Check(ctx context.Context) error {
+	if _, err := ld.s.client.CheckLock(ctx, &topoproxydatapb.CheckLockRequest{
+		LockId: ld.lockID,
+	}); err != nil {
+		return convertError(ctx, err, ld.dirPath)
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *lockDescriptor) Unlock(ctx context.Context) error {
+	defer ld.cancel()
+	if _, err := ld.s.client.Unlock(ctx, &topoproxydatapb.UnlockRequest{
+		LockId: ld.lockID,
+	}); err != nil {
+		return convertError(ctx, err, ld.dirPath)
+	}
+	return nil
+}
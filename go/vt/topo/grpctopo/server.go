@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package grpctopo implements topo.Server with a topo proxy as the backend.
+The topo proxy (go/vt/topo/topoproxy) runs in vtctld, and serves the
+topology from its own connections to the topo servers.
+
+The server address is the address of the topo proxy, and the root is
+ignored: the proxy uses its own global root. We follow these
+conventions within this package:
+  - The CellInfo records of the cells have the addresses of the topo
+    servers, which only the proxy uses. So all the cells are accessed
+    through the proxy the global cell was opened with.
+  - Call topoproxy.ErrorFromGRPC(err, nodePath) on any errors returned
+    by the gRPC client.
+*/
+package grpctopo
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+var (
+	cert = flag.String("topo_grpc_cert", "", "the cert to use to connect to the topo proxy")
+	key  = flag.String("topo_grpc_key", "", "the key to use to connect to the topo proxy")
+	ca   = flag.String("topo_grpc_ca", "", "the server ca to use to validate the topo proxy when connecting")
+	name = flag.String("topo_grpc_server_name", "", "the server name to use to validate the topo proxy certificate")
+)
+
+// Factory is the grpc topo.Factory implementation.
+type Factory struct {
+	// mu protects proxyAddr.
+	mu sync.Mutex
+	// proxyAddr is the address of the proxy the global cell was
+	// opened with.
+	proxyAddr string
+}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f *Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f *Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	f.mu.Lock()
+	if cell == topo.GlobalCell {
+		f.proxyAddr = serverAddr
+	}
+	proxyAddr := f.proxyAddr
+	f.mu.Unlock()
+
+	if proxyAddr == "" {
+		return nil, fmt.Errorf("the global cell must be opened before cell %v", cell)
+	}
+	return NewServer(proxyAddr, cell)
+}
+
+// Server is the implementation of topo.Server for a topo proxy.
+type Server struct {
+	// cc is the connection to the proxy.
+	cc     *grpc.ClientConn
+	client topoproxyservicepb.TopoProxyClient
+
+	// cell is the cell this Server accesses through the proxy.
+	cell string
+}
+
+// NewServer returns a new grpctopo.Server for the given cell.
+func NewServer(serverAddr, cell string) (*Server, error) {
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(serverAddr, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		cc:     cc,
+		client: topoproxyservicepb.NewTopoProxyClient(cc),
+		cell:   cell,
+	}, nil
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the client, so any attempt to
+// re-use this server will panic.
+func (s *Server) Close() {
+	s.cc.Close()
+	s.cc = nil
+	s.client = nil
+}
+
+func init() {
+	topo.RegisterFactory("grpc", &Factory{})
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/test"
+	"vitess.io/vitess/go/vt/topo/topoproxy"
+)
+
+// startProxy starts a topo proxy in front of a new memorytopo
+// server, and returns its address.
+func startProxy(t *testing.T) string {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	s := grpc.NewServer()
+	topoproxy.StartServer(s, memorytopo.NewServer(test.LocalCellName))
+	go s.Serve(listener)
+	return fmt.Sprintf("localhost:%v", listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestGRPCTopo(t *testing.T) {
+	// Run the TopoServerTestSuite tests, each test
+	// with its own proxy and backend.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		ts, err := topo.OpenServer("grpc", startProxy(t), "")
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+		return ts
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ProxyVersion is the version of a file, as returned by the proxy.
+// It implements topo.Version. It is the String() value of the
+// version of the topo server behind the proxy.
+type ProxyVersion string
+
+// String is part of the topo.Version interface.
+func (v ProxyVersion) String() string {
+	return string(v)
+}
+
+// versionString returns the version to send to the proxy
+// for a topo.Version, which may be nil.
+func versionString(version topo.Version) string {
+	if version == nil {
+		return ""
+	}
+	return version.String()
+}
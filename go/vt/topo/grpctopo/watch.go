@@ -0,0 +1,76 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctopo
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	// The stream has to outlive ctx, which is only used
+	// to get the current value.
+	watchCtx, cancel := context.WithCancel(context.Background())
+	stream, err := s.client.Watch(watchCtx, &topoproxydatapb.WatchRequest{
+		Cell:     s.cell,
+		FilePath: filePath,
+	})
+	if err != nil {
+		cancel()
+		return &topo.WatchData{Err: convertError(ctx, err, filePath)}, nil, nil
+	}
+
+	received := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-received:
+		}
+	}()
+	response, err := stream.Recv()
+	close(received)
+	if err != nil {
+		cancel()
+		return &topo.WatchData{Err: convertError(ctx, err, filePath)}, nil, nil
+	}
+	current := &topo.WatchData{
+		Contents: fileContents(response.Contents),
+		Version:  ProxyVersion(response.Version),
+	}
+
+	changes := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(changes)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				changes <- &topo.WatchData{Err: convertError(watchCtx, err, filePath)}
+				return
+			}
+			changes <- &topo.WatchData{
+				Contents: fileContents(response.Contents),
+				Version:  ProxyVersion(response.Version),
+			}
+		}
+	}()
+	return current, changes, topo.CancelFunc(cancel)
+}
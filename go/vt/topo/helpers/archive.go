@@ -0,0 +1,433 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+// ArchiveVersion is the version of the archive format written by
+// ExportArchive. ImportArchive refuses archives with a newer version.
+const ArchiveVersion = 1
+
+// Archive is a snapshot of the topology. It is written as indented JSON,
+// with the files sorted by path and their protos in JSON form, so two
+// archives can be compared with diff.
+type Archive struct {
+	// Version is the version of the archive format.
+	Version int `json:"version"`
+	// Time is when the snapshot was taken, in RFC 3339 format.
+	Time string `json:"time"`
+	// Cells maps the cells, including the global cell, to their files.
+	Cells map[string][]*ArchiveFile `json:"cells"`
+}
+
+// ArchiveFile is a file of the topology.
+type ArchiveFile struct {
+	// Path is the path of the file, relative to the root of the cell.
+	Path string `json:"path"`
+	// Value is the JSON form of the proto stored in the file,
+	// for the files with a known type.
+	Value json.RawMessage `json:"value,omitempty"`
+	// Data is the contents of the other files.
+	Data []byte `json:"data,omitempty"`
+}
+
+// protoForFile returns an empty proto of the type stored in the file,
+// or nil if the type is not known.
+func protoForFile(filePath string) proto.Message {
+	switch path.Base(filePath) {
+	case topo.CellInfoFile:
+		return new(topodatapb.CellInfo)
+	case topo.CellsAliasFile:
+		return new(topodatapb.CellsAlias)
+	case topo.KeyspaceFile:
+		return new(topodatapb.Keyspace)
+	case topo.ShardFile:
+		return new(topodatapb.Shard)
+	case topo.VSchemaFile:
+		return new(vschemapb.Keyspace)
+	case topo.ShardReplicationFile:
+		return new(topodatapb.ShardReplication)
+	case topo.TabletFile:
+		return new(topodatapb.Tablet)
+	case topo.SrvVSchemaFile:
+		return new(vschemapb.SrvVSchema)
+	case topo.SrvKeyspaceFile:
+		return new(topodatapb.SrvKeyspace)
+	case topo.RoutingRulesFile:
+		return new(vschemapb.RoutingRules)
+	case "Workflow":
+		return new(workflowpb.Workflow)
+	}
+	return nil
+}
+
+// newArchiveFile returns the ArchiveFile for the contents of a file.
+// The files of a known type that can't be decoded are kept as data.
+func newArchiveFile(filePath string, contents []byte) *ArchiveFile {
+	af := &ArchiveFile{Path: filePath}
+	if p := protoForFile(filePath); p != nil && proto.Unmarshal(contents, p) == nil {
+		m := jsonpb.Marshaler{OrigName: true}
+		if value, err := m.MarshalToString(p); err == nil {
+			af.Value = json.RawMessage(value)
+			return af
+		}
+	}
+	af.Data = contents
+	return af
+}
+
+// contents returns the contents to write to the topology for the file.
+func (af *ArchiveFile) contents() ([]byte, error) {
+	if af.Value == nil {
+		return af.Data, nil
+	}
+	p := protoForFile(af.Path)
+	if p == nil {
+		return nil, fmt.Errorf("file %v has a value, but its type is not known", af.Path)
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(af.Value), p); err != nil {
+		return nil, fmt.Errorf("cannot decode the value of file %v: %v", af.Path, err)
+	}
+	return proto.Marshal(p)
+}
+
+// text returns the human readable form of the file, used in diffs.
+func (af *ArchiveFile) text() string {
+	if af.Value == nil {
+		return string(af.Data)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, af.Value, "", "  "); err != nil {
+		return string(af.Value)
+	}
+	return buf.String()
+}
+
+// equal returns true if the file has the given contents. Protos
+// are compared decoded, as the same value may have different encodings.
+func (af *ArchiveFile) equal(contents []byte) bool {
+	other := newArchiveFile(af.Path, contents)
+	if af.Value == nil || other.Value == nil {
+		return af.Value == nil && other.Value == nil && bytes.Equal(af.Data, other.Data)
+	}
+	p1, p2 := protoForFile(af.Path), protoForFile(af.Path)
+	if jsonpb.Unmarshal(bytes.NewReader(af.Value), p1) != nil || proto.Unmarshal(contents, p2) != nil {
+		return false
+	}
+	return proto.Equal(p1, p2)
+}
+
+// ExportArchive returns a snapshot of the global cell and the given
+// cells. If cells is empty, all the cells are exported. Ephemeral
+// files, like locks and elections, are skipped.
+func ExportArchive(ctx context.Context, ts *topo.Server, cells []string) (*Archive, error) {
+	if len(cells) == 0 {
+		var err error
+		cells, err = ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("GetCellInfoNames failed: %v", err)
+		}
+	}
+
+	archive := &Archive{
+		Version: ArchiveVersion,
+		Time:    time.Now().UTC().Format(time.RFC3339),
+		Cells:   make(map[string][]*ArchiveFile),
+	}
+	for _, cell := range append([]string{topo.GlobalCell}, cells...) {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return nil, err
+		}
+		files := make(map[string][]byte)
+		if err := readFiles(ctx, conn, "/", files); err != nil {
+			return nil, fmt.Errorf("cannot read the files of cell %v: %v", cell, err)
+		}
+		archive.Cells[cell] = make([]*ArchiveFile, 0, len(files))
+		for _, filePath := range sortedPaths(files) {
+			archive.Cells[cell] = append(archive.Cells[cell], newArchiveFile(filePath, files[filePath]))
+		}
+	}
+	return archive, nil
+}
+
+// readFiles reads the contents of all the files under a directory,
+// skipping the ephemeral entries.
+func readFiles(ctx context.Context, conn topo.Conn, dirPath string, files map[string][]byte) error {
+	entries, err := conn.ListDir(ctx, dirPath, true /*full*/)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return nil
+	case err != nil:
+		return err
+	}
+	for _, e := range entries {
+		if e.Ephemeral {
+			continue
+		}
+		p := path.Join(dirPath, e.Name)
+		if dirPath == "/" {
+			p = e.Name
+		}
+		if e.Type == topo.TypeDirectory {
+			if err := readFiles(ctx, conn, p, files); err != nil {
+				return err
+			}
+			continue
+		}
+		contents, _, err := conn.Get(ctx, p)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			// Deleted since we listed the directory.
+		case err != nil:
+			return err
+		default:
+			files[p] = contents
+		}
+	}
+	return nil
+}
+
+func sortedPaths(files map[string][]byte) []string {
+	result := make([]string, 0, len(files))
+	for p := range files {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ArchiveChangeType is the type of an ArchiveChange.
+type ArchiveChangeType int
+
+const (
+	// ArchiveCreate creates a file that is only in the archive.
+	ArchiveCreate ArchiveChangeType = iota
+	// ArchiveUpdate updates a file that is different in the archive.
+	ArchiveUpdate
+	// ArchiveDelete deletes a file that is not in the archive.
+	ArchiveDelete
+)
+
+// String returns the name of the change type.
+func (t ArchiveChangeType) String() string {
+	switch t {
+	case ArchiveCreate:
+		return "create"
+	case ArchiveUpdate:
+		return "update"
+	case ArchiveDelete:
+		return "delete"
+	}
+	return fmt.Sprintf("unknown(%d)", int(t))
+}
+
+// ArchiveChange is a change to make to the topology to restore
+// an archive.
+type ArchiveChange struct {
+	Type ArchiveChangeType
+	Cell string
+	Path string
+
+	// current and restored are the current file in the topology,
+	// and the file in the archive. Either can be nil.
+	current  *ArchiveFile
+	restored *ArchiveFile
+}
+
+// String returns a one line description of the change.
+func (c *ArchiveChange) String() string {
+	return fmt.Sprintf("%v %v:%v", c.Type, c.Cell, c.Path)
+}
+
+// Diff returns the line diff of the file between the
+// topology and the archive.
+func (c *ArchiveChange) Diff() string {
+	var current, restored string
+	if c.current != nil {
+		current = c.current.text()
+	}
+	if c.restored != nil {
+		restored = c.restored.text()
+	}
+	return lineDiff(current, restored)
+}
+
+// DiffArchive returns the changes that ImportArchive would make to
+// restore the archive. The cells that are not in the archive are not
+// compared. If deleteExtra is set, the files that are not in the
+// archive are deleted.
+func DiffArchive(ctx context.Context, ts *topo.Server, archive *Archive, deleteExtra bool) ([]*ArchiveChange, error) {
+	if archive.Version > ArchiveVersion {
+		return nil, fmt.Errorf("archive version %v is not supported, the maximum version is %v", archive.Version, ArchiveVersion)
+	}
+
+	var changes []*ArchiveChange
+	for _, cell := range archiveCells(archive) {
+		files := make(map[string][]byte)
+		conn, err := ts.ConnForCell(ctx, cell)
+		switch {
+		case err == nil:
+			if err := readFiles(ctx, conn, "/", files); err != nil {
+				return nil, fmt.Errorf("cannot read the files of cell %v: %v", cell, err)
+			}
+		case topo.IsErrType(err, topo.NoNode) && cell != topo.GlobalCell:
+			// The CellInfo is restored from the global cell
+			// first, until then all its files are new.
+		default:
+			return nil, err
+		}
+
+		inArchive := make(map[string]bool)
+		for _, af := range archive.Cells[cell] {
+			inArchive[af.Path] = true
+			contents, ok := files[af.Path]
+			switch {
+			case !ok:
+				changes = append(changes, &ArchiveChange{Type: ArchiveCreate, Cell: cell, Path: af.Path, restored: af})
+			case !af.equal(contents):
+				changes = append(changes, &ArchiveChange{Type: ArchiveUpdate, Cell: cell, Path: af.Path, current: newArchiveFile(af.Path, contents), restored: af})
+			}
+		}
+		if !deleteExtra {
+			continue
+		}
+		for _, filePath := range sortedPaths(files) {
+			if !inArchive[filePath] {
+				changes = append(changes, &ArchiveChange{Type: ArchiveDelete, Cell: cell, Path: filePath, current: newArchiveFile(filePath, files[filePath])})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// archiveCells returns the cells of the archive, the global cell first,
+// so the CellInfo of the other cells is restored before their files.
+func archiveCells(archive *Archive) []string {
+	var cells []string
+	for cell := range archive.Cells {
+		if cell != topo.GlobalCell {
+			cells = append(cells, cell)
+		}
+	}
+	sort.Strings(cells)
+	if _, ok := archive.Cells[topo.GlobalCell]; ok {
+		cells = append([]string{topo.GlobalCell}, cells...)
+	}
+	return cells
+}
+
+// ApplyArchiveChanges makes the changes returned by DiffArchive.
+func ApplyArchiveChanges(ctx context.Context, ts *topo.Server, changes []*ArchiveChange) error {
+	for _, c := range changes {
+		conn, err := ts.ConnForCell(ctx, c.Cell)
+		if err != nil {
+			return err
+		}
+		switch c.Type {
+		case ArchiveCreate, ArchiveUpdate:
+			contents, err := c.restored.contents()
+			if err != nil {
+				return err
+			}
+			if _, err := conn.Update(ctx, c.Path, contents, nil); err != nil {
+				return fmt.Errorf("cannot %v: %v", c, err)
+			}
+		case ArchiveDelete:
+			if err := conn.Delete(ctx, c.Path, nil); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return fmt.Errorf("cannot %v: %v", c, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ImportArchive restores an archive, and returns the changes it made.
+// See DiffArchive for the meaning of deleteExtra.
+func ImportArchive(ctx context.Context, ts *topo.Server, archive *Archive, deleteExtra bool) ([]*ArchiveChange, error) {
+	changes, err := DiffArchive(ctx, ts, archive, deleteExtra)
+	if err != nil {
+		return nil, err
+	}
+	return changes, ApplyArchiveChanges(ctx, ts, changes)
+}
+
+// lineDiff returns the lines removed from a, prefixed with '-', and
+// the lines added to b, prefixed with '+', in order. The files are
+// small, so it uses the longest common subsequence of their lines.
+func lineDiff(a, b string) string {
+	al, bl := splitLines(a), splitLines(b)
+	// lcs[i][j] is the length of the LCS of al[i:] and bl[j:].
+	lcs := make([][]int, len(al)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bl)+1)
+	}
+	for i := len(al) - 1; i >= 0; i-- {
+		for j := len(bl) - 1; j >= 0; j-- {
+			switch {
+			case al[i] == bl[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var buf strings.Builder
+	i, j := 0, 0
+	for i < len(al) || j < len(bl) {
+		switch {
+		case i < len(al) && j < len(bl) && al[i] == bl[j]:
+			i++
+			j++
+		case j == len(bl) || (i < len(al) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&buf, "-%v\n", al[i])
+			i++
+		default:
+			fmt.Fprintf(&buf, "+%v\n", bl[j])
+			j++
+		}
+	}
+	return buf.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
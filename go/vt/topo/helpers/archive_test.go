@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+
+	// Export, and go through JSON like TopoExport and TopoImport do.
+	archive, err := ExportArchive(ctx, fromTS, nil)
+	if err != nil {
+		t.Fatalf("ExportArchive failed: %v", err)
+	}
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		t.Fatalf("json.MarshalIndent failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"hostname": "masterhost"`) {
+		t.Errorf("the protos are not readable in the archive:\n%s", data)
+	}
+	restored := &Archive{}
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	// Import into an empty topo, then there is nothing left to do.
+	if _, err := ImportArchive(ctx, toTS, restored, true /*deleteExtra*/); err != nil {
+		t.Fatalf("ImportArchive failed: %v", err)
+	}
+	tablet, err := toTS.GetTablet(ctx, &topodatapb.TabletAlias{Cell: "test_cell", Uid: 123})
+	if err != nil || tablet.Hostname != "masterhost" {
+		t.Fatalf("GetTablet after import: %v, %v", tablet, err)
+	}
+	changes, err := DiffArchive(ctx, toTS, restored, true /*deleteExtra*/)
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("DiffArchive after import: %v, %v, want no changes", changes, err)
+	}
+	exported, err := ExportArchive(ctx, toTS, nil)
+	if err != nil {
+		t.Fatalf("ExportArchive failed: %v", err)
+	}
+	if !reflect.DeepEqual(exported.Cells, archive.Cells) {
+		t.Errorf("exported archive of the restored topo is different:\n%v\nwant:\n%v", exported.Cells, archive.Cells)
+	}
+
+	// Change the topology, and check the diff.
+	if _, err := toTS.UpdateShardFields(ctx, "test_keyspace", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "test_cell", Uid: 234}
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	if err := toTS.CreateKeyspace(ctx, "extra_keyspace", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	changes, err = DiffArchive(ctx, toTS, restored, false /*deleteExtra*/)
+	if err != nil {
+		t.Fatalf("DiffArchive failed: %v", err)
+	}
+	if len(changes) != 1 || changes[0].String() != "update global:keyspaces/test_keyspace/shards/0/Shard" {
+		t.Fatalf("DiffArchive: %v, want one update of the shard", changes)
+	}
+	if diff := changes[0].Diff(); !strings.Contains(diff, "-    \"uid\": 234\n") {
+		t.Errorf("unexpected diff:\n%v", diff)
+	}
+	changes, err = ImportArchive(ctx, toTS, restored, true /*deleteExtra*/)
+	if err != nil {
+		t.Fatalf("ImportArchive failed: %v", err)
+	}
+	if len(changes) != 2 || changes[1].String() != "delete global:keyspaces/extra_keyspace/Keyspace" {
+		t.Errorf("ImportArchive: %v, want an update and a delete", changes)
+	}
+	if _, err := toTS.GetKeyspace(ctx, "extra_keyspace"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetKeyspace(extra_keyspace) after import: %v, want NoNode", err)
+	}
+
+	// Archives from the future are refused.
+	restored.Version = ArchiveVersion + 1
+	if _, err := DiffArchive(ctx, toTS, restored, false); err == nil {
+		t.Errorf("DiffArchive with a newer version worked")
+	}
+}
+
+func TestLineDiff(t *testing.T) {
+	testcases := []struct {
+		a, b, want string
+	}{{
+		a:    "",
+		b:    "x\ny\n",
+		want: "+x\n+y\n",
+	}, {
+		a:    "a\nb\nc",
+		b:    "a\nc\nd",
+		want: "-b\n+d\n",
+	}, {
+		a:    "a\nb",
+		b:    "a\nb",
+		want: "",
+	}}
+	for _, tcase := range testcases {
+		if got := lineDiff(tcase.a, tcase.b); got != tcase.want {
+			t.Errorf("lineDiff(%q, %q): %q, want %q", tcase.a, tcase.b, got, tcase.want)
+		}
+	}
+}
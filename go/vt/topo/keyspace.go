@@ -162,6 +162,7 @@ func (ts *Server) CreateKeyspace(ctx context.Context, keyspace string, value *to
 	if _, err := ts.globalCell.Create(ctx, keyspacePath, data); err != nil {
 		return err
 	}
+	ts.audit(ctx, AuditKeyspace, AuditCreate, keyspace, "", "", nil, value)
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
@@ -203,11 +204,13 @@ func (ts *Server) UpdateKeyspace(ctx context.Context, ki *KeyspaceInfo) error {
 		return err
 	}
 	keyspacePath := path.Join(KeyspacesPath, ki.keyspace, KeyspaceFile)
+	before := auditBefore(ctx, ts.globalCell, keyspacePath, &topodatapb.Keyspace{})
 	version, err := ts.globalCell.Update(ctx, keyspacePath, data, ki.version)
 	if err != nil {
 		return err
 	}
 	ki.version = version
+	ts.audit(ctx, AuditKeyspace, AuditUpdate, ki.keyspace, "", "", before, ki.Keyspace)
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: ki.keyspace,
@@ -282,9 +285,11 @@ func (ts *Server) GetOnlyShard(ctx context.Context, keyspace string) (*ShardInfo
 // and dispatches the event.
 func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	keyspacePath := path.Join(KeyspacesPath, keyspace, KeyspaceFile)
+	before := auditBefore(ctx, ts.globalCell, keyspacePath, &topodatapb.Keyspace{})
 	if err := ts.globalCell.Delete(ctx, keyspacePath, nil); err != nil {
 		return err
 	}
+	ts.audit(ctx, AuditKeyspace, AuditDelete, keyspace, "", "", before, nil)
 
 	// Delete the cell-global VSchema path
 	// If not remove this, vtctld web page Dashboard will Display Error
@@ -225,11 +225,13 @@ func (ts *Server) updateShard(ctx context.Context, si *ShardInfo) error {
 		return err
 	}
 	shardPath := shardFilePath(si.keyspace, si.shardName)
+	before := auditBefore(ctx, ts.globalCell, shardPath, &topodatapb.Shard{})
 	newVersion, err := ts.globalCell.Update(ctx, shardPath, data, si.version)
 	if err != nil {
 		return err
 	}
 	si.version = newVersion
+	ts.audit(ctx, AuditShard, AuditUpdate, si.keyspace, si.shardName, "", before, si.Shard)
 
 	event.Dispatch(&events.ShardChange{
 		KeyspaceName: si.Keyspace(),
@@ -313,6 +315,7 @@ func (ts *Server) CreateShard(ctx context.Context, keyspace, shard string) (err
 		// ErrNodeExists for instance.
 		return err
 	}
+	ts.audit(ctx, AuditShard, AuditCreate, keyspace, shard, "", nil, value)
 
 	event.Dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
@@ -355,9 +358,11 @@ func (ts *Server) GetOrCreateShard(ctx context.Context, keyspace, shard string)
 // and dispatches the event.
 func (ts *Server) DeleteShard(ctx context.Context, keyspace, shard string) error {
 	shardPath := shardFilePath(keyspace, shard)
+	before := auditBefore(ctx, ts.globalCell, shardPath, &topodatapb.Shard{})
 	if err := ts.globalCell.Delete(ctx, shardPath, nil); err != nil {
 		return err
 	}
+	ts.audit(ctx, AuditShard, AuditDelete, keyspace, shard, "", before, nil)
 	event.Dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
 		ShardName:    shard,
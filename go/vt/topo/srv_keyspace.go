@@ -594,8 +594,12 @@ func (ts *Server) UpdateSrvKeyspace(ctx context.Context, cell, keyspace string,
 	if err != nil {
 		return err
 	}
-	_, err = conn.Update(ctx, nodePath, data, nil)
-	return err
+	before := auditBefore(ctx, conn, nodePath, &topodatapb.SrvKeyspace{})
+	if _, err := conn.Update(ctx, nodePath, data, nil); err != nil {
+		return err
+	}
+	ts.audit(ctx, AuditSrvKeyspace, AuditUpdate, keyspace, "", cell, before, srvKeyspace)
+	return nil
 }
 
 // DeleteSrvKeyspace deletes a SrvKeyspace.
@@ -606,7 +610,12 @@ func (ts *Server) DeleteSrvKeyspace(ctx context.Context, cell, keyspace string)
 	}
 
 	nodePath := srvKeyspaceFileName(keyspace)
-	return conn.Delete(ctx, nodePath, nil)
+	before := auditBefore(ctx, conn, nodePath, &topodatapb.SrvKeyspace{})
+	if err := conn.Delete(ctx, nodePath, nil); err != nil {
+		return err
+	}
+	ts.audit(ctx, AuditSrvKeyspace, AuditDelete, keyspace, "", cell, before, nil)
+	return nil
 }
 
 // GetSrvKeyspaceAllCells returns the SrvKeyspace for all cells
@@ -0,0 +1,335 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// fileKey identifies a file in a cell.
+type fileKey struct {
+	cell     string
+	filePath string
+}
+
+// cache keeps the value of the files that are read or watched through
+// the proxy. It has a single watch on the topo server per file, and
+// fans out its changes to all the watchers of the file.
+type cache struct {
+	ts          *topo.Server
+	idleTimeout time.Duration
+	done        chan struct{}
+
+	// mu protects entries, and the fields of the entries
+	// and subscribers that say so.
+	mu      sync.Mutex
+	entries map[fileKey]*cacheEntry
+}
+
+// cacheEntry is a file watched by the cache.
+type cacheEntry struct {
+	key fileKey
+	// ready is closed once the watch on the topo server is started.
+	ready chan struct{}
+
+	// The following fields are protected by cache.mu.
+
+	// current is the last value of the file. If current.Err is set,
+	// the watch failed and the entry was removed from the cache.
+	current *topo.WatchData
+	// cancel stops the watch on the topo server.
+	cancel topo.CancelFunc
+	// subscribers are the watches of the file.
+	subscribers    map[int]*subscriber
+	nextSubscriber int
+	// lastUsed is the last time the entry was read or unsubscribed.
+	lastUsed time.Time
+	// dirty is set when a write to the file went through the proxy,
+	// until the watch returns the written version. The value of a
+	// dirty entry may be older than the write, so it is not used
+	// to answer reads.
+	dirty          bool
+	writtenVersion string
+}
+
+// subscriber is a watch of a file. Its changes channel only gets the
+// latest value of the file, so a slow subscriber may skip some
+// values, as allowed by the topo.Conn Watch API.
+type subscriber struct {
+	changes chan *topo.WatchData
+	// notify has a value when pending was set.
+	notify chan struct{}
+
+	// The following fields are protected by cache.mu.
+	pending *topo.WatchData
+	// last is set when pending is the last value to send.
+	last bool
+}
+
+func newCache(ts *topo.Server, idleTimeout time.Duration) *cache {
+	c := &cache{
+		ts:          ts,
+		idleTimeout: idleTimeout,
+		done:        make(chan struct{}),
+		entries:     make(map[fileKey]*cacheEntry),
+	}
+	if idleTimeout > 0 {
+		go c.expire()
+	}
+	return c
+}
+
+// close stops all the watches of the cache.
+func (c *cache) close() {
+	close(c.done)
+	c.mu.Lock()
+	var cancels []topo.CancelFunc
+	for _, e := range c.entries {
+		if e.cancel != nil {
+			cancels = append(cancels, e.cancel)
+		}
+	}
+	c.mu.Unlock()
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
+
+// entry returns the entry of a file, and starts watching the file if
+// it is not in the cache yet.
+func (c *cache) entry(key fileKey) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cacheEntry{
+			key:         key,
+			ready:       make(chan struct{}),
+			subscribers: make(map[int]*subscriber),
+		}
+		c.entries[key] = e
+		go c.watch(e)
+	}
+	e.lastUsed = time.Now()
+	return e
+}
+
+// watch runs the watch on the topo server for an entry.
+func (c *cache) watch(e *cacheEntry) {
+	ctx := context.Background()
+	current := &topo.WatchData{}
+	var changes <-chan *topo.WatchData
+	var cancel topo.CancelFunc
+	conn, err := c.ts.ConnForCell(ctx, e.key.cell)
+	if err != nil {
+		current.Err = err
+	} else {
+		current, changes, cancel = conn.Watch(ctx, e.key.filePath)
+	}
+
+	c.mu.Lock()
+	e.current = current
+	e.cancel = cancel
+	close(e.ready)
+	if current.Err != nil {
+		c.removeLocked(e)
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+
+	for wd := range changes {
+		c.mu.Lock()
+		e.current = wd
+		if wd.Err != nil {
+			c.removeLocked(e)
+		} else {
+			if e.dirty && wd.Version.String() == e.writtenVersion {
+				e.dirty = false
+			}
+			for _, s := range e.subscribers {
+				s.setLocked(wd, false)
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+// removeLocked removes an entry from the cache, and sends its
+// current value, which is an error, to its subscribers.
+func (c *cache) removeLocked(e *cacheEntry) {
+	if c.entries[e.key] == e {
+		delete(c.entries, e.key)
+	}
+	for id, s := range e.subscribers {
+		s.setLocked(e.current, true)
+		delete(e.subscribers, id)
+	}
+}
+
+// expire stops watching the files that were not used for idleTimeout.
+func (c *cache) expire() {
+	ticker := time.NewTicker(c.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+
+		var cancels []topo.CancelFunc
+		c.mu.Lock()
+		for _, e := range c.entries {
+			if e.cancel == nil || len(e.subscribers) != 0 || time.Since(e.lastUsed) < c.idleTimeout {
+				continue
+			}
+			delete(c.entries, e.key)
+			cancels = append(cancels, e.cancel)
+		}
+		c.mu.Unlock()
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
+
+// get returns the contents and version of a file.
+func (c *cache) get(ctx context.Context, cell, filePath string) ([]byte, topo.Version, error) {
+	e := c.entry(fileKey{cell: cell, filePath: filePath})
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		return nil, nil, convertError(ctx.Err(), filePath)
+	}
+
+	c.mu.Lock()
+	current, dirty := e.current, e.dirty
+	c.mu.Unlock()
+	if current.Err == nil && !dirty {
+		return current.Contents, current.Version, nil
+	}
+	if !dirty && topo.IsErrType(current.Err, topo.NoNode) {
+		return nil, nil, current.Err
+	}
+
+	// The value of the entry may be older than a write that went
+	// through the proxy: read the file from the topo server.
+	conn, err := c.ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, nil, err
+	}
+	contents, version, err := conn.Get(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.mu.Lock()
+	if e.dirty && e.current.Err == nil && e.current.Version.String() == version.String() {
+		// The watch caught up with the write.
+		e.dirty = false
+	}
+	c.mu.Unlock()
+	return contents, version, nil
+}
+
+// written must be called after a write to a file went through the
+// proxy. version is nil if the file was deleted.
+func (c *cache) written(cell, filePath string, version topo.Version) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[fileKey{cell: cell, filePath: filePath}]
+	if !ok {
+		return
+	}
+	e.dirty = true
+	e.writtenVersion = ""
+	if version != nil {
+		e.writtenVersion = version.String()
+	}
+}
+
+// subscribe starts watching a file. It has the same
+// semantics as topo.Conn.Watch.
+func (c *cache) subscribe(ctx context.Context, cell, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	e := c.entry(fileKey{cell: cell, filePath: filePath})
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		return &topo.WatchData{Err: convertError(ctx.Err(), filePath)}, nil, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e.current.Err != nil {
+		return e.current, nil, nil
+	}
+	s := &subscriber{
+		changes: make(chan *topo.WatchData),
+		notify:  make(chan struct{}, 1),
+	}
+	id := e.nextSubscriber
+	e.nextSubscriber++
+	e.subscribers[id] = s
+	go c.send(s)
+
+	cancel := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if _, ok := e.subscribers[id]; !ok {
+			return
+		}
+		delete(e.subscribers, id)
+		e.lastUsed = time.Now()
+		s.setLocked(&topo.WatchData{Err: topo.NewError(topo.Interrupted, "watch")}, true)
+	}
+	return e.current, s.changes, cancel
+}
+
+// setLocked sets the next value to send to the subscriber.
+func (s *subscriber) setLocked(wd *topo.WatchData, last bool) {
+	if s.last {
+		return
+	}
+	s.pending = wd
+	s.last = last
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// send sends the values of the file to a subscriber.
+func (c *cache) send(s *subscriber) {
+	defer close(s.changes)
+	for range s.notify {
+		c.mu.Lock()
+		wd, last := s.pending, s.last
+		s.pending = nil
+		c.mu.Unlock()
+		if wd != nil {
+			s.changes <- wd
+		}
+		if last {
+			return
+		}
+	}
+}
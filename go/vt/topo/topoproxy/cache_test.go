@@ -0,0 +1,203 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+// countingFactory counts the Get and Watch calls of its connections.
+type countingFactory struct {
+	*memorytopo.Factory
+
+	mu      sync.Mutex
+	gets    int
+	watches int
+}
+
+func (f *countingFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	conn, err := f.Factory.Create(cell, serverAddr, root)
+	if err != nil {
+		return nil, err
+	}
+	return &countingConn{Conn: conn, f: f}, nil
+}
+
+func (f *countingFactory) counts() (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.gets, f.watches
+}
+
+type countingConn struct {
+	topo.Conn
+	f *countingFactory
+}
+
+func (c *countingConn) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	c.f.mu.Lock()
+	c.f.gets++
+	c.f.mu.Unlock()
+	return c.Conn.Get(ctx, filePath)
+}
+
+func (c *countingConn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	c.f.mu.Lock()
+	c.f.watches++
+	c.f.mu.Unlock()
+	return c.Conn.Watch(ctx, filePath)
+}
+
+func newCountingServer(t *testing.T) (*topo.Server, *countingFactory) {
+	_, mf := memorytopo.NewServerAndFactory()
+	f := &countingFactory{Factory: mf}
+	ts, err := topo.NewWithFactory(f, "", "")
+	if err != nil {
+		t.Fatalf("NewWithFactory failed: %v", err)
+	}
+	return ts, f
+}
+
+func TestCacheCoalescesWatches(t *testing.T) {
+	ctx := context.Background()
+	ts, f := newCountingServer(t)
+	conn, _ := ts.ConnForCell(ctx, topo.GlobalCell)
+	version, err := conn.Create(ctx, "file", []byte("a"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	c := newCache(ts, 0)
+	defer c.close()
+
+	// All the reads and watches of the file share the same watch.
+	var changes []<-chan *topo.WatchData
+	for i := 0; i < 10; i++ {
+		if contents, _, err := c.get(ctx, topo.GlobalCell, "file"); err != nil || string(contents) != "a" {
+			t.Fatalf("get: %s, %v, want a", contents, err)
+		}
+		current, ch, cancel := c.subscribe(ctx, topo.GlobalCell, "file")
+		if current.Err != nil || string(current.Contents) != "a" {
+			t.Fatalf("subscribe: %s, %v, want a", current.Contents, current.Err)
+		}
+		defer cancel()
+		changes = append(changes, ch)
+	}
+	if gets, watches := f.counts(); gets != 0 || watches != 1 {
+		t.Errorf("got %v gets and %v watches on the topo server, want 0 and 1", gets, watches)
+	}
+
+	// All the watches see the changes.
+	if _, err := conn.Update(ctx, "file", []byte("b"), version); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	for _, ch := range changes {
+		select {
+		case wd := <-ch:
+			if wd.Err != nil || string(wd.Contents) != "b" {
+				t.Errorf("watch: %s, %v, want b", wd.Contents, wd.Err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the watch")
+		}
+	}
+}
+
+func TestCacheReadYourWrites(t *testing.T) {
+	ctx := context.Background()
+	ts, _ := newCountingServer(t)
+	s := NewServer(ts)
+	defer s.Close()
+
+	created, err := s.Create(ctx, &topoproxydatapb.CreateRequest{Cell: topo.GlobalCell, FilePath: "file", Contents: []byte("a")})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	version := created.Version
+	for _, contents := range []string{"b", "c", "d"} {
+		if _, err := s.Get(ctx, &topoproxydatapb.GetRequest{Cell: topo.GlobalCell, FilePath: "file"}); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		updated, err := s.Update(ctx, &topoproxydatapb.UpdateRequest{Cell: topo.GlobalCell, FilePath: "file", Contents: []byte(contents), Version: version})
+		if err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		version = updated.Version
+		got, err := s.Get(ctx, &topoproxydatapb.GetRequest{Cell: topo.GlobalCell, FilePath: "file"})
+		if err != nil || string(got.Contents) != contents || got.Version != version {
+			t.Fatalf("Get after Update: %v, %v, want %v, %v", got, err, contents, version)
+		}
+	}
+
+	// A stale version is rejected.
+	if _, err := s.Update(ctx, &topoproxydatapb.UpdateRequest{Cell: topo.GlobalCell, FilePath: "file", Contents: []byte("e"), Version: created.Version}); !topo.IsErrType(ErrorFromGRPC(err, "file"), topo.BadVersion) {
+		t.Errorf("Update with a stale version: %v, want BadVersion", err)
+	}
+
+	if _, err := s.Delete(ctx, &topoproxydatapb.DeleteRequest{Cell: topo.GlobalCell, FilePath: "file", Version: version}); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := s.Get(ctx, &topoproxydatapb.GetRequest{Cell: topo.GlobalCell, FilePath: "file"}); !topo.IsErrType(ErrorFromGRPC(err, "file"), topo.NoNode) {
+		t.Errorf("Get after Delete: %v, want NoNode", err)
+	}
+}
+
+func TestCacheExpiration(t *testing.T) {
+	ctx := context.Background()
+	ts, f := newCountingServer(t)
+	conn, _ := ts.ConnForCell(ctx, topo.GlobalCell)
+	if _, err := conn.Create(ctx, "file", []byte("a")); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	c := newCache(ts, 100*time.Millisecond)
+	defer c.close()
+	if _, _, err := c.get(ctx, topo.GlobalCell, "file"); err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+
+	// The file is not watched any more once it is idle, and
+	// watched again when it is read again.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		n := len(c.entries)
+		c.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("idle file is still in the cache")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, _, err := c.get(ctx, topo.GlobalCell, "file"); err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if _, watches := f.counts(); watches != 2 {
+		t.Errorf("got %v watches on the topo server, want 2", watches)
+	}
+}
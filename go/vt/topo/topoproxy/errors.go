@@ -0,0 +1,85 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"errors"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// topoErrorCodes maps the topo errors a topo.Conn can return
+// to gRPC codes, so they survive the round trip.
+var topoErrorCodes = []struct {
+	topoCode topo.ErrorCode
+	grpcCode codes.Code
+}{
+	{topo.NodeExists, codes.AlreadyExists},
+	{topo.NoNode, codes.NotFound},
+	{topo.NodeNotEmpty, codes.FailedPrecondition},
+	{topo.Timeout, codes.DeadlineExceeded},
+	{topo.Interrupted, codes.Canceled},
+	{topo.BadVersion, codes.Aborted},
+	{topo.PartialResult, codes.DataLoss},
+	{topo.NoImplementation, codes.Unimplemented},
+}
+
+// ErrorToGRPC converts an error returned by a topo.Conn into a gRPC error.
+func ErrorToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, c := range topoErrorCodes {
+		if topo.IsErrType(err, c.topoCode) {
+			return status.Error(c.grpcCode, err.Error())
+		}
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// ErrorFromGRPC converts an error returned by the topo proxy
+// into the topo error for nodePath.
+func ErrorFromGRPC(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, c := range topoErrorCodes {
+		if s.Code() == c.grpcCode {
+			return topo.NewError(c.topoCode, nodePath)
+		}
+	}
+	return errors.New(s.Message())
+}
+
+// convertError converts a context error into a topo error.
+func convertError(err error, nodePath string) error {
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+	return err
+}
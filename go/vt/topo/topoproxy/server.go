@@ -0,0 +1,427 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package topoproxy contains the gRPC server side of the topo proxy.
+
+The topo proxy serves the topology to processes that use the grpc topo
+implementation (go/vt/topo/grpctopo), so they don't each need their
+own connections and watches on the topo servers:
+  - Reads and watches are served from a cache. The cache has a single
+    watch on the topo server per file, shared by all the readers and
+    watchers of the file. A file that is not read nor watched for
+    -topoproxy_cache_idle_timeout is not watched any more.
+  - Writes, locks and master elections are forwarded to the topo server.
+    A write makes the proxy read the file from the topo server until its
+    watch catches up, so a process always reads its own writes.
+  - ListDir is forwarded to the topo server.
+*/
+package topoproxy
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+	topoproxyservicepb "vitess.io/vitess/go/vt/proto/topoproxyservice"
+)
+
+var cacheIdleTimeout = flag.Duration("topoproxy_cache_idle_timeout", time.Minute, "how long the topo proxy keeps watching a file that is not read nor watched any more")
+
+// Server is the gRPC server implementation of the TopoProxy service.
+type Server struct {
+	ts    *topo.Server
+	cache *cache
+	// lockIDPrefix makes the lock ids unique across restarts.
+	lockIDPrefix string
+
+	// mu protects the following fields.
+	mu         sync.Mutex
+	nextLockID int64
+	locks      map[string]*heldLock
+	// masterLookups are the participations used to read the
+	// current master of elections, indexed by cell and name.
+	// They never run for mastership, so they hold no goroutine
+	// or watch, and cannot be stopped: Stop waits for
+	// WaitForMastership to return. Close drops them.
+	masterLookups map[string]topo.MasterParticipation
+	// closed is set by Close.
+	closed bool
+}
+
+// heldLock is a lock taken through the proxy.
+type heldLock struct {
+	ld topo.LockDescriptor
+	// released is closed by Unlock.
+	released chan struct{}
+}
+
+// NewServer returns a new topo proxy serving the given topo server.
+func NewServer(ts *topo.Server) *Server {
+	return &Server{
+		ts:           ts,
+		cache:        newCache(ts, *cacheIdleTimeout),
+		lockIDPrefix: fmt.Sprintf("%x", time.Now().UnixNano()),
+		locks:        make(map[string]*heldLock),
+
+		masterLookups: make(map[string]topo.MasterParticipation),
+	}
+}
+
+// Close stops the watches of the proxy, and releases its
+// master lookups. It can be called more than once.
+func (s *Server) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	s.masterLookups = nil
+	s.mu.Unlock()
+
+	s.cache.close()
+}
+
+// ListDir is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) ListDir(ctx context.Context, request *topoproxydatapb.ListDirRequest) (_ *topoproxydatapb.ListDirResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	entries, err := conn.ListDir(ctx, request.DirPath, request.Full)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	response := &topoproxydatapb.ListDirResponse{
+		Entries: make([]*topoproxydatapb.DirEntry, len(entries)),
+	}
+	for i, e := range entries {
+		response.Entries[i] = &topoproxydatapb.DirEntry{
+			Name:      e.Name,
+			Ephemeral: e.Ephemeral,
+		}
+		if e.Type == topo.TypeFile {
+			response.Entries[i].Type = topoproxydatapb.DirEntry_FILE
+		}
+	}
+	return response, nil
+}
+
+// Create is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Create(ctx context.Context, request *topoproxydatapb.CreateRequest) (_ *topoproxydatapb.CreateResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	version, err := conn.Create(ctx, request.FilePath, request.Contents)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	s.cache.written(request.Cell, request.FilePath, version)
+	return &topoproxydatapb.CreateResponse{
+		Version: version.String(),
+	}, nil
+}
+
+// Update is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Update(ctx context.Context, request *topoproxydatapb.UpdateRequest) (_ *topoproxydatapb.UpdateResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	version, err := backendVersion(ctx, conn, request.FilePath, request.Version)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	newVersion, err := conn.Update(ctx, request.FilePath, request.Contents, version)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	s.cache.written(request.Cell, request.FilePath, newVersion)
+	return &topoproxydatapb.UpdateResponse{
+		Version: newVersion.String(),
+	}, nil
+}
+
+// Get is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Get(ctx context.Context, request *topoproxydatapb.GetRequest) (_ *topoproxydatapb.GetResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	contents, version, err := s.cache.get(ctx, request.Cell, request.FilePath)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	return &topoproxydatapb.GetResponse{
+		Contents: contents,
+		Version:  version.String(),
+	}, nil
+}
+
+// Delete is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Delete(ctx context.Context, request *topoproxydatapb.DeleteRequest) (_ *topoproxydatapb.DeleteResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	version, err := backendVersion(ctx, conn, request.FilePath, request.Version)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	if err := conn.Delete(ctx, request.FilePath, version); err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	s.cache.written(request.Cell, request.FilePath, nil)
+	return &topoproxydatapb.DeleteResponse{}, nil
+}
+
+// backendVersion returns the topo.Version of the topo server that
+// matches the version a client sent. Versions are opaque, so the
+// file is read from the topo server to get its version. The write
+// with that version then fails if the file changed in between.
+func backendVersion(ctx context.Context, conn topo.Conn, filePath, version string) (topo.Version, error) {
+	if version == "" {
+		return nil, nil
+	}
+	_, current, err := conn.Get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if current.String() != version {
+		return nil, topo.NewError(topo.BadVersion, filePath)
+	}
+	return current, nil
+}
+
+// Lock is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Lock(request *topoproxydatapb.LockRequest, stream topoproxyservicepb.TopoProxy_LockServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	ctx := stream.Context()
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return ErrorToGRPC(err)
+	}
+	ld, err := conn.Lock(ctx, request.DirPath, request.Contents)
+	if err != nil {
+		return ErrorToGRPC(err)
+	}
+
+	s.mu.Lock()
+	s.nextLockID++
+	lockID := fmt.Sprintf("%v-%v", s.lockIDPrefix, s.nextLockID)
+	hl := &heldLock{
+		ld:       ld,
+		released: make(chan struct{}),
+	}
+	s.locks[lockID] = hl
+	s.mu.Unlock()
+
+	// Hold the lock until Unlock is called, or the client goes away.
+	if err := stream.Send(&topoproxydatapb.LockResponse{LockId: lockID}); err == nil {
+		select {
+		case <-hl.released:
+			return nil
+		case <-ctx.Done():
+		}
+	}
+	if hl := s.removeLock(lockID); hl != nil {
+		if err := hl.ld.Unlock(context.Background()); err != nil {
+			log.Errorf("failed to release lock %v of %v: %v", request.DirPath, request.Cell, err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) removeLock(lockID string) *heldLock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hl, ok := s.locks[lockID]
+	if !ok {
+		return nil
+	}
+	delete(s.locks, lockID)
+	return hl
+}
+
+// CheckLock is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) CheckLock(ctx context.Context, request *topoproxydatapb.CheckLockRequest) (_ *topoproxydatapb.CheckLockResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	s.mu.Lock()
+	hl, ok := s.locks[request.LockId]
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrorToGRPC(fmt.Errorf("lock %v is not held", request.LockId))
+	}
+	if err := hl.ld.Check(ctx); err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	return &topoproxydatapb.CheckLockResponse{}, nil
+}
+
+// Unlock is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Unlock(ctx context.Context, request *topoproxydatapb.UnlockRequest) (_ *topoproxydatapb.UnlockResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	hl := s.removeLock(request.LockId)
+	if hl == nil {
+		return nil, ErrorToGRPC(fmt.Errorf("lock %v is not held", request.LockId))
+	}
+	err = hl.ld.Unlock(ctx)
+	close(hl.released)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	return &topoproxydatapb.UnlockResponse{}, nil
+}
+
+// Watch is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) Watch(request *topoproxydatapb.WatchRequest, stream topoproxyservicepb.TopoProxy_WatchServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	ctx := stream.Context()
+	current, changes, cancel := s.cache.subscribe(ctx, request.Cell, request.FilePath)
+	if current.Err != nil {
+		return ErrorToGRPC(current.Err)
+	}
+	defer func() {
+		// changes has to be drained after cancel.
+		cancel()
+		for range changes {
+		}
+	}()
+
+	if err := stream.Send(&topoproxydatapb.WatchResponse{
+		Contents: current.Contents,
+		Version:  current.Version.String(),
+	}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case wd := <-changes:
+			if wd.Err != nil {
+				return ErrorToGRPC(wd.Err)
+			}
+			if err := stream.Send(&topoproxydatapb.WatchResponse{
+				Contents: wd.Contents,
+				Version:  wd.Version.String(),
+			}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ErrorToGRPC(convertError(ctx.Err(), request.FilePath))
+		}
+	}
+}
+
+// WaitForMastership is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) WaitForMastership(request *topoproxydatapb.WaitForMastershipRequest, stream topoproxyservicepb.TopoProxy_WaitForMastershipServer) (err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	ctx := stream.Context()
+	conn, err := s.ts.ConnForCell(ctx, request.Cell)
+	if err != nil {
+		return ErrorToGRPC(err)
+	}
+	mp, err := conn.NewMasterParticipation(request.Name, request.Id)
+	if err != nil {
+		return ErrorToGRPC(err)
+	}
+	// The participation ends when the client goes away.
+	go func() {
+		<-ctx.Done()
+		mp.Stop()
+	}()
+
+	masterCtx, err := mp.WaitForMastership()
+	if err != nil {
+		return ErrorToGRPC(err)
+	}
+	if err := stream.Send(&topoproxydatapb.WaitForMastershipResponse{}); err != nil {
+		return err
+	}
+	select {
+	case <-masterCtx.Done():
+		return ErrorToGRPC(topo.NewError(topo.Interrupted, "mastership"))
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// GetCurrentMasterID is part of the topoproxyservicepb.TopoProxyServer interface.
+func (s *Server) GetCurrentMasterID(ctx context.Context, request *topoproxydatapb.GetCurrentMasterIDRequest) (_ *topoproxydatapb.GetCurrentMasterIDResponse, err error) {
+	defer servenv.HandlePanic("topoproxy", &err)
+
+	mp, err := s.masterLookup(ctx, request.Cell, request.Name)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	id, err := mp.GetCurrentMasterID(ctx)
+	if err != nil {
+		return nil, ErrorToGRPC(err)
+	}
+	return &topoproxydatapb.GetCurrentMasterIDResponse{Id: id}, nil
+}
+
+// masterLookup returns the participation used to read the current
+// master of an election. It is created on first use.
+func (s *Server) masterLookup(ctx context.Context, cell, name string) (topo.MasterParticipation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, topo.NewError(topo.Interrupted, "topo proxy closed")
+	}
+	key := cell + "/" + name
+	if mp, ok := s.masterLookups[key]; ok {
+		return mp, nil
+	}
+	conn, err := s.ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := conn.NewMasterParticipation(name, "")
+	if err != nil {
+		return nil, err
+	}
+	s.masterLookups[key] = mp
+	return mp, nil
+}
+
+// StartServer registers a new topo proxy server instance with the gRPC server.
+func StartServer(s *grpc.Server, ts *topo.Server) *Server {
+	server := NewServer(ts)
+	topoproxyservicepb.RegisterTopoProxyServer(s, server)
+	return server
+}
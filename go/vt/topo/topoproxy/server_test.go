@@ -0,0 +1,70 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproxy
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topoproxydatapb "vitess.io/vitess/go/vt/proto/topoproxydata"
+)
+
+func TestGetCurrentMasterID(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	s := NewServer(ts)
+	defer s.Close()
+
+	conn, err := ts.ConnForCell(ctx, "cell1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp, err := conn.NewMasterParticipation("election", "master1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mp.WaitForMastership(); err != nil {
+		t.Fatal(err)
+	}
+	defer mp.Stop()
+
+	// The lookups share one participation per election.
+	for i := 0; i < 3; i++ {
+		response, err := s.GetCurrentMasterID(ctx, &topoproxydatapb.GetCurrentMasterIDRequest{Cell: "cell1", Name: "election"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Id != "master1" {
+			t.Errorf("GetCurrentMasterID: %v, want master1", response.Id)
+		}
+	}
+	if got := len(s.masterLookups); got != 1 {
+		t.Errorf("masterLookups: %d, want 1", got)
+	}
+
+	// Close releases the lookups, and no new one is created.
+	s.Close()
+	if s.masterLookups != nil {
+		t.Errorf("masterLookups after Close: %v, want nil", s.masterLookups)
+	}
+	if _, err := s.GetCurrentMasterID(ctx, &topoproxydatapb.GetCurrentMasterIDRequest{Cell: "cell1", Name: "election"}); err == nil {
+		t.Errorf("GetCurrentMasterID after Close: no error")
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"flag"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// This file tests the audit log part of the topo.Server API.
+
+// fakeAuditSink keeps the audit records in memory.
+type fakeAuditSink struct {
+	mu      sync.Mutex
+	records []*topo.AuditRecord
+}
+
+func (f *fakeAuditSink) Record(ctx context.Context, ts *topo.Server, record *topo.AuditRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.records = append(f.records, record)
+	return nil
+}
+
+var testAuditSink = &fakeAuditSink{}
+
+func init() {
+	topo.RegisterAuditSink("test", testAuditSink)
+}
+
+func setAuditSink(t *testing.T, name string) func() {
+	if err := flag.Set("topo_audit_sink", name); err != nil {
+		t.Fatalf("cannot set -topo_audit_sink: %v", err)
+	}
+	return func() {
+		flag.Set("topo_audit_sink", "")
+	}
+}
+
+func TestAuditSink(t *testing.T) {
+	defer setAuditSink(t, "test")()
+	cell := "cell1"
+	ts := memorytopo.NewServer(cell)
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), nil)
+	ctx = topo.NewAuditContext(ctx, "MigrateServedTypes ks/0 rdonly")
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: cell, Uid: 1}
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	if err := ts.UpdateSrvKeyspace(ctx, cell, "ks", &topodatapb.SrvKeyspace{}); err != nil {
+		t.Fatalf("UpdateSrvKeyspace failed: %v", err)
+	}
+	if err := ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{{FromTable: "t1", ToTables: []string{"t2"}}}}); err != nil {
+		t.Fatalf("SaveRoutingRules failed: %v", err)
+	}
+
+	var got []string
+	for _, r := range testAuditSink.records {
+		got = append(got, r.Action+" "+r.Kind+" "+r.Keyspace+"/"+r.Shard+"@"+r.Cell)
+		if r.Caller != "alice" || r.Command != "MigrateServedTypes ks/0 rdonly" {
+			t.Errorf("wrong caller or command in %v", r)
+		}
+	}
+	want := "create Keyspace ks/@,create Shard ks/0@,update Shard ks/0@,update SrvKeyspace ks/@cell1,update RoutingRules /@"
+	if strings.Join(got, ",") != want {
+		t.Errorf("got records %v, want %v", strings.Join(got, ","), want)
+	}
+	update := testAuditSink.records[2]
+	if strings.Contains(update.Before, "master_alias") || !strings.Contains(update.After, "master_alias") {
+		t.Errorf("wrong before and after of the shard update: %v", update)
+	}
+}
+
+func TestAuditTopoSink(t *testing.T) {
+	defer setAuditSink(t, "topo")()
+	ts := memorytopo.NewServer("cell1")
+	ctx := context.Background()
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	start := time.Now()
+	for _, shard := range []string{"-80", "80-"} {
+		if err := ts.CreateShard(ctx, "ks", shard); err != nil {
+			t.Fatalf("CreateShard failed: %v", err)
+		}
+	}
+	if err := ts.DeleteShard(ctx, "ks", "80-"); err != nil {
+		t.Fatalf("DeleteShard failed: %v", err)
+	}
+
+	records, err := ts.GetAuditRecords(ctx, "ks", "", time.Time{})
+	if err != nil || len(records) != 4 {
+		t.Fatalf("GetAuditRecords(ks): %v, %v, want 4 records", records, err)
+	}
+	records, err = ts.GetAuditRecords(ctx, "ks", "80-", time.Time{})
+	if err != nil || len(records) != 2 || records[0].Action != topo.AuditCreate || records[1].Action != topo.AuditDelete || records[1].Before == "" {
+		t.Fatalf("GetAuditRecords(ks/80-): %v, %v, want a create and a delete", records, err)
+	}
+	records, err = ts.GetAuditRecords(ctx, "ks", "", start)
+	if err != nil || len(records) != 3 {
+		t.Fatalf("GetAuditRecords(ks, since): %v, %v, want 3 records", records, err)
+	}
+
+	// Only the newest records are kept.
+	if err := flag.Set("topo_audit_max_records", "2"); err != nil {
+		t.Fatalf("cannot set -topo_audit_max_records: %v", err)
+	}
+	defer flag.Set("topo_audit_max_records", "1000")
+	if err := ts.DeleteShard(ctx, "ks", "-80"); err != nil {
+		t.Fatalf("DeleteShard failed: %v", err)
+	}
+	// The records are pruned in the background.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		records, err = ts.GetAuditRecords(ctx, "ks", "", time.Time{})
+		if err == nil && len(records) == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("GetAuditRecords after pruning: %v, %v, want 2 records", records, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if records[1].Shard != "-80" || records[1].Action != topo.AuditDelete {
+		t.Fatalf("GetAuditRecords after pruning: %v, want the last 2 records", records)
+	}
+}
@@ -42,14 +42,23 @@ func (ts *Server) SaveVSchema(ctx context.Context, keyspace string, vschema *vsc
 		return err
 	}
 
-	_, err = ts.globalCell.Update(ctx, nodePath, data, nil)
-	return err
+	before := auditBefore(ctx, ts.globalCell, nodePath, &vschemapb.Keyspace{})
+	if _, err := ts.globalCell.Update(ctx, nodePath, data, nil); err != nil {
+		return err
+	}
+	ts.audit(ctx, AuditVSchema, AuditUpdate, keyspace, "", "", before, vschema)
+	return nil
 }
 
 // DeleteVSchema delete the keyspace if it exists
 func (ts *Server) DeleteVSchema(ctx context.Context, keyspace string) error {
 	nodePath := path.Join(KeyspacesPath, keyspace, VSchemaFile)
-	return ts.globalCell.Delete(ctx, nodePath, nil)
+	before := auditBefore(ctx, ts.globalCell, nodePath, &vschemapb.Keyspace{})
+	if err := ts.globalCell.Delete(ctx, nodePath, nil); err != nil {
+		return err
+	}
+	ts.audit(ctx, AuditVSchema, AuditDelete, keyspace, "", "", before, nil)
+	return nil
 }
 
 // GetVSchema fetches the vschema from the topo.
@@ -97,16 +106,24 @@ func (ts *Server) SaveRoutingRules(ctx context.Context, routingRules *vschemapb.
 		return err
 	}
 
+	before := auditBefore(ctx, ts.globalCell, RoutingRulesFile, &vschemapb.RoutingRules{})
 	if len(data) == 0 {
 		// No vschema, remove it. So we can remove the keyspace.
-		if err := ts.globalCell.Delete(ctx, RoutingRulesFile, nil); err != nil && !IsErrType(err, NoNode) {
+		if err := ts.globalCell.Delete(ctx, RoutingRulesFile, nil); err != nil {
+			if IsErrType(err, NoNode) {
+				return nil
+			}
 			return err
 		}
+		ts.audit(ctx, AuditRoutingRules, AuditDelete, "", "", "", before, nil)
 		return nil
 	}
 
-	_, err = ts.globalCell.Update(ctx, RoutingRulesFile, data, nil)
-	return err
+	if _, err := ts.globalCell.Update(ctx, RoutingRulesFile, data, nil); err != nil {
+		return err
+	}
+	ts.audit(ctx, AuditRoutingRules, AuditUpdate, "", "", "", before, routingRules)
+	return nil
 }
 
 // GetRoutingRules fetches the routing rules from the topo.
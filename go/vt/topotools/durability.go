@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the durability policies of the keyspaces, which
+// say which tablets acknowledge the semi-sync writes of the master,
+// and which tablets can be promoted.
+
+// The durability policies, stored in Keyspace.DurabilityPolicy.
+const (
+	// DurabilityNone disables semi-sync, and any REPLICA
+	// tablet can be promoted.
+	DurabilityNone = "none"
+	// DurabilitySemiSync has the REPLICA tablets acknowledge the
+	// writes of the master. A REPLICA tablet can be promoted if
+	// another REPLICA tablet can acknowledge its writes.
+	DurabilitySemiSync = "semi_sync"
+	// DurabilityCrossCell is like DurabilitySemiSync, but only the
+	// REPLICA tablets in other cells than the master's acknowledge
+	// its writes, and the promoted tablet needs another REPLICA
+	// tablet in another cell.
+	DurabilityCrossCell = "cross_cell"
+)
+
+// ValidateDurabilityPolicy returns an error if policy is not a known
+// durability policy. The empty policy is valid: it means semi-sync
+// is driven by the -enable_semi_sync flag of the tablets, and the
+// candidates for promotion are not restricted.
+func ValidateDurabilityPolicy(policy string) error {
+	switch policy {
+	case "", DurabilityNone, DurabilitySemiSync, DurabilityCrossCell:
+		return nil
+	}
+	return fmt.Errorf("unknown durability policy %q, must be one of %v, %v or %v", policy, DurabilityNone, DurabilitySemiSync, DurabilityCrossCell)
+}
+
+// IsSemiSyncAcker returns true if a tablet of the given type, in the
+// given cell, acknowledges the semi-sync writes of the master under
+// the policy. With DurabilityCrossCell, only the REPLICA tablets in
+// other cells than the master's acknowledge, so the master can't
+// commit with the acknowledgement of its own cell only. All the
+// REPLICA tablets acknowledge if the cell of the master is unknown.
+func IsSemiSyncAcker(policy string, tabletType topodatapb.TabletType, cell, masterCell string) bool {
+	switch {
+	case policy != DurabilitySemiSync && policy != DurabilityCrossCell:
+		return false
+	case tabletType == topodatapb.TabletType_MASTER:
+		return true
+	case tabletType != topodatapb.TabletType_REPLICA:
+		return false
+	case policy == DurabilityCrossCell:
+		return masterCell == "" || cell != masterCell
+	}
+	return true
+}
+
+// CheckPromotable returns an error if the candidate can't be promoted
+// to master of its shard under the policy, or can't stay master if it
+// is the master already. tablets are the tablets of the shard. Delayed
+// replicas are never promoted, but no other candidate is refused with
+// the empty policy.
+func CheckPromotable(policy string, candidate *topodatapb.Tablet, tablets map[string]*topo.TabletInfo) error {
+	if candidate.MasterDelay > 0 {
+		return fmt.Errorf("tablet %v is a delayed replica (master_delay=%vs), it cannot be promoted", topoproto.TabletAliasString(candidate.Alias), candidate.MasterDelay)
+	}
+	if policy == "" {
+		return nil
+	}
+	if err := ValidateDurabilityPolicy(policy); err != nil {
+		return err
+	}
+	if candidate.Type != topodatapb.TabletType_REPLICA && candidate.Type != topodatapb.TabletType_MASTER {
+		return fmt.Errorf("tablet %v is a %v tablet, only REPLICA tablets can be promoted with the %v durability policy", topoproto.TabletAliasString(candidate.Alias), candidate.Type, policy)
+	}
+	if policy == DurabilityNone {
+		return nil
+	}
+	if !hasSemiSyncAcker(policy, candidate.Alias, tablets) {
+		return fmt.Errorf("tablet %v cannot be promoted with the %v durability policy: %v", topoproto.TabletAliasString(candidate.Alias), policy, missingAckersReason(policy))
+	}
+	return nil
+}
+
+// hasSemiSyncAcker returns true if a REPLICA tablet can acknowledge
+// the writes of the given tablet under the policy. The current master
+// counts as a REPLICA tablet, since it is demoted to REPLICA when
+// another tablet is promoted. Callers that won't demote it, because
+// it is dead, must leave it out of tablets.
+func hasSemiSyncAcker(policy string, alias *topodatapb.TabletAlias, tablets map[string]*topo.TabletInfo) bool {
+	for _, ti := range tablets {
+		if topoproto.TabletAliasEqual(ti.Alias, alias) || (ti.Type != topodatapb.TabletType_REPLICA && ti.Type != topodatapb.TabletType_MASTER) {
+			continue
+		}
+		if policy == DurabilityCrossCell && ti.Alias.Cell == alias.Cell {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func missingAckersReason(policy string) string {
+	if policy == DurabilityCrossCell {
+		return "there is no other REPLICA tablet in another cell"
+	}
+	return "there is no other REPLICA tablet"
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func durabilityTablet(cell string, uid uint32, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+	return &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{Cell: cell, Uid: uid},
+		Type:  tabletType,
+	}
+}
+
+func TestCheckPromotable(t *testing.T) {
+	master := durabilityTablet("cell1", 1, topodatapb.TabletType_MASTER)
+	replica1 := durabilityTablet("cell1", 2, topodatapb.TabletType_REPLICA)
+	replica2 := durabilityTablet("cell1", 3, topodatapb.TabletType_REPLICA)
+	replica3 := durabilityTablet("cell2", 4, topodatapb.TabletType_REPLICA)
+	rdonly := durabilityTablet("cell2", 5, topodatapb.TabletType_RDONLY)
+	delayed := durabilityTablet("cell2", 6, topodatapb.TabletType_REPLICA)
+	delayed.MasterDelay = 3600
+	tabletMap := func(tablets ...*topodatapb.Tablet) map[string]*topo.TabletInfo {
+		result := make(map[string]*topo.TabletInfo)
+		for _, tablet := range tablets {
+			result[topoproto.TabletAliasString(tablet.Alias)] = &topo.TabletInfo{Tablet: tablet}
+		}
+		return result
+	}
+
+	table := []struct {
+		desc      string
+		policy    string
+		candidate *topodatapb.Tablet
+		tablets   map[string]*topo.TabletInfo
+		wantError bool
+	}{{
+		desc:      "no policy allows rdonly",
+		policy:    "",
+		candidate: rdonly,
+		tablets:   tabletMap(master, rdonly),
+	}, {
+		desc:      "no policy refuses a delayed replica",
+		policy:    "",
+		candidate: delayed,
+		tablets:   tabletMap(master, delayed),
+		wantError: true,
+	}, {
+		desc:      "none refuses rdonly",
+		policy:    DurabilityNone,
+		candidate: rdonly,
+		tablets:   tabletMap(master, rdonly),
+		wantError: true,
+	}, {
+		desc:      "none allows a lone replica",
+		policy:    DurabilityNone,
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1),
+	}, {
+		desc:      "semi_sync needs another replica",
+		policy:    DurabilitySemiSync,
+		candidate: replica1,
+		tablets:   tabletMap(replica1, rdonly),
+		wantError: true,
+	}, {
+		desc:      "semi_sync counts the master as a replica",
+		policy:    DurabilitySemiSync,
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1, rdonly),
+	}, {
+		desc:      "semi_sync with another replica",
+		policy:    DurabilitySemiSync,
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1, replica2),
+	}, {
+		desc:      "cross_cell needs a replica in another cell",
+		policy:    DurabilityCrossCell,
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1, replica2, rdonly),
+		wantError: true,
+	}, {
+		desc:      "cross_cell with a replica in another cell",
+		policy:    DurabilityCrossCell,
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1, replica3),
+	}, {
+		desc:      "master with a replica in another cell",
+		policy:    DurabilityCrossCell,
+		candidate: master,
+		tablets:   tabletMap(master, replica3),
+	}, {
+		desc:      "unknown policy",
+		policy:    "unknown",
+		candidate: replica1,
+		tablets:   tabletMap(master, replica1, replica3),
+		wantError: true,
+	}}
+	for _, tcase := range table {
+		err := CheckPromotable(tcase.policy, tcase.candidate, tcase.tablets)
+		if (err != nil) != tcase.wantError {
+			t.Errorf("%v: CheckPromotable() = %v, want error %v", tcase.desc, err, tcase.wantError)
+		}
+	}
+}
+
+func TestIsSemiSyncAcker(t *testing.T) {
+	table := []struct {
+		policy     string
+		tabletType topodatapb.TabletType
+		cell       string
+		masterCell string
+		want       bool
+	}{
+		{"", topodatapb.TabletType_REPLICA, "cell1", "cell1", false},
+		{DurabilityNone, topodatapb.TabletType_REPLICA, "cell1", "cell1", false},
+		{DurabilitySemiSync, topodatapb.TabletType_REPLICA, "cell1", "cell1", true},
+		{DurabilitySemiSync, topodatapb.TabletType_MASTER, "cell1", "cell1", true},
+		{DurabilitySemiSync, topodatapb.TabletType_RDONLY, "cell1", "cell1", false},
+		{DurabilityCrossCell, topodatapb.TabletType_REPLICA, "cell1", "cell1", false},
+		{DurabilityCrossCell, topodatapb.TabletType_REPLICA, "cell2", "cell1", true},
+		{DurabilityCrossCell, topodatapb.TabletType_REPLICA, "cell1", "", true},
+		{DurabilityCrossCell, topodatapb.TabletType_MASTER, "cell1", "cell1", true},
+		{DurabilityCrossCell, topodatapb.TabletType_RDONLY, "cell2", "cell1", false},
+	}
+	for _, tcase := range table {
+		if got := IsSemiSyncAcker(tcase.policy, tcase.tabletType, tcase.cell, tcase.masterCell); got != tcase.want {
+			t.Errorf("IsSemiSyncAcker(%q, %v, %v, %v) = %v, want %v", tcase.policy, tcase.tabletType, tcase.cell, tcase.masterCell, got, tcase.want)
+		}
+	}
+}
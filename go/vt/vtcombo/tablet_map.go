@@ -337,9 +337,9 @@ func (itc *internalTabletConn) Begin(ctx context.Context, target *querypb.Target
 }
 
 // Commit is part of queryservice.QueryService
-func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
-	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	sessionStateChanges, err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
+	return sessionStateChanges, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // Rollback is part of queryservice.QueryService
@@ -676,6 +676,10 @@ func (itmc *internalTabletManagerClient) ResetReplication(ctx context.Context, t
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
@@ -724,9 +728,29 @@ func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) SetBackupMaxRate(ctx context.Context, tablet *topodatapb.Tablet, maxRate int64) (int64, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return 0, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SetBackupMaxRate(ctx, maxRate)
+}
+
+func (itmc *internalTabletManagerClient) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, names []string) (map[string]string, map[string]string, error) {
+	return nil, nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ApplyMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	return nil, nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) RestartMysqld(ctx context.Context, tablet *topodatapb.Tablet, upgradeHook string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) Close() {
 }
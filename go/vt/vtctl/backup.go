@@ -21,9 +21,12 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strconv"
+	"time"
 
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -34,8 +37,8 @@ func init() {
 	addCommand("Shards", command{
 		"ListBackups",
 		commandListBackups,
-		"<keyspace/shard>",
-		"Lists all the backups for a shard."})
+		"[-verification] <keyspace/shard>",
+		"Lists all the backups for a shard. With -verification, also displays the status of the last verification of each backup by vtbackup -verify_backup."})
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
@@ -46,6 +49,11 @@ func init() {
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"CopyBackups",
+		commandCopyBackups,
+		"[-binlog_archives] <source storage> <destination storage> <keyspace/shard> [<backup name> ...]",
+		"Copies the named backups of a shard from a backup storage to another one, or all the complete backups missing from the destination if no backup is named. The storages are named like -backup_storage_implementation, or <implementation>:<parameter> for the implementations taking one, like file:<root directory>. Incomplete copies in the destination are copied again. With -binlog_archives, copies the archived binlogs of the shard instead."})
 
 	addCommand("Tablets", command{
 		"Backup",
@@ -55,8 +63,13 @@ func init() {
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_time=<RFC3339 time>] [-restore_to_pos=<position>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_time or -restore_to_pos, restores the data to that point in time from the archived binlogs: the tablet is then DRAINED and doesn't replicate."})
+	addCommand("Tablets", command{
+		"SetBackupMaxRate",
+		commandSetBackupMaxRate,
+		"<tablet alias> <bytes per second>",
+		"Changes the rate limit of the backups and restores of a tablet, including the running ones. 0 means no limit. The limit is reset to -backup_max_rate when the tablet restarts."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -169,6 +182,7 @@ func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.T
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	verification := subFlags.Bool("verification", false, "Displays the verification status of each backup")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -191,8 +205,27 @@ func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if err != nil {
 		return err
 	}
+	if !*verification {
+		for _, bh := range bhs {
+			wr.Logger().Printf("%v\n", bh.Name())
+		}
+		return nil
+	}
+
+	verifications, err := mysqlctl.GetBackupVerifications(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
 	for _, bh := range bhs {
-		wr.Logger().Printf("%v\n", bh.Name())
+		v, ok := verifications[bh.Name()]
+		if !ok {
+			wr.Logger().Printf("%v unverified\n", bh.Name())
+			continue
+		}
+		wr.Logger().Printf("%v %v %v\n", bh.Name(), v.Status, v.VerifiedTime)
+		for _, verr := range v.Errors {
+			wr.Logger().Printf("  %v\n", verr)
+		}
 	}
 	return nil
 }
@@ -221,6 +254,8 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 }
 
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToTimeStr := subFlags.String("restore_to_time", "", "Restores the data to this time, in RFC3339 format, from the archived binlogs")
+	restoreToPos := subFlags.String("restore_to_pos", "", "Restores the data to this replication position from the archived binlogs")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -232,11 +267,21 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
+	var restoreToTime time.Time
+	if *restoreToTimeStr != "" {
+		restoreToTime, err = time.Parse(time.RFC3339, *restoreToTimeStr)
+		if err != nil {
+			return fmt.Errorf("cannot parse -restore_to_time %v: %v", *restoreToTimeStr, err)
+		}
+	}
+	if !restoreToTime.IsZero() && *restoreToPos != "" {
+		return fmt.Errorf("only one of -restore_to_time and -restore_to_pos can be set")
+	}
 	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, restoreToTime, *restoreToPos)
 	if err != nil {
 		return err
 	}
@@ -252,3 +297,66 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 		}
 	}
 }
+
+func commandSetBackupMaxRate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the SetBackupMaxRate command requires the <tablet alias> and <bytes per second> arguments")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	maxRate, err := strconv.ParseInt(subFlags.Arg(1), 10, 64)
+	if err != nil || maxRate < 0 {
+		return fmt.Errorf("invalid <bytes per second> %v: must be a non-negative integer", subFlags.Arg(1))
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	previousMaxRate, err := wr.TabletManagerClient().SetBackupMaxRate(ctx, tabletInfo.Tablet, maxRate)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Changed the backup max rate of %v from %v to %v bytes per second\n", topoproto.TabletAliasString(tabletAlias), previousMaxRate, maxRate)
+	return nil
+}
+
+func commandCopyBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	binlogArchives := subFlags.Bool("binlog_archives", false, "Copies the archived binlogs of the shard instead of its backups")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 3 {
+		return fmt.Errorf("action CopyBackups requires <source storage> <destination storage> <keyspace/shard> [<backup name> ...]")
+	}
+
+	src, err := backupstorage.GetBackupStorageByName(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := backupstorage.GetBackupStorageByName(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(2))
+	if err != nil {
+		return err
+	}
+	dir := mysqlctl.GetBackupDir(keyspace, shard)
+	if *binlogArchives {
+		dir = mysqlctl.GetBinlogArchiveDir(keyspace, shard)
+	}
+
+	copied, err := mysqlctl.SyncBackups(ctx, src, dst, dir, subFlags.Args()[3:], wr.Logger())
+	for _, name := range copied {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return err
+}
@@ -23,8 +23,13 @@ package grpcvtctlserver
 import (
 	"sync"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
@@ -33,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
@@ -74,7 +80,33 @@ func (s *VtctlServer) ExecuteVtctlCommand(args *vtctldatapb.ExecuteVtctlCommandR
 	wr := wrangler.New(logger, s.ts, tmc)
 
 	// execute the command
-	return vtctl.RunCommand(stream.Context(), wr, args.Args)
+	return vtctl.RunCommand(callerContext(stream.Context()), wr, args.Args)
+}
+
+// callerContext returns a context with the immediate caller of a
+// command, for the topo audit log: the user authenticated by the
+// 'static' gRPC auth plugin, the common name of the verified client
+// certificate, or the address of the peer.
+func callerContext(ctx context.Context) context.Context {
+	if callerid.EffectiveCallerIDFromContext(ctx) != nil || callerid.ImmediateCallerIDFromContext(ctx) != nil {
+		return ctx
+	}
+	username := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok && *servenv.GRPCAuth == "static" && len(md["username"]) != 0 {
+		username = md["username"][0]
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && username == "" && len(tlsInfo.State.VerifiedChains) != 0 && len(tlsInfo.State.VerifiedChains[0]) != 0 {
+			username = tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+		}
+		if username == "" && p.Addr != nil {
+			username = p.Addr.String()
+		}
+	}
+	if username == "" {
+		return ctx
+	}
+	return callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: username})
 }
 
 // StartServer registers the VtctlServer for RPCs
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+func init() {
+	addCommand("Tablets", command{
+		"GetMysqlVariables",
+		commandGetMysqlVariables,
+		"<tablet alias> [<variable name> ...]",
+		"Displays the effective global variables of the mysqld of a tablet, all of them if none is named, and the ones persisted in its my.cnf by ApplyMysqlVariables."})
+	addCommand("Shards", command{
+		"ApplyMysqlVariables",
+		commandApplyMysqlVariables,
+		"[-allow_restart] <keyspace|keyspace/shard> <name=value> ...",
+		"Changes global variables of the mysqld of all the tablets of a shard, or of all the shards of a keyspace, one tablet at a time and the masters last, and persists them in their my.cnf so they survive the restarts. The variables which are not dynamic are rejected, unless -allow_restart is set: they are then only persisted, and the tablets needing a restart of mysqld to apply them are listed. The rolling_restart workflow restarts them without downtime."})
+}
+
+func commandGetMysqlVariables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetMysqlVariables command")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	variables, persisted, err := wr.TabletManagerClient().GetMysqlVariables(ctx, tabletInfo.Tablet, subFlags.Args()[1:])
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), map[string]map[string]string{
+		"variables": variables,
+		"persisted": persisted,
+	})
+}
+
+func commandApplyMysqlVariables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	allowRestart := subFlags.Bool("allow_restart", false, "Persists the variables which are not dynamic, they are applied at the next restart of mysqld")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <keyspace|keyspace/shard> and <name=value> arguments are required for the ApplyMysqlVariables command")
+	}
+
+	keyspace, shard := subFlags.Arg(0), ""
+	if strings.Contains(keyspace, "/") {
+		var err error
+		keyspace, shard, err = topoproto.ParseKeyspaceShard(keyspace)
+		if err != nil {
+			return err
+		}
+	}
+	variables := make(map[string]string)
+	for _, arg := range subFlags.Args()[1:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid variable %q, expected <name=value>", arg)
+		}
+		variables[parts[0]] = parts[1]
+	}
+
+	restartRequired, err := wr.ApplyMysqlVariables(ctx, keyspace, shard, variables, *allowRestart)
+	if len(restartRequired) > 0 {
+		aliases := make([]string, 0, len(restartRequired))
+		for alias := range restartRequired {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+		for _, alias := range aliases {
+			wr.Logger().Printf("%v needs a restart of mysqld to apply %v\n", alias, strings.Join(restartRequired[alias], ", "))
+		}
+	}
+	return err
+}
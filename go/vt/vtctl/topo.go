@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
 
@@ -29,6 +31,8 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -53,6 +57,24 @@ func init() {
 		commandTopoCp,
 		"[-cell <cell>] [-to_topo] <src> <dst>",
 		"Copies a file from topo to local file structure, or the other way around"})
+
+	addCommand(topoGroupName, command{
+		"TopoExport",
+		commandTopoExport,
+		"[-cells <cell1>,<cell2>,...] <archive file>",
+		"Exports the topology of the global cell and the given cells (all cells by default) to an archive file. The archive is JSON, with the decoded protos of the files, so two archives can be compared with diff."})
+
+	addCommand(topoGroupName, command{
+		"TopoImport",
+		commandTopoImport,
+		"[-dry_run] [-diff] [-delete_extra] <archive file>",
+		"Restores the topology from an archive file written by TopoExport. It displays the files it creates, updates or deletes, and with -diff how their contents change. With -dry_run, it doesn't change the topology."})
+
+	addCommand(topoGroupName, command{
+		"GetTopoAuditLog",
+		commandGetTopoAuditLog,
+		"[-since <duration>] [<keyspace> | <keyspace/shard>]",
+		"Displays the changes made to a keyspace or a shard, or to the routing rules if no keyspace is given. The changes are recorded when the processes that make them run with -topo_audit_sink topo."})
 }
 
 // DecodeContent uses the filename to imply a type, and proto-decodes
@@ -175,6 +197,97 @@ func copyFileToTopo(ctx context.Context, ts *topo.Server, cell, from, to string)
 	return err
 }
 
+func commandTopoExport(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cellsStr := subFlags.String("cells", "", "comma-separated list of cells to export, in addition to the global cell. Defaults to all cells.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <archive file> argument is required for the TopoExport command")
+	}
+	var cells []string
+	if *cellsStr != "" {
+		cells = strings.Split(*cellsStr, ",")
+	}
+
+	archive, err := helpers.ExportArchive(ctx, wr.TopoServer(), cells)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(subFlags.Arg(0), append(data, '\n'), 0644)
+}
+
+func commandTopoImport(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "only displays the changes, without making them")
+	diff := subFlags.Bool("diff", false, "displays how the contents of the files change")
+	deleteExtra := subFlags.Bool("delete_extra", false, "deletes the files that are not in the archive, in the cells of the archive")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <archive file> argument is required for the TopoImport command")
+	}
+
+	data, err := ioutil.ReadFile(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	archive := &helpers.Archive{}
+	if err := json.Unmarshal(data, archive); err != nil {
+		return fmt.Errorf("cannot decode archive %v: %v", subFlags.Arg(0), err)
+	}
+	changes, err := helpers.DiffArchive(ctx, wr.TopoServer(), archive, *deleteExtra)
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		wr.Logger().Printf("%v\n", c)
+		if *diff {
+			wr.Logger().Printf("%v", c.Diff())
+		}
+	}
+	if *dryRun {
+		wr.Logger().Printf("dry run: %v changes not made\n", len(changes))
+		return nil
+	}
+	return helpers.ApplyArchiveChanges(ctx, wr.TopoServer(), changes)
+}
+
+func commandGetTopoAuditLog(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	since := subFlags.Duration("since", 0, "only displays the changes made in that duration. Defaults to all the recorded changes.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() > 1 {
+		return fmt.Errorf("the GetTopoAuditLog command takes at most a <keyspace> or <keyspace/shard> argument")
+	}
+	var keyspace, shard string
+	if subFlags.NArg() == 1 {
+		keyspace = subFlags.Arg(0)
+		if strings.Contains(keyspace, "/") {
+			var err error
+			keyspace, shard, err = topoproto.ParseKeyspaceShard(keyspace)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	var sinceTime time.Time
+	if *since > 0 {
+		sinceTime = time.Now().Add(-*since)
+	}
+
+	records, err := wr.TopoServer().GetAuditRecords(ctx, keyspace, shard, sinceTime)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), records)
+}
+
 type TopologyDecoder interface {
 	decode([]string, topo.Conn, context.Context, *wrangler.Wrangler, bool) error
 }
@@ -187,6 +187,9 @@ var commands = []commandGroup{
 			{"StopSlave", commandStopSlave,
 				"<tablet alias>",
 				"Stops replication on the specified slave."},
+			{"StopDelayedReplica", commandStopDelayedReplica,
+				"{-until_gtid=<gtid> || -until_time=<RFC3339 time>} [-wait_timeout=1h] <tablet alias>",
+				"Stops the replication of a delayed replica just before the transaction with the given GTID, or after it applied the transactions committed on the master until the given time, and prints its position. Use StartSlave to resume the replication with its delay."},
 			{"ChangeSlaveType", commandChangeSlaveType,
 				"[-dry-run] <tablet alias> <tablet type>",
 				"Changes the db type for the specified tablet, if possible. This command is used primarily to arrange replicas, and it will not convert a master.\n" +
@@ -268,6 +271,12 @@ var commands = []commandGroup{
 			{"ShardReplicationFix", commandShardReplicationFix,
 				"<cell> <keyspace/shard>",
 				"Walks through a ShardReplication object and fixes the first error that it encounters."},
+			{"ValidateReplication", commandValidateReplication,
+				"<keyspace/shard>",
+				"Checks that the replicas of the shard replicate from its master, and have no errant transactions (transactions the master doesn't have). Outputs the problems found as JSON."},
+			{"RepairReplication", commandRepairReplication,
+				"[-inject_empty_transactions] [-quarantine] <keyspace/shard>",
+				"Fixes the problems found by ValidateReplication. The replicas that replicate from the wrong server, or don't replicate, are pointed at the master. With -inject_empty_transactions, the errant transactions of the replicas are injected as empty transactions on the master (MySQL 5.6+ GTIDs only), the changes they made stay on the replicas. Otherwise, with -quarantine, the replicas with errant transactions are changed to DRAINED. Outputs the problems found and their repairs as JSON."},
 			{"WaitForFilteredReplication", commandWaitForFilteredReplication,
 				"[-max_delay <max_delay, default 30s>] <keyspace/shard>",
 				"Blocks until the specified shard has caught up with the filtered replication of its source shard."},
@@ -282,8 +291,8 @@ var commands = []commandGroup{
 	{
 		"Keyspaces", []command{
 			{"CreateKeyspace", commandCreateKeyspace,
-				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] <keyspace name>",
-				"Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00"},
+				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] [-durability_policy=policy] <keyspace name>",
+				"Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00. durability_policy can be none, semi_sync or cross_cell, see SetKeyspaceDurabilityPolicy."},
 			{"DeleteKeyspace", commandDeleteKeyspace,
 				"[-recursive] <keyspace>",
 				"Deletes the specified keyspace. In recursive mode, it also recursively deletes all shards in the keyspace. Otherwise, there must be no shards left in the keyspace."},
@@ -299,6 +308,9 @@ var commands = []commandGroup{
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
+			{"SetKeyspaceDurabilityPolicy", commandSetKeyspaceDurabilityPolicy,
+				"<keyspace name> <policy>",
+				"Sets the durability policy of a keyspace, which can be none, semi_sync or cross_cell. With none, semi-sync is disabled. With semi_sync, the REPLICA tablets acknowledge the writes of the master, and a tablet can only be promoted if another REPLICA tablet can acknowledge its writes. cross_cell is like semi_sync, but that REPLICA tablet must be in another cell. An empty policy uses the -enable_semi_sync flag of the tablets, and doesn't restrict the reparents. Tablets read the new policy when their state is refreshed (see RefreshStateByShard), and apply it the next time their replication is configured, for instance during the next reparent."},
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
@@ -881,6 +893,38 @@ func commandStopSlave(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return wr.TabletManagerClient().StopSlave(ctx, ti.Tablet)
 }
 
+func commandStopDelayedReplica(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	untilGTID := subFlags.String("until_gtid", "", "Stops the replication just before the transaction with this GTID")
+	untilTimeStr := subFlags.String("until_time", "", "Stops the replication after the transactions committed on the master until this time, in RFC3339 format")
+	waitTimeout := subFlags.Duration("wait_timeout", time.Hour, "The maximum time to wait for the replication to reach the stop point")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the StopDelayedReplica command")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	var untilTime time.Time
+	if *untilTimeStr != "" {
+		untilTime, err = time.Parse(time.RFC3339, *untilTimeStr)
+		if err != nil {
+			return fmt.Errorf("cannot parse -until_time %v: %v", *untilTimeStr, err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, *waitTimeout)
+	defer cancel()
+	position, err := wr.StopDelayedReplica(ctx, tabletAlias, *untilGTID, untilTime)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("%v\n", position)
+	return nil
+}
+
 func commandChangeSlaveType(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	dryRun := subFlags.Bool("dry-run", false, "Lists the proposed change without actually executing it")
 
@@ -1479,6 +1523,64 @@ func commandShardReplicationFix(ctx context.Context, wr *wrangler.Wrangler, subF
 	return topo.FixShardReplication(ctx, wr.TopoServer(), wr.Logger(), cell, keyspace, shard)
 }
 
+func commandValidateReplication(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the ValidateReplication command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	problems, err := wr.ValidateReplication(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if err := printJSON(wr.Logger(), problems); err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %v replication problems in shard %v/%v", len(problems), keyspace, shard)
+	}
+	return nil
+}
+
+func commandRepairReplication(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	injectEmptyTransactions := subFlags.Bool("inject_empty_transactions", false, "Injects the errant transactions of the replicas as empty transactions on the master")
+	quarantine := subFlags.Bool("quarantine", false, "Changes the replicas with errant transactions to DRAINED, unless -inject_empty_transactions is set")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the RepairReplication command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	problems, err := wr.RepairReplication(ctx, keyspace, shard, *injectEmptyTransactions, *quarantine)
+	if err != nil {
+		return err
+	}
+	if err := printJSON(wr.Logger(), problems); err != nil {
+		return err
+	}
+	unrepaired := 0
+	for _, p := range problems {
+		if p.Repair == "" {
+			unrepaired++
+		}
+	}
+	if unrepaired > 0 {
+		return fmt.Errorf("%v replication problems in shard %v/%v were not repaired", unrepaired, keyspace, shard)
+	}
+	return nil
+}
+
 func commandWaitForFilteredReplication(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	maxDelay := subFlags.Duration("max_delay", wrangler.DefaultWaitForFilteredReplicationMaxDelay,
 		"Specifies the maximum delay, in seconds, the filtered replication of the"+
@@ -1554,6 +1656,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	keyspaceType := subFlags.String("keyspace_type", "", "Specifies the type of the keyspace")
 	baseKeyspace := subFlags.String("base_keyspace", "", "Specifies the base keyspace for a snapshot keyspace")
 	timestampStr := subFlags.String("snapshot_time", "", "Specifies the snapshot time for this keyspace")
+	durabilityPolicy := subFlags.String("durability_policy", "", "Specifies the durability policy of the keyspace: none, semi_sync or cross_cell")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1566,6 +1669,9 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	if err != nil {
 		return err
 	}
+	if err := topotools.ValidateDurabilityPolicy(*durabilityPolicy); err != nil {
+		return err
+	}
 	ktype := topodatapb.KeyspaceType_NORMAL
 	if *keyspaceType != "" {
 		kt, err := topoproto.ParseKeyspaceType(*keyspaceType)
@@ -1600,6 +1706,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 		KeyspaceType:       ktype,
 		BaseKeyspace:       *baseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   *durabilityPolicy,
 	}
 	if len(servedFrom) > 0 {
 		for name, value := range servedFrom {
@@ -1738,6 +1845,17 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <policy> arguments are required for the SetKeyspaceDurabilityPolicy command")
+	}
+
+	return wr.SetKeyspaceDurabilityPolicy(ctx, subFlags.Arg(0), subFlags.Arg(1))
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -2845,6 +2963,8 @@ func RunCommand(ctx context.Context, wr *wrangler.Wrangler, args []string) error
 					wr.Logger().Printf("%s\n\n", cmd.help)
 					subFlags.PrintDefaults()
 				}
+				// Record the command in the topo audit log.
+				ctx = topo.NewAuditContext(ctx, auditCommand(args))
 				return cmd.method(ctx, wr, subFlags, args[1:])
 			}
 		}
@@ -2854,6 +2974,26 @@ func RunCommand(ctx context.Context, wr *wrangler.Wrangler, args []string) error
 	return ErrUnknownCommand
 }
 
+// auditCommand returns the command line to record in the topo audit
+// log. Positional arguments and flag values can carry SQL or secrets,
+// so only the command name and the flag names are kept.
+func auditCommand(args []string) string {
+	parts := []string{args[0]}
+	for _, arg := range args[1:] {
+		if arg == "--" || arg == "-" || !strings.HasPrefix(arg, "-") {
+			// Everything after the first positional argument is
+			// positional too, as in flag.Parse.
+			break
+		}
+		name := arg
+		if i := strings.Index(name, "="); i >= 0 {
+			name = name[:i] + "=<redacted>"
+		}
+		parts = append(parts, name)
+	}
+	return strings.Join(parts, " ")
+}
+
 // PrintAllCommands will print the list of commands to the logger
 func PrintAllCommands(logger logutil.Logger) {
 	for _, group := range commands {
@@ -0,0 +1,43 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import "testing"
+
+func TestAuditCommand(t *testing.T) {
+	testcases := []struct {
+		args []string
+		want string
+	}{{
+		args: []string{"RebuildKeyspaceGraph", "ks"},
+		want: "RebuildKeyspaceGraph",
+	}, {
+		args: []string{"ApplySchema", "-sql=alter table t add column c int", "ks"},
+		want: "ApplySchema -sql=<redacted>",
+	}, {
+		args: []string{"ExecuteFetchAsDba", "-max_rows", "10", "cell-0000000100", "select password from users"},
+		want: "ExecuteFetchAsDba -max_rows",
+	}, {
+		args: []string{"SetKeyspaceShardingInfo", "-force", "--", "ks", "-secret"},
+		want: "SetKeyspaceShardingInfo -force",
+	}}
+	for _, tc := range testcases {
+		if got := auditCommand(tc.args); got != tc.want {
+			t.Errorf("auditCommand(%v): %q, want %q", tc.args, got, tc.want)
+		}
+	}
+}
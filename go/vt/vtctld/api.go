@@ -29,6 +29,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/schemamanager"
@@ -41,6 +42,7 @@ import (
 
 	"vitess.io/vitess/go/vt/mysqlctl"
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -500,7 +502,9 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		logstream := logutil.NewMemoryLogger()
 
 		wr := wrangler.New(logstream, ts, tmClient)
-		err := vtctl.RunCommand(r.Context(), wr, args)
+		// The HTTP client is the caller recorded in the topo audit log.
+		ctx := callerid.NewContext(r.Context(), nil, &querypb.VTGateCallerID{Username: r.RemoteAddr})
+		err := vtctl.RunCommand(ctx, wr, args)
 		if err != nil {
 			resp.Error = err.Error()
 		}
@@ -0,0 +1,85 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/recovery"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var enableRecovery = flag.Bool("enable_recovery", false, "if set, vtctld runs the recovery daemon, which replaces the failed masters with EmergencyReparentShard. A topology server-based master election makes sure only one vtctld runs it at a time.")
+
+func initRecovery(ts *topo.Server) {
+	if !*enableRecovery {
+		return
+	}
+
+	tmc := tmclient.NewTabletManagerClient()
+	daemon := recovery.NewDaemon(ts, tmc, wrangler.New(logutil.NewConsoleLogger(), ts, tmc))
+	http.Handle(apiPrefix+"recovery/events", daemon)
+
+	var mp topo.MasterParticipation
+
+	// We use servenv.ListeningURL which is only populated during Run,
+	// so we have to start this with OnRun.
+	servenv.OnRun(func() {
+		conn, err := ts.ConnForCell(context.Background(), topo.GlobalCell)
+		if err != nil {
+			log.Errorf("Cannot get global cell topo connection, disabling the recovery daemon: %v", err)
+			return
+		}
+
+		mp, err = conn.NewMasterParticipation("recovery", servenv.ListeningURL.Host)
+		if err != nil {
+			log.Errorf("Cannot start MasterParticipation, disabling the recovery daemon: %v", err)
+			return
+		}
+
+		go func() {
+			for {
+				ctx, err := mp.WaitForMastership()
+				switch {
+				case err == nil:
+					daemon.Run(ctx)
+				case topo.IsErrType(err, topo.Interrupted):
+					return
+				default:
+					log.Errorf("Got error while waiting for master, will retry in 5s: %v", err)
+					time.Sleep(5 * time.Second)
+				}
+			}
+		}()
+	})
+
+	// When we get killed, clean up.
+	servenv.OnTermSync(func() {
+		if mp != nil {
+			mp.Stop()
+		}
+	})
+}
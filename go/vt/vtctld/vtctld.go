@@ -179,4 +179,7 @@ func InitVtctld(ts *topo.Server) {
 
 	// Init workflow manager.
 	initWorkflowManager(ts)
+
+	// Init the recovery daemon.
+	initRecovery(ts)
 }
@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/workflow"
 	"vitess.io/vitess/go/vt/workflow/resharding"
 	"vitess.io/vitess/go/vt/workflow/reshardingworkflowgen"
+	"vitess.io/vitess/go/vt/workflow/rollingrestart"
 	"vitess.io/vitess/go/vt/workflow/topovalidator"
 )
 
@@ -64,6 +65,9 @@ func initWorkflowManager(ts *topo.Server) {
 		// Register workflow that generates Horizontal Resharding workflows.
 		reshardingworkflowgen.Register()
 
+		// Register the Rolling Restart workflow.
+		rollingrestart.Register()
+
 		// Unregister the blacklisted workflows.
 		for _, name := range workflowManagerDisable {
 			workflow.Unregister(name)
@@ -0,0 +1,259 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file implements the commands that manage the messages that
+// vttablet moved to the dead letter table of a message table after
+// they exceeded its max epoch. The dead letter table has the same
+// columns as the message table. The commands are exposed by the
+// vtgate REST API, see initDeadLetterAPI.
+
+// reservedMessageColumns are the message table columns that are
+// managed by vttablet and must not be specified on insert.
+var reservedMessageColumns = map[string]bool{
+	"time_next":    true,
+	"epoch":        true,
+	"time_created": true,
+	"time_acked":   true,
+}
+
+// messageTablesQuery returns the name and comment of the message
+// tables of a keyspace.
+const messageTablesQuery = "select table_name, table_comment from information_schema.tables where table_schema = database() and table_comment like 'vitess_message%'"
+
+// deadLetterMessageTables returns the message tables of the keyspace
+// that use deadLetterTable as their dead letter table. It fails if
+// there are none, so the commands below can only access the tables
+// that were configured as dead letter tables.
+func (e *Executor) deadLetterMessageTables(ctx context.Context, keyspace, deadLetterTable string) ([]string, error) {
+	qr, err := e.Execute(ctx, "DeadLetterTables", deadLetterSession(keyspace), messageTablesQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, row := range qr.Rows {
+		// The comment format is parsed by vttablet in loadMessageInfo.
+		for _, input := range strings.Split(row[1].ToString(), ",") {
+			if input == "vt_dead_letter_table="+deadLetterTable {
+				names = append(names, row[0].ToString())
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v is not the dead letter table of a message table in keyspace %v", deadLetterTable, keyspace)
+	}
+	return names, nil
+}
+
+// ListDeadLetters returns the messages of a dead letter table.
+func (e *Executor) ListDeadLetters(ctx context.Context, keyspace, deadLetterTable string) (*sqltypes.Result, error) {
+	if _, err := e.deadLetterMessageTables(ctx, keyspace, deadLetterTable); err != nil {
+		return nil, err
+	}
+	query := sqlparser.BuildParsedQuery("select * from %v order by id", sqlparser.NewTableIdent(deadLetterTable)).Query
+	return e.Execute(ctx, "ListDeadLetters", deadLetterSession(keyspace), query, nil)
+}
+
+// PurgeDeadLetters deletes messages from a dead letter table.
+// It returns the number of messages deleted.
+func (e *Executor) PurgeDeadLetters(ctx context.Context, keyspace, deadLetterTable string, ids []*querypb.Value) (int64, error) {
+	if _, err := e.deadLetterMessageTables(ctx, keyspace, deadLetterTable); err != nil {
+		return 0, err
+	}
+	query := sqlparser.BuildParsedQuery("delete from %v where id in %a", sqlparser.NewTableIdent(deadLetterTable), "::ids").Query
+	qr, err := e.Execute(ctx, "PurgeDeadLetters", deadLetterSession(keyspace), query, idsBindVars(ids))
+	if err != nil {
+		return 0, err
+	}
+	return int64(qr.RowsAffected), nil
+}
+
+// RequeueDeadLetters moves messages from a dead letter table back to
+// the message table they came from. The messages are delivered again
+// as if they were new. It returns the number of messages moved.
+func (e *Executor) RequeueDeadLetters(ctx context.Context, keyspace, deadLetterTable, name string, ids []*querypb.Value) (count int64, err error) {
+	const method = "RequeueDeadLetters"
+	names, err := e.deadLetterMessageTables(ctx, keyspace, deadLetterTable)
+	if err != nil {
+		return 0, err
+	}
+	found := false
+	for _, n := range names {
+		if n == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v is not the dead letter table of message table %v", deadLetterTable, name)
+	}
+	safeSession := deadLetterSession(keyspace)
+	if _, err := e.Execute(ctx, method, safeSession, "begin", nil); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if safeSession.InTransaction() {
+			e.Execute(ctx, method, safeSession, "rollback", nil)
+		}
+	}()
+
+	bindVars := idsBindVars(ids)
+	dlqName := sqlparser.NewTableIdent(deadLetterTable)
+	query := sqlparser.BuildParsedQuery("select * from %v where id in %a for update", dlqName, "::ids").Query
+	qr, err := e.Execute(ctx, method, safeSession, query, bindVars)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) == 0 {
+		return 0, nil
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v(", sqlparser.NewTableIdent(name))
+	var columns []int
+	for i, field := range qr.Fields {
+		if reservedMessageColumns[sqlparser.NewColIdent(field.Name).Lowered()] {
+			continue
+		}
+		if len(columns) != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(field.Name))
+		columns = append(columns, i)
+	}
+	buf.Myprintf(") values ")
+	insertBindVars := make(map[string]*querypb.BindVariable)
+	for i, row := range qr.Rows {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("(")
+		for j, col := range columns {
+			if j != 0 {
+				buf.Myprintf(", ")
+			}
+			bvName := fmt.Sprintf("r%dc%d", i, j)
+			buf.Myprintf(":%s", bvName)
+			insertBindVars[bvName] = sqltypes.ValueBindVariable(row[col])
+		}
+		buf.Myprintf(")")
+	}
+	if _, err := e.Execute(ctx, method, safeSession, buf.String(), insertBindVars); err != nil {
+		return 0, err
+	}
+
+	query = sqlparser.BuildParsedQuery("delete from %v where id in %a", dlqName, "::ids").Query
+	if _, err := e.Execute(ctx, method, safeSession, query, bindVars); err != nil {
+		return 0, err
+	}
+	if _, err := e.Execute(ctx, method, safeSession, "commit", nil); err != nil {
+		return 0, err
+	}
+	return int64(len(qr.Rows)), nil
+}
+
+func deadLetterSession(keyspace string) *SafeSession {
+	return NewSafeSession(&vtgatepb.Session{TargetString: keyspace + "@master"})
+}
+
+func idsBindVars(ids []*querypb.Value) map[string]*querypb.BindVariable {
+	return map[string]*querypb.BindVariable{
+		"ids": {
+			Type:   querypb.Type_TUPLE,
+			Values: ids,
+		},
+	}
+}
+
+// initDeadLetterAPI registers the dead letter commands in the REST API.
+// A GET on /api/dead-letters/<keyspace>/<dead letter table> lists the
+// messages, and requires the DEBUGGING role. A POST on the same path
+// with action=purge and a comma separated list of ids deletes them.
+// A POST with action=requeue also needs table=<message table>, and
+// moves them back to the message table. POSTs require the ADMIN role.
+func initDeadLetterAPI(executor *Executor) {
+	handleCollection("dead-letters", func(r *http.Request) (interface{}, error) {
+		parts := strings.Split(getItemPath(r.URL.Path), "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid dead-letters path: %q  expected path: /dead-letters/<keyspace>/<dead letter table>", r.URL.Path)
+		}
+		keyspace, deadLetterTable := parts[0], parts[1]
+		ctx := context.Background()
+
+		switch r.Method {
+		case "GET":
+			if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+				return nil, err
+			}
+			return executor.ListDeadLetters(ctx, keyspace, deadLetterTable)
+		case "POST":
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return nil, err
+			}
+			if err := r.ParseForm(); err != nil {
+				return nil, err
+			}
+			var ids []*querypb.Value
+			for _, id := range strings.Split(r.FormValue("ids"), ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					ids = append(ids, sqltypes.ValueToProto(sqltypes.NewVarChar(id)))
+				}
+			}
+			if len(ids) == 0 {
+				return nil, errors.New("a POST request must specify ids")
+			}
+			var count int64
+			var err error
+			switch action := r.FormValue("action"); action {
+			case "purge":
+				count, err = executor.PurgeDeadLetters(ctx, keyspace, deadLetterTable, ids)
+			case "requeue":
+				name := r.FormValue("table")
+				if name == "" {
+					return nil, errors.New("requeue must specify the message table")
+				}
+				count, err = executor.RequeueDeadLetters(ctx, keyspace, deadLetterTable, name, ids)
+			default:
+				return nil, fmt.Errorf("unknown dead-letters action: %q", action)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return map[string]int64{"count": count}, nil
+		default:
+			return nil, fmt.Errorf("unsupported HTTP method: %v", r.Method)
+		}
+	})
+}
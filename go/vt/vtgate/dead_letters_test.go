@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDeadLetters(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	ctx := context.Background()
+	ids := []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewVarChar("1"))}
+	idsBindVar := &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: ids}
+	lookupQuery := &querypb.BoundQuery{
+		Sql:           "select table_name, table_comment from information_schema.`tables` where table_schema = database() and table_comment like 'vitess_message%'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}
+
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult})
+	_, err := executor.ListDeadLetters(ctx, KsTestUnsharded, "user_msgs_dlq")
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{lookupQuery, {
+		Sql:           "select * from user_msgs_dlq order by id asc",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("ListDeadLetters queries:\n%+v, want\n%+v", sbclookup.Queries, wantQueries)
+	}
+
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult})
+	_, err = executor.PurgeDeadLetters(ctx, KsTestUnsharded, "user_msgs_dlq", ids)
+	require.NoError(t, err)
+	wantQueries = []*querypb.BoundQuery{lookupQuery, {
+		Sql:           "delete from user_msgs_dlq where id in ::ids",
+		BindVariables: map[string]*querypb.BindVariable{"ids": idsBindVar},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("PurgeDeadLetters queries:\n%+v, want\n%+v", sbclookup.Queries, wantQueries)
+	}
+
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|time_scheduled|time_next|epoch|time_created|time_acked|message",
+			"int64|int64|int64|int64|int64|int64|varchar",
+		),
+		"1|10|20|5|10|null|hello",
+	)})
+	count, err := executor.RequeueDeadLetters(ctx, KsTestUnsharded, "user_msgs_dlq", "user_msgs", ids)
+	require.NoError(t, err)
+	if count != 1 {
+		t.Errorf("RequeueDeadLetters: %d, want 1", count)
+	}
+	wantQueries = []*querypb.BoundQuery{lookupQuery, {
+		Sql:           "select * from user_msgs_dlq where id in ::ids for update",
+		BindVariables: map[string]*querypb.BindVariable{"ids": idsBindVar},
+	}, {
+		Sql: "insert into user_msgs(id, time_scheduled, message) values (:r0c0, :r0c1, :r0c2)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"r0c0": sqltypes.Int64BindVariable(1),
+			"r0c1": sqltypes.Int64BindVariable(10),
+			"r0c2": sqltypes.StringBindVariable("hello"),
+		},
+	}, {
+		Sql:           "delete from user_msgs_dlq where id in ::ids",
+		BindVariables: map[string]*querypb.BindVariable{"ids": idsBindVar},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("RequeueDeadLetters queries:\n%+v, want\n%+v", sbclookup.Queries, wantQueries)
+	}
+	if got := sbclookup.CommitCount.Get(); got != 1 {
+		t.Errorf("CommitCount: %d, want 1", got)
+	}
+}
+
+func TestDeadLettersNotConfigured(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	ctx := context.Background()
+	ids := []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewVarChar("1"))}
+
+	// Tables that are not dead letter tables are rejected.
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult})
+	_, err := executor.ListDeadLetters(ctx, KsTestUnsharded, "user")
+	require.EqualError(t, err, "user is not the dead letter table of a message table in keyspace TestUnsharded")
+
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult})
+	_, err = executor.PurgeDeadLetters(ctx, KsTestUnsharded, "user_msgs", ids)
+	require.EqualError(t, err, "user_msgs is not the dead letter table of a message table in keyspace TestUnsharded")
+
+	// Messages can only be requeued to the message table they came from.
+	sbclookup.SetResults([]*sqltypes.Result{messageTablesResult})
+	_, err = executor.RequeueDeadLetters(ctx, KsTestUnsharded, "user_msgs_dlq", "user", ids)
+	require.EqualError(t, err, "user_msgs_dlq is not the dead letter table of message table user")
+
+	if len(sbclookup.Queries) != 3 {
+		t.Errorf("Queries: %v, want only the message table lookups", sbclookup.Queries)
+	}
+}
+
+var messageTablesResult = sqltypes.MakeTestResult(
+	sqltypes.MakeTestFields(
+		"table_name|table_comment",
+		"varchar|varchar",
+	),
+	"user_msgs|vitess_message,vt_ack_wait=30,vt_max_epoch=5,vt_dead_letter_table=user_msgs_dlq",
+	"other_msgs|vitess_message,vt_ack_wait=30",
+)
@@ -146,6 +146,9 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	result, err = e.execute(ctx, safeSession, sql, bindVars, logStats)
 	logStats.Error = err
+	if result != nil {
+		result = withCommittedGTIDs(result, safeSession)
+	}
 	if result != nil && len(result.Rows) > *warnMemoryRows {
 		warnings.Add("ResultsExceeded", 1)
 	}
@@ -159,6 +162,48 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	return result, err
 }
 
+// withCommittedGTIDs adds the GTIDs of the transactions committed
+// by the statement to its result. The GTID sets reported by the
+// shards are merged into a single set.
+func withCommittedGTIDs(result *sqltypes.Result, safeSession *SafeSession) *sqltypes.Result {
+	gtids := result.SessionStateChanges
+	if committed := safeSession.TakeSessionStateChanges(); committed != "" {
+		if gtids != "" {
+			gtids += ","
+		}
+		gtids += committed
+	}
+	if gtids == "" {
+		return result
+	}
+	// Only MySQL 5.6 GTID sets can be merged, others are
+	// returned as they were reported.
+	if set, ok := mergeMysql56GTIDSets(gtids); ok {
+		gtids = set.String()
+	}
+	if gtids == result.SessionStateChanges {
+		return result
+	}
+	out := *result
+	out.SessionStateChanges = gtids
+	return &out
+}
+
+// mergeMysql56GTIDSets merges a comma-separated list of MySQL 5.6 GTID
+// sets, in which a SID can appear more than once since they come from
+// several shards. It returns false if one of them can't be parsed.
+func mergeMysql56GTIDSets(s string) (mysql.Mysql56GTIDSet, bool) {
+	result := mysql.Mysql56GTIDSet{}
+	for _, part := range strings.Split(s, ",") {
+		set, err := mysql.ParseMysql56GTIDSet(part)
+		if err != nil {
+			return nil, false
+		}
+		result = result.Union(set)
+	}
+	return result, true
+}
+
 func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	// Start an implicit transaction if necessary.
 	if !safeSession.Autocommit && !safeSession.InTransaction() {
@@ -644,6 +689,22 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				safeSession.Options = &querypb.ExecuteOptions{}
 			}
 			safeSession.Options.SqlSelectLimit = val
+		case "session_track_gtids":
+			val, ok := v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for session_track_gtids: %T", v)
+			}
+			if safeSession.Options == nil {
+				safeSession.Options = &querypb.ExecuteOptions{}
+			}
+			switch strings.ToLower(val) {
+			case "off":
+				safeSession.Options.SessionTrackGtids = false
+			case "own_gtid":
+				safeSession.Options.SessionTrackGtids = true
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for session_track_gtids: %v", val)
+			}
 		case "sql_auto_is_null":
 			val, ok := v.(int64)
 			if !ok {
@@ -1217,6 +1278,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 			}
 		}
 
+		safeSession.RecordSessionStateChanges(qr.SessionStateChanges)
 		for _, row := range qr.Rows {
 			result.Rows = append(result.Rows, row)
 			for _, col := range row {
@@ -1235,8 +1297,10 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		return nil
 	})
 
-	// Send left-over rows.
-	if len(result.Rows) > 0 {
+	// Send left-over rows, along with the GTIDs of the transactions
+	// committed by the statement.
+	result = withCommittedGTIDs(result, safeSession)
+	if len(result.Rows) > 0 || result.SessionStateChanges != "" {
 		if err := callback(result); err != nil {
 			return err
 		}
@@ -214,6 +214,72 @@ func TestDirectTargetRewrites(t *testing.T) {
 	}})
 }
 
+func TestExecutorCommitSessionStateChanges(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	sbclookup.CommitSessionStateChanges = "00010203-0405-0607-0809-0a0b0c0d0e0f:6"
+
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "begin", nil); err != nil {
+		t.Fatal(err)
+	}
+	sbclookup.SetResults([]*sqltypes.Result{{
+		RowsAffected:        1,
+		SessionStateChanges: "00010203-0405-0607-0809-0a0b0c0d0e0f:5",
+	}})
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "update music_user_map set user_id = 1 where music_id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "00010203-0405-0607-0809-0a0b0c0d0e0f:6"
+	if qr.SessionStateChanges != want {
+		t.Errorf("commit SessionStateChanges: %v, want %v", qr.SessionStateChanges, want)
+	}
+
+	// The GTIDs recorded by the session and the ones of the result
+	// are merged into one set.
+	sbclookup.CommitSessionStateChanges = ""
+	sbclookup.SetResults([]*sqltypes.Result{{
+		RowsAffected:        1,
+		SessionStateChanges: "00010203-0405-0607-0809-0a0b0c0d0e0f:8",
+	}})
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	session.RecordSessionStateChanges("00010203-0405-0607-0809-0a0b0c0d0e0f:7")
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "update music_user_map set user_id = 1 where music_id = 1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "00010203-0405-0607-0809-0a0b0c0d0e0f:7-8"
+	if qr.SessionStateChanges != want {
+		t.Errorf("autocommit SessionStateChanges: %v, want %v", qr.SessionStateChanges, want)
+	}
+}
+
+func TestExecutorStreamSessionStateChanges(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	sbclookup.SetResults([]*sqltypes.Result{{
+		Fields:              []*querypb.Field{{Name: "id", Type: sqltypes.Int32}},
+		Rows:                [][]sqltypes.Value{{sqltypes.NewInt32(1)}},
+		SessionStateChanges: "00010203-0405-0607-0809-0a0b0c0d0e0f:8",
+	}})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	session.RecordSessionStateChanges("00010203-0405-0607-0809-0a0b0c0d0e0f:7")
+	var last *sqltypes.Result
+	err := executor.StreamExecute(context.Background(), "TestExecuteStream", session, "select id from music_user_map where id = 1", nil, querypb.Target{TabletType: topodatapb.TabletType_MASTER}, func(qr *sqltypes.Result) error {
+		last = qr
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "00010203-0405-0607-0809-0a0b0c0d0e0f:7-8"
+	if last == nil || last.SessionStateChanges != want {
+		t.Errorf("stream SessionStateChanges: %v, want %v", last, want)
+	}
+}
+
 func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -473,6 +539,15 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set skip_query_plan_cache = 0",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set session_track_gtids = own_gtid",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{SessionTrackGtids: true}},
+	}, {
+		in:  "set session_track_gtids = 'OFF'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set session_track_gtids = all_gtids",
+		err: "unexpected value for session_track_gtids: all_gtids",
 	}, {
 		in:  "set sql_auto_is_null = 0",
 		out: &vtgatepb.Session{Autocommit: true}, // no effect
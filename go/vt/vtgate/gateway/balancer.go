@@ -0,0 +1,368 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the balancer that picks the tablet a query is sent
+// to, among the healthy tablets of a target. The balancer keeps track of
+// the load of every tablet, and delegates the choice to a BalancerPolicy.
+
+var balancerPolicyName = flag.String("gateway_balancer", "random", "The policy used to pick a tablet among the healthy ones of a shard: random, power_of_two_choices or least_outstanding_requests")
+
+// latencyWeight is the weight of a new sample in the moving
+// average of the latency of a tablet.
+const latencyWeight = 0.1
+
+const (
+	// BalancerStatusTemplate is the display part to use to show
+	// a BalancerStatus.
+	BalancerStatusTemplate = `
+<style>
+  table {
+    border-collapse: collapse;
+  }
+  td, th {
+    border: 1px solid #999;
+    padding: 0.2rem;
+  }
+  table tr:nth-child(even) {
+    background-color: #eee;
+  }
+  table tr:nth-child(odd) {
+    background-color: #fff;
+  }
+</style>
+<p>Policy: {{.Policy}}</p>
+<table>
+  <tr>
+    <th>Keyspace</th>
+    <th>Shard</th>
+    <th>TabletType</th>
+    <th>Tablet</th>
+    <th>Picks</th>
+    <th>In Flight</th>
+    <th>Latency (ms) (moving avg)</th>
+    <th>Score</th>
+  </tr>
+  {{range $i, $load := .Tablets}}
+  <tr>
+    <td>{{$load.Keyspace}}</td>
+    <td>{{$load.Shard}}</td>
+    <td>{{$load.TabletType}}</td>
+    <td>{{$load.Name}}</td>
+    <td>{{$load.Picks}}</td>
+    <td>{{$load.InFlight}}</td>
+    <td>{{$load.FormattedLatency}}</td>
+    <td>{{$load.FormattedScore}}</td>
+  </tr>
+  {{end}}
+</table>
+`
+)
+
+// BalancerPolicy picks the tablet a query is sent to.
+type BalancerPolicy interface {
+	// Pick returns the index of the tablet to use in candidates.
+	// candidates is never empty, and is in random order.
+	Pick(candidates []*TabletLoad) int
+}
+
+var balancerPolicies = make(map[string]BalancerPolicy)
+
+// RegisterBalancerPolicy registers a BalancerPolicy with given name.
+func RegisterBalancerPolicy(name string, policy BalancerPolicy) {
+	if _, ok := balancerPolicies[name]; ok {
+		log.Fatalf("Balancer policy %s already exists", name)
+	}
+	balancerPolicies[name] = policy
+}
+
+func init() {
+	RegisterBalancerPolicy("random", randomPolicy{})
+	RegisterBalancerPolicy("power_of_two_choices", powerOfTwoChoicesPolicy{})
+	RegisterBalancerPolicy("least_outstanding_requests", leastOutstandingRequestsPolicy{})
+}
+
+// randomPolicy picks a tablet at random.
+type randomPolicy struct{}
+
+func (randomPolicy) Pick(candidates []*TabletLoad) int {
+	return 0
+}
+
+// powerOfTwoChoicesPolicy picks two tablets at random,
+// and uses the one with the lowest score.
+type powerOfTwoChoicesPolicy struct{}
+
+func (powerOfTwoChoicesPolicy) Pick(candidates []*TabletLoad) int {
+	if len(candidates) < 2 || candidates[1].Score() >= candidates[0].Score() {
+		return 0
+	}
+	return 1
+}
+
+// leastOutstandingRequestsPolicy picks the tablet with the lowest
+// number of requests in flight. Ties are broken at random.
+type leastOutstandingRequestsPolicy struct{}
+
+func (leastOutstandingRequestsPolicy) Pick(candidates []*TabletLoad) int {
+	best := 0
+	for i, tl := range candidates {
+		if tl.InFlight() < candidates[best].InFlight() {
+			best = i
+		}
+	}
+	return best
+}
+
+// TabletLoad tracks the load of a tablet as seen by this vtgate.
+type TabletLoad struct {
+	// Target is protected by mu once the load is tracked.
+	Target querypb.Target
+	Name   string
+
+	mu       sync.Mutex
+	inFlight int64
+	// latency is the moving average of the query latency,
+	// in nanoseconds. It is 0 until the first query returns.
+	latency float64
+	picks   uint64
+}
+
+// InFlight returns the number of queries currently sent to the tablet.
+func (tl *TabletLoad) InFlight() int64 {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	return tl.inFlight
+}
+
+// Latency returns the moving average of the latency of the tablet.
+func (tl *TabletLoad) Latency() time.Duration {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	return time.Duration(tl.latency)
+}
+
+// Score estimates how long a new query would take on the tablet:
+// the lower, the better. Tablets that have no latency yet have
+// a score of 0, so that they get traffic.
+func (tl *TabletLoad) Score() float64 {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	return tl.score()
+}
+
+func (tl *TabletLoad) score() float64 {
+	return tl.latency * float64(tl.inFlight+1)
+}
+
+// start counts a query sent to the tablet.
+func (tl *TabletLoad) start() {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.inFlight++
+	tl.picks++
+}
+
+// done ends a query started with start, and records its latency.
+func (tl *TabletLoad) done(elapsed time.Duration) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.inFlight--
+	if tl.latency == 0 {
+		tl.latency = float64(elapsed)
+		return
+	}
+	tl.latency += latencyWeight * (float64(elapsed) - tl.latency)
+}
+
+// finish ends a query started with start, without recording
+// its latency.
+func (tl *TabletLoad) finish() {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.inFlight--
+}
+
+// latencyMethods are the methods whose latency is recorded by the
+// balancer. The duration of streaming queries and transaction
+// statements says little about how loaded a tablet is.
+var latencyMethods = map[string]bool{
+	"Execute":      true,
+	"ExecuteBatch": true,
+}
+
+// balancer picks tablets using a BalancerPolicy.
+type balancer struct {
+	policyName string
+	policy     BalancerPolicy
+
+	mu sync.Mutex
+	// loads is indexed by the TabletStats key.
+	loads map[string]*TabletLoad
+}
+
+func newBalancer(policyName string) (*balancer, error) {
+	policy, ok := balancerPolicies[policyName]
+	if !ok {
+		return nil, fmt.Errorf("unknown balancer policy: %s", policyName)
+	}
+	return &balancer{
+		policyName: policyName,
+		policy:     policy,
+		loads:      make(map[string]*TabletLoad),
+	}, nil
+}
+
+// pick returns the tablet to use, and its load. It skips the invalid
+// tablets, and prefers the tablets in the local cell. It returns nil
+// if there is no tablet to use.
+func (b *balancer) pick(cell string, tablets []discovery.TabletStats, invalidTablets map[string]bool) (*discovery.TabletStats, *TabletLoad) {
+	// Same cell tablets are first.
+	shuffleTablets(cell, tablets)
+	var candidates []*discovery.TabletStats
+	for i := range tablets {
+		ts := &tablets[i]
+		if invalidTablets[ts.Key] {
+			continue
+		}
+		if len(candidates) != 0 && (ts.Tablet.Alias.Cell == cell) != (candidates[0].Tablet.Alias.Cell == cell) {
+			break
+		}
+		candidates = append(candidates, ts)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	loads := make([]*TabletLoad, len(candidates))
+	b.mu.Lock()
+	for i, ts := range candidates {
+		loads[i] = b.loadLocked(ts)
+	}
+	b.mu.Unlock()
+	i := b.policy.Pick(loads)
+	return candidates[i], loads[i]
+}
+
+func (b *balancer) loadLocked(ts *discovery.TabletStats) *TabletLoad {
+	tl, ok := b.loads[ts.Key]
+	if !ok {
+		tl = &TabletLoad{
+			Target: *ts.Target,
+			Name:   topoproto.TabletAliasString(ts.Tablet.Alias),
+		}
+		b.loads[ts.Key] = tl
+		return tl
+	}
+	// The tablet type changes when the tablet is reparented or
+	// used for a backup or a resharding.
+	tl.mu.Lock()
+	if !proto.Equal(&tl.Target, ts.Target) {
+		tl.Target = *ts.Target
+	}
+	tl.mu.Unlock()
+	return tl
+}
+
+// remove forgets the load of a tablet.
+func (b *balancer) remove(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.loads, key)
+}
+
+// status returns the status of the balancer.
+func (b *balancer) status() *BalancerStatus {
+	bs := &BalancerStatus{Policy: b.policyName}
+	b.mu.Lock()
+	for _, tl := range b.loads {
+		tl.mu.Lock()
+		bs.Tablets = append(bs.Tablets, &TabletLoadStatus{
+			Keyspace:   tl.Target.Keyspace,
+			Shard:      tl.Target.Shard,
+			TabletType: tl.Target.TabletType.String(),
+			Name:       tl.Name,
+			Picks:      tl.picks,
+			InFlight:   tl.inFlight,
+			Latency:    time.Duration(tl.latency),
+			Score:      tl.score(),
+		})
+		tl.mu.Unlock()
+	}
+	b.mu.Unlock()
+	sort.Slice(bs.Tablets, func(i, j int) bool {
+		ti, tj := bs.Tablets[i], bs.Tablets[j]
+		if ti.Keyspace != tj.Keyspace {
+			return ti.Keyspace < tj.Keyspace
+		}
+		if ti.Shard != tj.Shard {
+			return ti.Shard < tj.Shard
+		}
+		if ti.TabletType != tj.TabletType {
+			return ti.TabletType < tj.TabletType
+		}
+		return ti.Name < tj.Name
+	})
+	return bs
+}
+
+// BalancerStatus contains the status of the balancer of a gateway.
+type BalancerStatus struct {
+	Policy  string
+	Tablets []*TabletLoadStatus
+}
+
+// TabletLoadStatus contains the load of a tablet, as used by the balancer.
+type TabletLoadStatus struct {
+	Keyspace   string
+	Shard      string
+	TabletType string
+	Name       string
+
+	Picks    uint64
+	InFlight int64
+	Latency  time.Duration
+	Score    float64
+}
+
+// FormattedLatency shows the latency in milliseconds.
+// Used in the HTML template above.
+func (tls *TabletLoadStatus) FormattedLatency() string {
+	return fmt.Sprintf("%.2f", float64(tls.Latency)/float64(time.Millisecond))
+}
+
+// FormattedScore shows the score in milliseconds.
+// Used in the HTML template above.
+func (tls *TabletLoadStatus) FormattedScore() string {
+	return fmt.Sprintf("%.2f", tls.Score/float64(time.Millisecond))
+}
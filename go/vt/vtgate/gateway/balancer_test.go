@@ -0,0 +1,184 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func balancerTablets(cells ...string) []discovery.TabletStats {
+	var tablets []discovery.TabletStats
+	for i, cell := range cells {
+		tablets = append(tablets, discovery.TabletStats{
+			Key:     fmt.Sprintf("t%d", i),
+			Tablet:  topo.NewTablet(uint32(i), cell, fmt.Sprintf("host%d", i)),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Up:      true,
+			Serving: true,
+		})
+	}
+	return tablets
+}
+
+func TestBalancerUnknownPolicy(t *testing.T) {
+	_, err := newBalancer("unknown")
+	want := "unknown balancer policy: unknown"
+	if err == nil || err.Error() != want {
+		t.Errorf("newBalancer: %v, want %s", err, want)
+	}
+}
+
+func TestBalancerPickCandidates(t *testing.T) {
+	b, err := newBalancer("random")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		tablets := balancerTablets("cell1", "cell2", "cell1", "cell2")
+		// Same cell tablets are preferred.
+		ts, _ := b.pick("cell1", tablets, nil)
+		if ts.Tablet.Alias.Cell != "cell1" {
+			t.Errorf("pick: %v, want a tablet in cell1", ts.Tablet.Alias)
+		}
+
+		// Other cells are used once all the local tablets were tried.
+		ts, _ = b.pick("cell1", tablets, map[string]bool{"t0": true, "t2": true})
+		if ts.Tablet.Alias.Cell != "cell2" {
+			t.Errorf("pick: %v, want a tablet in cell2", ts.Tablet.Alias)
+		}
+
+		ts, _ = b.pick("cell1", tablets, map[string]bool{"t0": true, "t1": true, "t2": true, "t3": true})
+		if ts != nil {
+			t.Errorf("pick: %v, want nil", ts.Tablet.Alias)
+		}
+	}
+}
+
+func TestBalancerLeastOutstandingRequests(t *testing.T) {
+	b, err := newBalancer("least_outstanding_requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Load all the tablets but one.
+	for i := 0; i < 3; i++ {
+		tablets := balancerTablets("cell1", "cell1", "cell1")
+		_, load := b.pick("cell1", tablets, nil)
+		load.start()
+	}
+	for i := 0; i < 10; i++ {
+		tablets := balancerTablets("cell1", "cell1", "cell1")
+		_, load := b.pick("cell1", tablets, nil)
+		if got := load.InFlight(); got != 1 {
+			t.Errorf("InFlight of the picked tablet: %d, want 1", got)
+		}
+	}
+}
+
+func TestBalancerPowerOfTwoChoices(t *testing.T) {
+	b, err := newBalancer("power_of_two_choices")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tablets := balancerTablets("cell1", "cell1")
+	b.pick("cell1", tablets, nil)
+	for key, latency := range map[string]time.Duration{"t0": 100 * time.Millisecond, "t1": time.Millisecond} {
+		b.loads[key].start()
+		b.loads[key].done(latency)
+	}
+	for i := 0; i < 10; i++ {
+		tablets := balancerTablets("cell1", "cell1")
+		ts, _ := b.pick("cell1", tablets, nil)
+		if ts.Key != "t1" {
+			t.Errorf("pick: %s, want t1", ts.Key)
+		}
+	}
+}
+
+func TestTabletLoad(t *testing.T) {
+	tl := &TabletLoad{}
+	tl.start()
+	tl.start()
+	if got, want := tl.InFlight(), int64(2); got != want {
+		t.Errorf("InFlight: %d, want %d", got, want)
+	}
+	tl.done(10 * time.Millisecond)
+	if got, want := tl.Latency(), 10*time.Millisecond; got != want {
+		t.Errorf("Latency: %v, want %v", got, want)
+	}
+	tl.done(20 * time.Millisecond)
+	if got, want := tl.Latency(), 11*time.Millisecond; got != want {
+		t.Errorf("Latency: %v, want %v", got, want)
+	}
+	if got, want := tl.Score(), float64(11*time.Millisecond); got != want {
+		t.Errorf("Score: %v, want %v", got, want)
+	}
+}
+
+func TestBalancerStatus(t *testing.T) {
+	b, err := newBalancer("random")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tablets := balancerTablets("cell1")
+	_, load := b.pick("cell1", tablets, nil)
+	load.start()
+	load.done(2 * time.Millisecond)
+
+	status := b.status()
+	if status.Policy != "random" {
+		t.Errorf("Policy: %s, want random", status.Policy)
+	}
+	if len(status.Tablets) != 1 {
+		t.Fatalf("Tablets: %v, want 1 tablet", status.Tablets)
+	}
+	got := status.Tablets[0]
+	want := TabletLoadStatus{
+		Keyspace:   "k",
+		Shard:      "s",
+		TabletType: "REPLICA",
+		Name:       "cell1-0000000000",
+		Picks:      1,
+		Latency:    2 * time.Millisecond,
+		Score:      float64(2 * time.Millisecond),
+	}
+	if *got != want {
+		t.Errorf("TabletLoadStatus: %+v, want %+v", *got, want)
+	}
+	if got, want := got.FormattedLatency(), "2.00"; got != want {
+		t.Errorf("FormattedLatency: %s, want %s", got, want)
+	}
+
+	// The load follows the type changes of the tablet.
+	tablets[0].Target = &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_MASTER}
+	b.pick("cell1", tablets, nil)
+	if got := b.status().Tablets[0].TabletType; got != "MASTER" {
+		t.Errorf("TabletType after reparent: %s, want MASTER", got)
+	}
+
+	b.remove("t0")
+	if status := b.status(); len(status.Tablets) != 0 {
+		t.Errorf("Tablets: %v, want none", status.Tablets)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
@@ -82,6 +83,16 @@ type discoveryGateway struct {
 
 	// buffer, if enabled, buffers requests during a detected MASTER failover.
 	buffer *buffer.Buffer
+
+	// balancer picks the tablet to use among the healthy ones.
+	balancer *balancer
+
+	// hedger decides when read-only queries to replicas are hedged.
+	hedger *hedger
+
+	// listeners receive all the health check updates after the
+	// gateway has processed them. It is protected by mu.
+	listeners []discovery.HealthCheckStatsListener
 }
 
 func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, cell string, retryCount int) Gateway {
@@ -93,6 +104,10 @@ func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv
 			log.Exitf("Unable to create new discoverygateway: %v", err)
 		}
 	}
+	balancer, err := newBalancer(*balancerPolicyName)
+	if err != nil {
+		log.Exitf("Unable to create new discoverygateway: %v", err)
+	}
 
 	dg := &discoveryGateway{
 		hc:                hc,
@@ -103,6 +118,8 @@ func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv
 		tabletsWatchers:   make([]*discovery.TopologyWatcher, 0, 1),
 		statusAggregators: make(map[string]*TabletStatusAggregator),
 		buffer:            buffer.New(),
+		balancer:          balancer,
+		hedger:            newHedger(*hedgePercentile, *hedgeMinDelay),
 	}
 
 	// Set listener which will update TabletStatsCache and MasterBuffer.
@@ -174,7 +191,8 @@ func (dg *discoveryGateway) topologyWatcherChecksum() int64 {
 	return checksum
 }
 
-// StatsUpdate forwards HealthCheck updates to TabletStatsCache and MasterBuffer.
+// StatsUpdate forwards HealthCheck updates to TabletStatsCache, MasterBuffer
+// and the registered listeners.
 // It is part of the discovery.HealthCheckStatsListener interface.
 func (dg *discoveryGateway) StatsUpdate(ts *discovery.TabletStats) {
 	dg.tsc.StatsUpdate(ts)
@@ -182,6 +200,23 @@ func (dg *discoveryGateway) StatsUpdate(ts *discovery.TabletStats) {
 	if ts.Target.TabletType == topodatapb.TabletType_MASTER {
 		dg.buffer.StatsUpdate(ts)
 	}
+	if !ts.Up {
+		dg.balancer.remove(ts.Key)
+	}
+
+	dg.mu.RLock()
+	listeners := dg.listeners
+	dg.mu.RUnlock()
+	for _, l := range listeners {
+		l.StatsUpdate(ts)
+	}
+}
+
+// AddStatsListener is part of the gateway.Gateway interface.
+func (dg *discoveryGateway) AddStatsListener(l discovery.HealthCheckStatsListener) {
+	dg.mu.Lock()
+	defer dg.mu.Unlock()
+	dg.listeners = append(dg.listeners, l)
 }
 
 // WaitForTablets is part of the gateway.Gateway interface.
@@ -223,27 +258,39 @@ func (dg *discoveryGateway) CacheStatus() TabletCacheStatusList {
 	return res
 }
 
+// BalancerStatus is part of the gateway.Gateway interface.
+func (dg *discoveryGateway) BalancerStatus() *BalancerStatus {
+	return dg.balancer.status()
+}
+
+// Execute is part of the queryservice.QueryService interface.
+// Read-only queries to replicas are hedged if enabled.
+func (dg *discoveryGateway) Execute(ctx context.Context, target *querypb.Target, sql string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	if dg.hedger.canHedge(target, sql, transactionID) {
+		return dg.hedgedExecute(ctx, target, sql, bindVars, options)
+	}
+	return dg.QueryService.Execute(ctx, target, sql, bindVars, transactionID, options)
+}
+
 // withRetry gets available connections and executes the action. If there are retryable errors,
 // it retries retryCount times before failing. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
 // a resharding event, and set the re-resolve bit and let the upper layers
 // re-resolve and retry.
 func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Target, unused queryservice.QueryService, name string, inTransaction bool, inner func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error)) error {
+	return dg.retry(ctx, target, name, inTransaction, false, inner)
+}
+
+// retry implements withRetry. If hedge is set, inner must be safe to
+// call concurrently: once enough latencies of the target are known,
+// every attempt is hedged.
+func (dg *discoveryGateway) retry(ctx context.Context, target *querypb.Target, name string, inTransaction, hedge bool, inner func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error)) error {
 	var tabletLastUsed *topodatapb.Tablet
 	var err error
 	invalidTablets := make(map[string]bool)
 
-	if len(allowedTabletTypes) > 0 {
-		var match bool
-		for _, allowed := range allowedTabletTypes {
-			if allowed == target.TabletType {
-				match = true
-				break
-			}
-		}
-		if !match {
-			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "requested tablet type %v is not part of the allowed tablet types for this vtgate: %+v", target.TabletType.String(), allowedTabletTypes)
-		}
+	if !isAllowedTabletType(target.TabletType) {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "requested tablet type %v is not part of the allowed tablet types for this vtgate: %+v", target.TabletType.String(), allowedTabletTypes)
 	}
 
 	bufferedOnce := false
@@ -280,16 +327,8 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 			err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no valid tablet")
 			break
 		}
-		shuffleTablets(dg.localCell, tablets)
-
 		// skip tablets we tried before
-		var ts *discovery.TabletStats
-		for _, t := range tablets {
-			if _, ok := invalidTablets[t.Key]; !ok {
-				ts = &t
-				break
-			}
-		}
+		ts, load := dg.balancer.pick(dg.localCell, tablets, invalidTablets)
 		if ts == nil {
 			if err == nil {
 				// do not override error from last attempt.
@@ -307,10 +346,27 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 			continue
 		}
 
-		startTime := time.Now()
 		var canRetry bool
-		canRetry, err = inner(ctx, ts.Target, conn)
-		dg.updateStats(target, startTime, err)
+		if delay, ok := dg.hedger.delay(target); hedge && ok {
+			tabletLastUsed, canRetry, err = dg.hedgedAttempt(ctx, target, ts, load, conn, delay, invalidTablets, inner)
+		} else {
+			startTime := time.Now()
+			canRetry, err = func() (bool, error) {
+				load.start()
+				defer func() {
+					if latencyMethods[name] {
+						load.done(time.Since(startTime))
+					} else {
+						load.finish()
+					}
+				}()
+				return inner(ctx, ts.Target, conn)
+			}()
+			dg.updateStats(target, startTime, err)
+			if hedge && err == nil {
+				dg.hedger.record(target, time.Since(startTime))
+			}
+		}
 		if canRetry {
 			invalidTablets[ts.Key] = true
 			continue
@@ -320,6 +376,20 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 	return NewShardError(err, target, tabletLastUsed)
 }
 
+// isAllowedTabletType returns true if this vtgate can route queries
+// to the tablet type.
+func isAllowedTabletType(tabletType topodatapb.TabletType) bool {
+	if len(allowedTabletTypes) == 0 {
+		return true
+	}
+	for _, allowed := range allowedTabletTypes {
+		if allowed == tabletType {
+			return true
+		}
+	}
+	return false
+}
+
 func shuffleTablets(cell string, tablets []discovery.TabletStats) {
 	sameCell, diffCell, sameCellMax := 0, 0, -1
 	length := len(tablets)
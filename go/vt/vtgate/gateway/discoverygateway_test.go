@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -30,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -78,7 +80,8 @@ func TestDiscoveryGatewayBegin(t *testing.T) {
 
 func TestDiscoveryGatewayCommit(t *testing.T) {
 	testDiscoveryGatewayTransact(t, func(dg Gateway, target *querypb.Target) error {
-		return dg.Commit(context.Background(), target, 1)
+		_, err := dg.Commit(context.Background(), target, 1)
+		return err
 	})
 }
 
@@ -103,6 +106,51 @@ func TestDiscoveryGatewayBeginExecuteBatch(t *testing.T) {
 	})
 }
 
+func TestDiscoveryGatewayBalancerLoad(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "cell", 2).(*discoveryGateway)
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+	key := dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)[0].Key
+	slow := func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error) {
+		time.Sleep(time.Millisecond)
+		return false, nil
+	}
+
+	// Streaming queries and transaction statements don't record latency.
+	for _, name := range []string{"StreamExecute", "Begin", "Commit"} {
+		if err := dg.withRetry(context.Background(), target, nil, name, false, slow); err != nil {
+			t.Fatalf("withRetry(%v): %v", name, err)
+		}
+	}
+	load := dg.balancer.loads[key]
+	if got := load.Latency(); got != 0 {
+		t.Errorf("Latency after non Execute calls: %v, want 0", got)
+	}
+
+	if err := dg.withRetry(context.Background(), target, nil, "Execute", false, slow); err != nil {
+		t.Fatalf("withRetry(Execute): %v", err)
+	}
+	if got := load.Latency(); got < time.Millisecond {
+		t.Errorf("Latency after Execute: %v, want at least 1ms", got)
+	}
+
+	// A panic doesn't leave the query in flight.
+	func() {
+		defer func() { recover() }()
+		dg.withRetry(context.Background(), target, nil, "Execute", false, func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error) {
+			panic("test panic")
+		})
+	}()
+	if got := load.InFlight(); got != 0 {
+		t.Errorf("InFlight after panic: %d, want 0", got)
+	}
+}
+
 func TestDiscoveryGatewayGetTablets(t *testing.T) {
 	keyspace := "ks"
 	shard := "0"
@@ -71,6 +71,13 @@ type Gateway interface {
 
 	// CacheStatus returns a list of TabletCacheStatus per shard / tablet type.
 	CacheStatus() TabletCacheStatusList
+
+	// BalancerStatus returns the status of the tablet balancer.
+	BalancerStatus() *BalancerStatus
+
+	// AddStatsListener registers a listener that will receive all
+	// the health check updates seen by the gateway.
+	AddStatsListener(l discovery.HealthCheckStatsListener)
 }
 
 // Creator is the factory method which can create the actual gateway object.
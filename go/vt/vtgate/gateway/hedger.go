@@ -0,0 +1,269 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"flag"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file implements hedged requests: a read-only query sent to a
+// replica that takes longer than usual is sent to a second tablet, and
+// the first answer wins.
+
+var (
+	hedgePercentile = flag.Float64("gateway_hedge_percentile", 0, "If set, read-only queries sent to REPLICA and RDONLY tablets outside of a transaction are sent to a second tablet if the first one did not answer within this percentile of the recent latency of the shard. 0 disables hedging.")
+	hedgeMinDelay   = flag.Duration("gateway_hedge_min_delay", 1*time.Millisecond, "The minimum time to wait for the first tablet before sending a hedged request.")
+
+	hedgeCount = stats.NewCountersWithMultiLabels(
+		"GatewayHedges",
+		"Number of hedged requests sent",
+		[]string{"Keyspace", "ShardName", "DbType"})
+	hedgeWins = stats.NewCountersWithMultiLabels(
+		"GatewayHedgeWins",
+		"Number of hedged requests that answered first",
+		[]string{"Keyspace", "ShardName", "DbType"})
+)
+
+const (
+	// hedgeWindowSize is the number of recent latencies
+	// kept per target to compute the hedge delay.
+	hedgeWindowSize = 100
+	// hedgeMinSamples is the number of latencies needed
+	// before a target's requests can be hedged.
+	hedgeMinSamples = 10
+)
+
+// hedger keeps the recent latencies of every target, and computes
+// how long to wait before hedging a request.
+type hedger struct {
+	percentile float64
+	minDelay   time.Duration
+
+	mu sync.Mutex
+	// windows is indexed by keyspace/shard/tablet_type.
+	windows map[string]*latencyWindow
+}
+
+// latencyWindow is a ring buffer of latencies.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func newHedger(percentile float64, minDelay time.Duration) *hedger {
+	return &hedger{
+		percentile: percentile,
+		minDelay:   minDelay,
+		windows:    make(map[string]*latencyWindow),
+	}
+}
+
+// canHedge returns true if the query can be hedged.
+// Hedging only applies to read-only queries sent to replicas,
+// outside of a transaction.
+func (h *hedger) canHedge(target *querypb.Target, sql string, transactionID int64) bool {
+	if h.percentile <= 0 || transactionID != 0 {
+		return false
+	}
+	if target.TabletType != topodatapb.TabletType_REPLICA && target.TabletType != topodatapb.TabletType_RDONLY {
+		return false
+	}
+	return sqlparser.Preview(sql) == sqlparser.StmtSelect
+}
+
+// record adds a latency to the window of the target.
+func (h *hedger) record(target *querypb.Target, elapsed time.Duration) {
+	key := targetKey(target)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	w, ok := h.windows[key]
+	if !ok {
+		w = &latencyWindow{}
+		h.windows[key] = w
+	}
+	if len(w.samples) < hedgeWindowSize {
+		w.samples = append(w.samples, elapsed)
+		return
+	}
+	w.samples[w.next] = elapsed
+	w.next = (w.next + 1) % hedgeWindowSize
+}
+
+// delay returns how long to wait for the first tablet before sending
+// a hedged request. It returns false if there are not enough samples yet.
+func (h *hedger) delay(target *querypb.Target) (time.Duration, bool) {
+	h.mu.Lock()
+	w, ok := h.windows[targetKey(target)]
+	if !ok || len(w.samples) < hedgeMinSamples {
+		h.mu.Unlock()
+		return 0, false
+	}
+	samples := make([]time.Duration, len(w.samples))
+	copy(samples, w.samples)
+	h.mu.Unlock()
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	i := int(float64(len(samples)) * h.percentile / 100)
+	if i >= len(samples) {
+		i = len(samples) - 1
+	}
+	if samples[i] < h.minDelay {
+		return h.minDelay, true
+	}
+	return samples[i], true
+}
+
+func targetKey(target *querypb.Target) string {
+	return target.Keyspace + "/" + target.Shard + "/" + target.TabletType.String()
+}
+
+type hedgeResult struct {
+	ts       *discovery.TabletStats
+	canRetry bool
+	err      error
+	hedged   bool
+}
+
+// hedgedExecute executes a read-only query through the regular retry
+// logic, hedging every attempt once enough latencies are known.
+func (dg *discoveryGateway) hedgedExecute(ctx context.Context, target *querypb.Target, sql string, bindVars map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	// Both requests of an attempt may succeed: the first
+	// answer is kept.
+	var mu sync.Mutex
+	var qr *sqltypes.Result
+	err := dg.retry(ctx, target, "Execute", false, true, func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error) {
+		res, err := conn.Execute(ctx, target, sql, bindVars, 0, options)
+		if err != nil {
+			return isRetryable(ctx, err), err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if qr == nil {
+			qr = res
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// hedgedAttempt runs inner on the first tablet, and on a second one
+// if the first one did not answer within delay. The first successful
+// answer wins, and the other request is canceled. It returns the tablet
+// of the answer, or of the last failure. The tablets that failed with a
+// retryable error are added to invalidTablets.
+func (dg *discoveryGateway) hedgedAttempt(ctx context.Context, target *querypb.Target, first *discovery.TabletStats, firstLoad *TabletLoad, firstConn queryservice.QueryService, delay time.Duration, invalidTablets map[string]bool, inner func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error)) (*topodatapb.Tablet, bool, error) {
+	// Canceling the context on return cancels the losing request.
+	hedgeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgeResult, 2)
+	send := func(ts *discovery.TabletStats, load *TabletLoad, conn queryservice.QueryService, hedged bool) {
+		startTime := time.Now()
+		load.start()
+		canRetry, err := inner(hedgeCtx, ts.Target, conn)
+		if err != nil && hedgeCtx.Err() != nil {
+			// The canceled request is not counted as an error,
+			// and its latency is meaningless.
+			load.finish()
+		} else {
+			load.done(time.Since(startTime))
+			dg.updateStats(target, startTime, err)
+		}
+		if err == nil {
+			dg.hedger.record(target, time.Since(startTime))
+		}
+		results <- hedgeResult{ts: ts, canRetry: canRetry, err: err, hedged: hedged}
+	}
+	go send(first, firstLoad, firstConn, false)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeTimer := timer.C
+	pending := 1
+	var lastResult hedgeResult
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				if r.hedged {
+					hedgeWins.Add(statsKey(target), 1)
+				}
+				return r.ts.Tablet, false, nil
+			}
+			if r.canRetry {
+				invalidTablets[r.ts.Key] = true
+			}
+			lastResult = r
+		case <-hedgeTimer:
+			hedgeTimer = nil
+			skip := map[string]bool{first.Key: true}
+			for key := range invalidTablets {
+				skip[key] = true
+			}
+			tablets := dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)
+			second, secondLoad := dg.balancer.pick(dg.localCell, tablets, skip)
+			if second == nil {
+				continue
+			}
+			conn := dg.hc.GetConnection(second.Key)
+			if conn == nil {
+				invalidTablets[second.Key] = true
+				continue
+			}
+			hedgeCount.Add(statsKey(target), 1)
+			pending++
+			go send(second, secondLoad, conn, true)
+		}
+	}
+	return lastResult.ts.Tablet, lastResult.canRetry, lastResult.err
+}
+
+// isRetryable returns true if the query can be sent to another
+// tablet after failing with err.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_FAILED_PRECONDITION:
+		return true
+	}
+	return false
+}
+
+func statsKey(target *querypb.Target) []string {
+	return []string{target.Keyspace, target.Shard, target.TabletType.String()}
+}
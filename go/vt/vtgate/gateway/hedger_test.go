@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestHedgerCanHedge(t *testing.T) {
+	h := newHedger(90, time.Millisecond)
+	replica := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	master := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER}
+	testcases := []struct {
+		target        *querypb.Target
+		sql           string
+		transactionID int64
+		want          bool
+	}{
+		{replica, "select 1 from dual", 0, true},
+		{replica, "select 1 from dual", 1, false},
+		{replica, "update t set a = 1", 0, false},
+		{master, "select 1 from dual", 0, false},
+	}
+	for _, tc := range testcases {
+		if got := h.canHedge(tc.target, tc.sql, tc.transactionID); got != tc.want {
+			t.Errorf("canHedge(%v, %s, %d): %v, want %v", tc.target.TabletType, tc.sql, tc.transactionID, got, tc.want)
+		}
+	}
+
+	h = newHedger(0, time.Millisecond)
+	if h.canHedge(replica, "select 1 from dual", 0) {
+		t.Errorf("canHedge with hedging disabled: true, want false")
+	}
+}
+
+func TestHedgerDelay(t *testing.T) {
+	h := newHedger(90, 5*time.Millisecond)
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	for i := 1; i < hedgeMinSamples; i++ {
+		h.record(target, time.Duration(i)*time.Millisecond)
+	}
+	if _, ok := h.delay(target); ok {
+		t.Errorf("delay with %d samples: ok, want not ok", hedgeMinSamples-1)
+	}
+
+	for i := hedgeMinSamples; i <= hedgeWindowSize; i++ {
+		h.record(target, time.Duration(i)*time.Millisecond)
+	}
+	delay, ok := h.delay(target)
+	if !ok || delay != 91*time.Millisecond {
+		t.Errorf("delay: %v, %v, want 91ms, true", delay, ok)
+	}
+
+	// The window only keeps the last samples.
+	for i := 0; i < hedgeWindowSize; i++ {
+		h.record(target, time.Millisecond)
+	}
+	delay, ok = h.delay(target)
+	if !ok || delay != 5*time.Millisecond {
+		t.Errorf("delay: %v, %v, want the min delay", delay, ok)
+	}
+}
+
+// slowConn is a tablet that never answers Execute.
+type slowConn struct {
+	*sandboxconn.SandboxConn
+	canceled chan struct{}
+}
+
+func (sc *slowConn) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	<-ctx.Done()
+	close(sc.canceled)
+	return nil, ctx.Err()
+}
+
+func TestDiscoveryGatewayHedgedExecute(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "cell", 2).(*discoveryGateway)
+	dg.hedger = newHedger(50, time.Millisecond)
+	var err error
+	dg.balancer, err = newBalancer("least_outstanding_requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slow := &slowConn{canceled: make(chan struct{})}
+	hc.AddFakeTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil, func(tablet *topodatapb.Tablet) queryservice.QueryService {
+		slow.SandboxConn = sandboxconn.NewSandboxConn(tablet)
+		return slow
+	})
+	fast := hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	// Load the fast tablet, so the slow one is picked first.
+	var slowKey string
+	for _, ts := range dg.tsc.GetHealthyTabletStats("ks", "0", topodatapb.TabletType_REPLICA) {
+		if ts.Tablet.Hostname == "1.1.1.1" {
+			slowKey = ts.Key
+		}
+	}
+	_, fastLoad := dg.balancer.pick("cell", dg.tsc.GetHealthyTabletStats("ks", "0", topodatapb.TabletType_REPLICA), map[string]bool{slowKey: true})
+	fastLoad.start()
+
+	// Hedge after a millisecond.
+	for i := 0; i < hedgeMinSamples; i++ {
+		dg.hedger.record(target, time.Millisecond)
+	}
+
+	hedgesBefore := hedgeCount.Counts()["ks.0.REPLICA"]
+	winsBefore := hedgeWins.Counts()["ks.0.REPLICA"]
+	if _, err := dg.Execute(context.Background(), target, "select 1 from dual", nil, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := fast.ExecCount.Get(); got != 1 {
+		t.Errorf("ExecCount of the fast tablet: %d, want 1", got)
+	}
+	select {
+	case <-slow.canceled:
+	case <-time.After(10 * time.Second):
+		t.Errorf("the request to the slow tablet was not canceled")
+	}
+	if got := hedgeCount.Counts()["ks.0.REPLICA"] - hedgesBefore; got != 1 {
+		t.Errorf("GatewayHedges: %d, want 1", got)
+	}
+	if got := hedgeWins.Counts()["ks.0.REPLICA"] - winsBefore; got != 1 {
+		t.Errorf("GatewayHedgeWins: %d, want 1", got)
+	}
+
+	// The latency of the canceled request is not recorded.
+	dg.balancer.mu.Lock()
+	slowLoad := dg.balancer.loads[slowKey]
+	dg.balancer.mu.Unlock()
+	for start := time.Now(); slowLoad.InFlight() != 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("the request to the slow tablet is still in flight")
+		}
+	}
+	if got := slowLoad.Latency(); got != 0 {
+		t.Errorf("latency of the slow tablet: %v, want 0", got)
+	}
+}
+
+func TestDiscoveryGatewayHedgedExecuteRetry(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "cell", 2).(*discoveryGateway)
+	dg.hedger = newHedger(50, time.Hour)
+	sbc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	sbc2 := hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	sbc1.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 100
+	for i := 0; i < hedgeMinSamples; i++ {
+		dg.hedger.record(target, time.Millisecond)
+	}
+
+	// The attempts are not hedged before the first tablet fails,
+	// and the queries are retried on the other one.
+	for i := 0; i < 10; i++ {
+		if _, err := dg.Execute(context.Background(), target, "select 1 from dual", nil, 0, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := sbc2.ExecCount.Get(); got != 10 {
+		t.Errorf("ExecCount: %d, want 10", got)
+	}
+}
@@ -360,6 +360,11 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 			}
 			// No return: break out.
 		case st.singleRoute != nil:
+			// If all the column lists are known, the column
+			// must belong to one of the tables.
+			if !st.hasColumn(col.Name) {
+				return nil, fmt.Errorf("symbol %s not found", sqlparser.String(col))
+			}
 			// If there's only one route, create an anonymous symbol.
 			return &column{origin: st.singleRoute, st: st}, nil
 		default:
@@ -390,6 +395,20 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 	return c, nil
 }
 
+// hasColumn returns false if all the tables have an authoritative
+// column list, and none of them has the column.
+func (st *symtab) hasColumn(name sqlparser.ColIdent) bool {
+	for _, t := range st.tables {
+		if !t.isAuthoritative {
+			return true
+		}
+		if _, ok := t.columns[name.Lowered()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // ResultFromNumber returns the result column index based on the column
 // order expression.
 func ResultFromNumber(rcs []*resultColumn, val *sqlparser.SQLVal) (int, error) {
@@ -168,6 +168,16 @@ func startSpan(ctx context.Context, query, label string) (trace.Span, context.Co
 
 func (vh *vtgateHandler) ComInitDB(c *mysql.Conn, schemaName string) {
 	vh.session(c).TargetString = schemaName
+	c.SetSchemaName(targetSchemaName(schemaName))
+}
+
+// targetSchemaName returns the schema name to report to the client
+// for a target string: the keyspace, without the shard or tablet type.
+func targetSchemaName(targetString string) string {
+	if i := strings.IndexAny(targetString, ":[@"); i != -1 {
+		return targetString[:i]
+	}
+	return targetString
 }
 
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
@@ -217,6 +227,8 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	if err != nil {
 		return err
 	}
+	// Let the client know if the statement changed the current schema.
+	c.SetSchemaName(targetSchemaName(session.TargetString))
 	return callback(result)
 }
 
@@ -210,6 +210,23 @@ func newTestAuthServerStatic() *mysql.AuthServerStatic {
 	return mysql.NewAuthServerStatic("", jsonConfig, 0)
 }
 
+func TestTargetSchemaName(t *testing.T) {
+	testcases := map[string]string{
+		"":              "",
+		"ks":            "ks",
+		"ks@replica":    "ks",
+		"ks:-80":        "ks",
+		"ks:-80@rdonly": "ks",
+		"ks[deadbeef]":  "ks",
+		"@master":       "",
+	}
+	for in, want := range testcases {
+		if got := targetSchemaName(in); got != want {
+			t.Errorf("targetSchemaName(%q): %q, want %q", in, got, want)
+		}
+	}
+}
+
 func TestDefaultWorkloadEmpty(t *testing.T) {
 	vh := &vtgateHandler{}
 	sess := vh.session(&mysql.Conn{})
@@ -344,6 +344,11 @@ func (res *Resolver) GetGatewayCacheStatus() gateway.TabletCacheStatusList {
 	return res.scatterConn.GetGatewayCacheStatus()
 }
 
+// GetGatewayBalancerStatus returns a displayable version of the Gateway balancer.
+func (res *Resolver) GetGatewayBalancerStatus() *gateway.BalancerStatus {
+	return res.scatterConn.GetGatewayBalancerStatus()
+}
+
 // StrsEquals compares contents of two string slices.
 func StrsEquals(a, b []string) bool {
 	if len(a) != len(b) {
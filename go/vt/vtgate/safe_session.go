@@ -37,6 +37,11 @@ type SafeSession struct {
 	mustRollback    bool
 	autocommitState autocommitState
 	commitOrder     vtgatepb.CommitOrder
+
+	// sessionStateChanges accumulates the GTIDs reported by the
+	// tablets for the transactions committed by the session.
+	sessionStateChanges string
+
 	*vtgatepb.Session
 }
 
@@ -231,6 +236,30 @@ func (session *SafeSession) RecordWarning(warning *querypb.QueryWarning) {
 	session.Session.Warnings = append(session.Session.Warnings, warning)
 }
 
+// RecordSessionStateChanges stores the GTIDs reported by a tablet
+// for a commit.
+func (session *SafeSession) RecordSessionStateChanges(changes string) {
+	if changes == "" {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.sessionStateChanges != "" {
+		session.sessionStateChanges += ","
+	}
+	session.sessionStateChanges += changes
+}
+
+// TakeSessionStateChanges returns the GTIDs recorded by
+// RecordSessionStateChanges, and clears them.
+func (session *SafeSession) TakeSessionStateChanges() string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	changes := session.sessionStateChanges
+	session.sessionStateChanges = ""
+	return changes
+}
+
 // ClearWarnings removes all the warnings from the session
 func (session *SafeSession) ClearWarnings() {
 	session.mu.Lock()
@@ -738,6 +738,11 @@ func (stc *ScatterConn) GetGatewayCacheStatus() gateway.TabletCacheStatusList {
 	return stc.gateway.CacheStatus()
 }
 
+// GetGatewayBalancerStatus returns a displayable version of the Gateway balancer.
+func (stc *ScatterConn) GetGatewayBalancerStatus() *gateway.BalancerStatus {
+	return stc.gateway.BalancerStatus()
+}
+
 // multiGo performs the requested 'action' on the specified
 // shards in parallel. This does not handle any transaction state.
 // The action function must match the shardActionFunc2 signature.
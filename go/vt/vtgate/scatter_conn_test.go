@@ -19,6 +19,7 @@ package vtgate
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -624,6 +625,43 @@ func TestScatterConnSingleDB(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestScatterConnSessionStateChanges(t *testing.T) {
+	createSandbox("TestScatterConnSessionStateChanges")
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, "TestScatterConnSessionStateChanges", "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, "TestScatterConnSessionStateChanges", "1", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc0.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: "uuid:1"}})
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: "uuid:2"}})
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	rss, _, err := res.ResolveDestinations(context.Background(), "TestScatterConnSessionStateChanges", topodatapb.TabletType_MASTER, nil,
+		[]key.Destination{key.DestinationShard("0"), key.DestinationShard("1")})
+	if err != nil {
+		t.Fatalf("ResolveDestination failed: %v", err)
+	}
+	queries := []*querypb.BoundQuery{{
+		Sql:           "update t set a=1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "update t set a=1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	qr, errs := sc.ExecuteMultiShard(context.Background(), rss, queries, topodatapb.TabletType_MASTER, NewSafeSession(nil), false, true)
+	if len(errs) != 0 {
+		t.Fatalf("ExecuteMultiShard failed: %v", errs)
+	}
+	got := strings.Split(qr.SessionStateChanges, ",")
+	sort.Strings(got)
+	want := []string{
+		"uuid:1",
+		"uuid:2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SessionStateChanges: %v, want %v", got, want)
+	}
+}
+
 func TestAppendResult(t *testing.T) {
 	qr := new(sqltypes.Result)
 	innerqr1 := &sqltypes.Result{
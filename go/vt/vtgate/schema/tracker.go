@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema keeps track of the columns of the tables served
+// by the tablets vtgate is talking to.
+package schema
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// columnsQuery returns the columns of all the tables of the database
+// the tablet is serving, in their definition order.
+const columnsQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+// loadTimeout is the maximum time a schema load is allowed to take.
+const loadTimeout = 30 * time.Second
+
+// Tracker keeps a per-keyspace cache of the columns of every table.
+// It listens to the health check updates of the MASTER tablets:
+// the schema of a keyspace is loaded the first time one of its
+// masters is seen serving, and reloaded every time a master reports
+// that some of its tables have changed.
+//
+// All the shards of a keyspace are expected to have the same schema,
+// so only the shard that reported the change is queried.
+type Tracker struct {
+	qs queryservice.QueryService
+
+	mu sync.Mutex
+	// tables is indexed by keyspace, then by table name.
+	tables map[string]map[string][]vindexes.Column
+	// loading contains the keyspaces for which a load is in progress.
+	// A true value means another load was requested in the meantime.
+	loading map[string]bool
+	signal  func()
+}
+
+// NewTracker creates a new Tracker. The schema is loaded by executing
+// queries against the MASTER tablets through qs.
+func NewTracker(qs queryservice.QueryService) *Tracker {
+	return &Tracker{
+		qs:      qs,
+		tables:  make(map[string]map[string][]vindexes.Column),
+		loading: make(map[string]bool),
+	}
+}
+
+// RegisterSignalReceiver sets the function to call every time the
+// schema of a keyspace has been (re)loaded.
+func (t *Tracker) RegisterSignalReceiver(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// StatsUpdate is part of the discovery.HealthCheckStatsListener interface.
+func (t *Tracker) StatsUpdate(ts *discovery.TabletStats) {
+	if ts.Target == nil || ts.Target.TabletType != topodatapb.TabletType_MASTER || !ts.Up || !ts.Serving {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, loaded := t.tables[ts.Target.Keyspace]
+	if loaded && len(ts.Stats.GetTableSchemaChanged()) == 0 {
+		return
+	}
+	if _, ok := t.loading[ts.Target.Keyspace]; ok {
+		t.loading[ts.Target.Keyspace] = true
+		return
+	}
+	t.loading[ts.Target.Keyspace] = false
+	target := *ts.Target
+	go t.load(&target)
+}
+
+// load reads the schema of the target's keyspace, and loops as long
+// as new loads are requested while it is running.
+func (t *Tracker) load(target *querypb.Target) {
+	for {
+		tables, err := t.loadTables(target)
+
+		t.mu.Lock()
+		if err != nil {
+			// Leave the keyspace as is: the next health
+			// check update of the master will retry.
+			log.Warningf("Error loading the schema of keyspace %v: %v", target.Keyspace, err)
+		} else {
+			t.tables[target.Keyspace] = tables
+		}
+		again := t.loading[target.Keyspace]
+		if !again {
+			delete(t.loading, target.Keyspace)
+		} else {
+			t.loading[target.Keyspace] = false
+		}
+		signal := t.signal
+		t.mu.Unlock()
+
+		if err == nil && signal != nil {
+			signal()
+		}
+		if !again {
+			return
+		}
+	}
+}
+
+func (t *Tracker) loadTables(target *querypb.Target) (map[string][]vindexes.Column, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+	qr, err := t.qs.Execute(ctx, target, columnsQuery, nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[string][]vindexes.Column)
+	for _, row := range qr.Rows {
+		tableName := row[0].ToString()
+		ct := &sqlparser.ColumnType{
+			Type:     strings.ToLower(row[2].ToString()),
+			Unsigned: sqlparser.BoolVal(strings.Contains(strings.ToLower(row[3].ToString()), "unsigned")),
+		}
+		tables[tableName] = append(tables[tableName], vindexes.Column{
+			Name: sqlparser.NewColIdent(row[1].ToString()),
+			Type: ct.SQLType(),
+		})
+	}
+	return tables, nil
+}
+
+// Tables returns the tables of the keyspace with their columns, or nil
+// if the schema of the keyspace has not been loaded yet.
+// The returned map must not be modified.
+func (t *Tracker) Tables(keyspace string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace]
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type fakeQueryService struct {
+	queryservice.QueryService
+
+	mu      sync.Mutex
+	result  *sqltypes.Result
+	targets []string
+}
+
+func (f *fakeQueryService) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.targets = append(f.targets, target.Keyspace+"/"+target.Shard)
+	return f.result, nil
+}
+
+func (f *fakeQueryService) setResult(rows ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.result = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name|data_type|column_type", "varchar|varchar|varchar|varchar"),
+		rows...,
+	)
+}
+
+func (f *fakeQueryService) getTargets() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.targets
+}
+
+func masterStats(keyspace, shard string, changed ...string) *discovery.TabletStats {
+	return &discovery.TabletStats{
+		Target: &querypb.Target{
+			Keyspace:   keyspace,
+			Shard:      shard,
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+		Up:      true,
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{TableSchemaChanged: changed},
+	}
+}
+
+func waitForSignal(t *testing.T, signal chan struct{}) {
+	t.Helper()
+	select {
+	case <-signal:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the schema to load")
+	}
+}
+
+func TestTracker(t *testing.T) {
+	qs := &fakeQueryService{QueryService: fakes.ErrorQueryService}
+	qs.setResult(
+		"t1|id|bigint|bigint(20) unsigned",
+		"t1|name|varchar|varchar(64)",
+		"t2|id|int|int(11)",
+	)
+	tracker := NewTracker(qs)
+	signal := make(chan struct{}, 10)
+	tracker.RegisterSignalReceiver(func() { signal <- struct{}{} })
+
+	// Non-master and non-serving tablets are ignored.
+	replica := masterStats("ks", "-80")
+	replica.Target.TabletType = topodatapb.TabletType_REPLICA
+	tracker.StatsUpdate(replica)
+	notServing := masterStats("ks", "-80")
+	notServing.Serving = false
+	tracker.StatsUpdate(notServing)
+	if got := tracker.Tables("ks"); got != nil {
+		t.Errorf("Tables: %v, want nil", got)
+	}
+
+	tracker.StatsUpdate(masterStats("ks", "-80"))
+	waitForSignal(t, signal)
+	want := map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Uint64},
+			{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+		},
+		"t2": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int32},
+		},
+	}
+	if got := tracker.Tables("ks"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tables: %v, want %v", got, want)
+	}
+
+	// Regular health updates don't trigger a reload.
+	tracker.StatsUpdate(masterStats("ks", "80-"))
+	// A schema change does.
+	qs.setResult("t1|id|bigint|bigint(20) unsigned")
+	tracker.StatsUpdate(masterStats("ks", "80-", "t2"))
+	waitForSignal(t, signal)
+	delete(want, "t2")
+	want["t1"] = want["t1"][:1]
+	if got := tracker.Tables("ks"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tables: %v, want %v", got, want)
+	}
+
+	wantTargets := []string{"ks/-80", "ks/80-"}
+	if got := qs.getTargets(); !reflect.DeepEqual(got, wantTargets) {
+		t.Errorf("queried targets: %v, want %v", got, wantTargets)
+	}
+}
@@ -98,7 +98,7 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error {
 	if err := txc.runSessions(session.PreSessions, func(s *vtgatepb.Session_ShardSession) error {
 		defer func() { s.TransactionId = 0 }()
-		return txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+		return txc.commitShard(ctx, s, session)
 	}); err != nil {
 		_ = txc.Rollback(ctx, session)
 		return err
@@ -106,7 +106,7 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
-		if err := txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
+		if err := txc.commitShard(ctx, shardSession, session); err != nil {
 			shardSession.TransactionId = 0
 			_ = txc.Rollback(ctx, session)
 			return err
@@ -116,7 +116,7 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	if err := txc.runSessions(session.PostSessions, func(s *vtgatepb.Session_ShardSession) error {
 		defer func() { s.TransactionId = 0 }()
-		return txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+		return txc.commitShard(ctx, s, session)
 	}); err != nil {
 		// If last commit fails, there will be nothing to rollback.
 		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("post-operation transaction had an error: %v", err)})
@@ -124,6 +124,17 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 	return nil
 }
 
+// commitShard commits the transaction of a shard session, and
+// records the GTIDs the tablet reported for it.
+func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSession, session *SafeSession) error {
+	sessionStateChanges, err := txc.gateway.Commit(ctx, s.Target, s.TransactionId)
+	if err != nil {
+		return err
+	}
+	session.RecordSessionStateChanges(sessionStateChanges)
+	return nil
+}
+
 func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 	if len(session.PreSessions) != 0 || len(session.PostSessions) != 0 {
 		_ = txc.Rollback(ctx, session)
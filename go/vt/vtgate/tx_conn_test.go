@@ -17,6 +17,8 @@ limitations under the License.
 package vtgate
 
 import (
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -143,6 +145,32 @@ func TestTxConnCommitSuccess(t *testing.T) {
 	}
 }
 
+func TestTxConnCommitSessionStateChanges(t *testing.T) {
+	sc, sbc0, sbc1, _, _, rss01 := newTestTxConnEnv(t, "TestTxConn")
+	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
+	sbc0.CommitSessionStateChanges = "00010203-0405-0607-0809-0a0b0c0d0e0f:3"
+	sbc1.CommitSessionStateChanges = "00010203-0405-0607-0809-0a0b0c0d0eff:7"
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	sc.Execute(context.Background(), "query1", nil, rss01, topodatapb.TabletType_MASTER, session, false, nil)
+	if err := sc.txConn.Commit(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+	// The shards are committed in the order they joined the transaction.
+	got := strings.Split(session.TakeSessionStateChanges(), ",")
+	sort.Strings(got)
+	want := []string{
+		"00010203-0405-0607-0809-0a0b0c0d0e0f:3",
+		"00010203-0405-0607-0809-0a0b0c0d0eff:7",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeSessionStateChanges: %v, want %v", got, want)
+	}
+	if got := session.TakeSessionStateChanges(); got != "" {
+		t.Errorf("TakeSessionStateChanges after take: %v, want empty", got)
+	}
+}
+
 func TestTxConnCommitOrderFailure1(t *testing.T) {
 	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
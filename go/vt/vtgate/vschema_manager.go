@@ -36,6 +36,22 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	schema            SchemaInfo
+	// built is incremented by every vschema build, under mu.
+	built int64
+
+	// saveMu serializes the saves of the built vschemas, so that
+	// an older build never replaces a newer one. It is not held
+	// with mu.
+	saveMu sync.Mutex
+	saved  int64
+}
+
+// SchemaInfo is the interface to the tracked schema of the keyspaces.
+type SchemaInfo interface {
+	// Tables returns the tables of the keyspace with their columns,
+	// or nil if the schema of the keyspace is not known.
+	Tables(keyspace string) map[string][]vindexes.Column
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -77,12 +93,11 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		// keep a copy of the latest SrvVschema
 		vm.mu.Lock()
 		vm.currentSrvVschema = v
-		vm.mu.Unlock()
 
 		// Transform the provided SrvVSchema into a VSchema.
 		var vschema *vindexes.VSchema
 		if v != nil {
-			vschema, err = vindexes.BuildVSchema(v)
+			vschema, err = vm.buildVSchema(v)
 			if err != nil {
 				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
 				err = fmt.Errorf("error creating VSchema for cell %v: %v", cell, err)
@@ -113,11 +128,87 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		if v == nil && vm.e.vschema != nil {
 			vschema = vm.e.vschema
 		}
+		vm.built++
+		build := vm.built
+		vm.mu.Unlock()
 
-		vm.e.SaveVSchema(vschema, stats)
+		vm.saveVSchema(build, vschema, stats)
 	})
 }
 
+// setSchemaInfo sets the source of the column lists for the tables
+// that don't have any in the vschema, and rebuilds the vschema.
+func (vm *VSchemaManager) setSchemaInfo(schema SchemaInfo) {
+	vm.mu.Lock()
+	vm.schema = schema
+	vm.mu.Unlock()
+	vm.Rebuild()
+}
+
+// Rebuild rebuilds the vschema from the latest SrvVSchema and the
+// tracked schema. It is called when the tracked schema changes.
+func (vm *VSchemaManager) Rebuild() {
+	vm.mu.Lock()
+	if vm.currentSrvVschema == nil {
+		vm.mu.Unlock()
+		return
+	}
+	vschema, err := vm.buildVSchema(vm.currentSrvVschema)
+	if err != nil {
+		vm.mu.Unlock()
+		// The SrvVSchema was already built successfully once,
+		// so this should not happen.
+		log.Warningf("Error rebuilding VSchema: %v", err)
+		return
+	}
+	vm.built++
+	build := vm.built
+	vm.mu.Unlock()
+
+	vm.saveVSchema(build, vschema, NewVSchemaStats(vschema, ""))
+}
+
+// saveVSchema saves the vschema of the given build in the executor,
+// unless a newer build was already saved. It must be called without
+// vm.mu held.
+func (vm *VSchemaManager) saveVSchema(build int64, vschema *vindexes.VSchema, stats *VSchemaStats) {
+	vm.saveMu.Lock()
+	defer vm.saveMu.Unlock()
+	if build < vm.saved {
+		return
+	}
+	vm.saved = build
+	vm.e.SaveVSchema(vschema, stats)
+}
+
+// buildVSchema builds the VSchema from the SrvVSchema, and fills in the
+// columns of the tables that have none from the tracked schema.
+// It must be called with vm.mu held.
+func (vm *VSchemaManager) buildVSchema(v *vschemapb.SrvVSchema) (*vindexes.VSchema, error) {
+	vschema, err := vindexes.BuildVSchema(v)
+	if err != nil || vm.schema == nil {
+		return vschema, err
+	}
+	for ksName, ks := range vschema.Keyspaces {
+		tables := vm.schema.Tables(ksName)
+		if tables == nil {
+			continue
+		}
+		for tableName, table := range ks.Tables {
+			if len(table.Columns) != 0 {
+				continue
+			}
+			columns, ok := tables[tableName]
+			if !ok {
+				continue
+			}
+			table.Columns = columns
+			table.ColumnListAuthoritative = true
+		}
+	}
+	return vschema, nil
+}
+
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
 // is updated in all known cells.
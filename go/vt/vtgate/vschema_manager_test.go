@@ -0,0 +1,104 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+type fakeSchemaInfo map[string]map[string][]vindexes.Column
+
+func (f fakeSchemaInfo) Tables(keyspace string) map[string][]vindexes.Column {
+	return f[keyspace]
+}
+
+func TestVSchemaManagerSchemaInfo(t *testing.T) {
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {
+						Columns: []*vschemapb.Column{{Name: "c1"}},
+					},
+					"t3": {},
+				},
+			},
+		},
+	}
+	trackedColumns := []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64}}
+	vm := &VSchemaManager{
+		schema: fakeSchemaInfo{
+			"ks": {
+				"t1": trackedColumns,
+				"t2": trackedColumns,
+			},
+		},
+	}
+	vschema, err := vm.buildVSchema(srvVSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables := vschema.Keyspaces["ks"].Tables
+
+	// Column-less tables get the tracked columns.
+	if got := tables["t1"].Columns; !reflect.DeepEqual(got, trackedColumns) {
+		t.Errorf("t1 columns: %v, want %v", got, trackedColumns)
+	}
+	if !tables["t1"].ColumnListAuthoritative {
+		t.Errorf("t1 column list is not authoritative")
+	}
+
+	// Columns from the vschema take precedence.
+	wantColumns := []vindexes.Column{{Name: sqlparser.NewColIdent("c1"), Type: sqltypes.Null}}
+	if got := tables["t2"].Columns; !reflect.DeepEqual(got, wantColumns) {
+		t.Errorf("t2 columns: %v, want %v", got, wantColumns)
+	}
+	if tables["t2"].ColumnListAuthoritative {
+		t.Errorf("t2 column list is authoritative")
+	}
+
+	// Untracked tables are left alone.
+	if got := tables["t3"].Columns; got != nil {
+		t.Errorf("t3 columns: %v, want nil", got)
+	}
+}
+
+func TestVSchemaManagerSaveOrder(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	vm := &executor.vm
+	newer, _ := vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
+	older, _ := vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
+
+	// A build that finishes saving after a newer one is dropped.
+	vm.mu.Lock()
+	vm.built += 2
+	build := vm.built
+	vm.mu.Unlock()
+	vm.saveVSchema(build, newer, NewVSchemaStats(newer, ""))
+	vm.saveVSchema(build-1, older, NewVSchemaStats(older, ""))
+	if executor.VSchema() != newer {
+		t.Errorf("the older vschema replaced the newer one")
+	}
+}
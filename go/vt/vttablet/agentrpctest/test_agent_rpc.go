@@ -925,6 +925,26 @@ func agentRPCTestResetReplicationPanic(ctx context.Context, t *testing.T, client
 	expectHandleRPCPanic(t, "ResetReplication", true /*verbose*/, err)
 }
 
+var testInjectEmptyTransactionsGTIDs = "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"
+
+func (fra *fakeRPCAgent) InjectEmptyTransactions(ctx context.Context, gtids string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "InjectEmptyTransactions gtids", gtids, testInjectEmptyTransactionsGTIDs)
+	return nil
+}
+
+func agentRPCTestInjectEmptyTransactions(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.InjectEmptyTransactions(ctx, tablet, testInjectEmptyTransactionsGTIDs)
+	compareError(t, "InjectEmptyTransactions", err, true, true)
+}
+
+func agentRPCTestInjectEmptyTransactionsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.InjectEmptyTransactions(ctx, tablet, testInjectEmptyTransactionsGTIDs)
+	expectHandleRPCPanic(t, "InjectEmptyTransactions", true /*verbose*/, err)
+}
+
 func (fra *fakeRPCAgent) InitMaster(ctx context.Context) (string, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -1198,17 +1218,22 @@ func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+var testRestoreToTime = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+var testRestoreToPos = "MariaDB/1-123-456"
+
+func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup restoreToTime", restoreToTime.UTC(), testRestoreToTime)
+	compare(fra.t, "RestoreFromBackup restoreToPos", restoreToPos, testRestoreToPos)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToTime, testRestoreToPos)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1217,7 +1242,7 @@ func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmc
 }
 
 func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToTime, testRestoreToPos)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1228,6 +1253,107 @@ func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, clien
 	expectHandleRPCPanic(t, "RestoreFromBackup", true /*verbose*/, err)
 }
 
+var testBackupMaxRate int64 = 50 * 1024 * 1024
+var testPreviousBackupMaxRate int64 = 10 * 1024 * 1024
+
+func (fra *fakeRPCAgent) SetBackupMaxRate(ctx context.Context, maxRate int64) (int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetBackupMaxRate maxRate", maxRate, testBackupMaxRate)
+	return testPreviousBackupMaxRate, nil
+}
+
+func agentRPCTestSetBackupMaxRate(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	previousMaxRate, err := client.SetBackupMaxRate(ctx, tablet, testBackupMaxRate)
+	compareError(t, "SetBackupMaxRate", err, previousMaxRate, testPreviousBackupMaxRate)
+}
+
+func agentRPCTestSetBackupMaxRatePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SetBackupMaxRate(ctx, tablet, testBackupMaxRate)
+	expectHandleRPCPanic(t, "SetBackupMaxRate", true /*verbose*/, err)
+}
+
+//
+// MySQL configuration related methods
+//
+
+var testMysqlVariableNames = []string{"max_connections", "innodb_log_file_size"}
+var testMysqlVariables = map[string]string{
+	"max_connections":      "100",
+	"innodb_log_file_size": "50331648",
+}
+var testPersistedMysqlVariables = map[string]string{
+	"max_connections": "100",
+}
+var testApplyMysqlVariables = map[string]string{
+	"max_connections":      "200",
+	"innodb_log_file_size": "100663296",
+}
+var testApplyMysqlVariablesAllowRestart = true
+var testRestartRequiredMysqlVariables = []string{"innodb_log_file_size"}
+
+func (fra *fakeRPCAgent) GetMysqlVariables(ctx context.Context, names []string) (map[string]string, map[string]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetMysqlVariables names", names, testMysqlVariableNames)
+	return testMysqlVariables, testPersistedMysqlVariables, nil
+}
+
+func agentRPCTestGetMysqlVariables(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	variables, persisted, err := client.GetMysqlVariables(ctx, tablet, testMysqlVariableNames)
+	compareError(t, "GetMysqlVariables", err, variables, testMysqlVariables)
+	compare(t, "GetMysqlVariables persisted", persisted, testPersistedMysqlVariables)
+}
+
+func agentRPCTestGetMysqlVariablesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetMysqlVariables(ctx, tablet, testMysqlVariableNames)
+	expectHandleRPCPanic(t, "GetMysqlVariables", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) ApplyMysqlVariables(ctx context.Context, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ApplyMysqlVariables variables", variables, testApplyMysqlVariables)
+	compareBool(fra.t, "ApplyMysqlVariables allowRestart", allowRestart)
+	return testMysqlVariables, testRestartRequiredMysqlVariables, nil
+}
+
+func agentRPCTestApplyMysqlVariables(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	previous, restartRequired, err := client.ApplyMysqlVariables(ctx, tablet, testApplyMysqlVariables, testApplyMysqlVariablesAllowRestart)
+	compareError(t, "ApplyMysqlVariables", err, previous, testMysqlVariables)
+	compare(t, "ApplyMysqlVariables restartRequired", restartRequired, testRestartRequiredMysqlVariables)
+}
+
+func agentRPCTestApplyMysqlVariablesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.ApplyMysqlVariables(ctx, tablet, testApplyMysqlVariables, testApplyMysqlVariablesAllowRestart)
+	expectHandleRPCPanic(t, "ApplyMysqlVariables", true /*verbose*/, err)
+}
+
+var testUpgradeHook = "upgrade_mysqld"
+
+func (fra *fakeRPCAgent) RestartMysqld(ctx context.Context, upgradeHook string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RestartMysqld upgradeHook", upgradeHook, testUpgradeHook)
+	return nil
+}
+
+func agentRPCTestRestartMysqld(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RestartMysqld(ctx, tablet, testUpgradeHook)
+	if err != nil {
+		t.Errorf("RestartMysqld failed: %v", err)
+	}
+}
+
+func agentRPCTestRestartMysqldPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RestartMysqld(ctx, tablet, testUpgradeHook)
+	expectHandleRPCPanic(t, "RestartMysqld", true /*verbose*/, err)
+}
+
 //
 // RPC helpers
 //
@@ -1287,6 +1413,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 
 	// Reparenting related functions
 	agentRPCTestResetReplication(ctx, t, client, tablet)
+	agentRPCTestInjectEmptyTransactions(ctx, t, client, tablet)
 	agentRPCTestInitMaster(ctx, t, client, tablet)
 	agentRPCTestPopulateReparentJournal(ctx, t, client, tablet)
 	agentRPCTestInitSlave(ctx, t, client, tablet)
@@ -1302,6 +1429,12 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Backup / restore related methods
 	agentRPCTestBackup(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackup(ctx, t, client, tablet)
+	agentRPCTestSetBackupMaxRate(ctx, t, client, tablet)
+
+	// MySQL configuration related methods
+	agentRPCTestGetMysqlVariables(ctx, t, client, tablet)
+	agentRPCTestApplyMysqlVariables(ctx, t, client, tablet)
+	agentRPCTestRestartMysqld(ctx, t, client, tablet)
 
 	//
 	// Tests panic handling everywhere now
@@ -1341,6 +1474,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 
 	// Reparenting related functions
 	agentRPCTestResetReplicationPanic(ctx, t, client, tablet)
+	agentRPCTestInjectEmptyTransactionsPanic(ctx, t, client, tablet)
 	agentRPCTestInitMasterPanic(ctx, t, client, tablet)
 	agentRPCTestPopulateReparentJournalPanic(ctx, t, client, tablet)
 	agentRPCTestInitSlavePanic(ctx, t, client, tablet)
@@ -1356,6 +1490,12 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Backup / restore related methods
 	agentRPCTestBackupPanic(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackupPanic(ctx, t, client, tablet)
+	agentRPCTestSetBackupMaxRatePanic(ctx, t, client, tablet)
+
+	// MySQL configuration related methods
+	agentRPCTestGetMysqlVariablesPanic(ctx, t, client, tablet)
+	agentRPCTestApplyMysqlVariablesPanic(ctx, t, client, tablet)
+	agentRPCTestRestartMysqldPanic(ctx, t, client, tablet)
 
 	client.Close()
 }
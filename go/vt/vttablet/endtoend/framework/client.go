@@ -96,7 +96,8 @@ func (client *QueryClient) Begin(clientFoundRows bool) error {
 // Commit commits the current transaction.
 func (client *QueryClient) Commit() error {
 	defer func() { client.transactionID = 0 }()
-	return client.server.Commit(client.ctx, &client.target, client.transactionID)
+	_, err := client.server.Commit(client.ctx, &client.target, client.transactionID)
+	return err
 }
 
 // Rollback rolls back the current transaction.
@@ -240,6 +240,11 @@ func (client *FakeTabletManagerClient) ResetReplication(ctx context.Context, tab
 	return nil
 }
 
+// InjectEmptyTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	return nil
+}
+
 // InitMaster is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	return "", nil
@@ -311,10 +316,34 @@ func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topod
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
+// SetBackupMaxRate is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetBackupMaxRate(ctx context.Context, tablet *topodatapb.Tablet, maxRate int64) (int64, error) {
+	return 0, nil
+}
+
+//
+// MySQL configuration related methods
+//
+
+// GetMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, names []string) (map[string]string, map[string]string, error) {
+	return map[string]string{}, map[string]string{}, nil
+}
+
+// ApplyMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ApplyMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	return map[string]string{}, nil, nil
+}
+
+// RestartMysqld is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RestartMysqld(ctx context.Context, tablet *topodatapb.Tablet, upgradeHook string) error {
+	return nil
+}
+
 //
 // Management related methods
 //
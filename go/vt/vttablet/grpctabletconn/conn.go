@@ -218,11 +218,11 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 }
 
 // Commit commits the ongoing transaction.
-func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return tabletconn.ConnClosed
+		return "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.CommitRequest{
@@ -231,11 +231,11 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		TransactionId:     transactionID,
 	}
-	_, err := conn.c.Commit(ctx, req)
+	cr, err := conn.c.Commit(ctx, req)
 	if err != nil {
-		return tabletconn.ErrorFromGRPC(err)
+		return "", tabletconn.ErrorFromGRPC(err)
 	}
-	return nil
+	return cr.SessionStateChanges, nil
 }
 
 // Rollback rolls back the ongoing transaction.
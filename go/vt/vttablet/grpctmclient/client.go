@@ -615,6 +615,19 @@ func (client *Client) ResetReplication(ctx context.Context, tablet *topodatapb.T
 	return err
 }
 
+// InjectEmptyTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.InjectEmptyTransactions(ctx, &tabletmanagerdatapb.InjectEmptyTransactionsRequest{
+		Gtids: gtids,
+	})
+	return err
+}
+
 // InitMaster is part of the tmclient.TabletManagerClient interface.
 func (client *Client) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	cc, c, err := client.dial(tablet)
@@ -822,13 +835,16 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToTime: logutil.TimeToProto(restoreToTime),
+		RestoreToPos:  restoreToPos,
+	})
 	if err != nil {
 		cc.Close()
 		return nil, err
@@ -839,6 +855,72 @@ func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.
 	}, nil
 }
 
+// SetBackupMaxRate is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetBackupMaxRate(ctx context.Context, tablet *topodatapb.Tablet, maxRate int64) (int64, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer cc.Close()
+	response, err := c.SetBackupMaxRate(ctx, &tabletmanagerdatapb.SetBackupMaxRateRequest{
+		MaxRate: maxRate,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.PreviousMaxRate, nil
+}
+
+//
+// MySQL configuration related methods
+//
+
+// GetMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, names []string) (map[string]string, map[string]string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetMysqlVariables(ctx, &tabletmanagerdatapb.GetMysqlVariablesRequest{
+		Names: names,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Variables, response.Persisted, nil
+}
+
+// ApplyMysqlVariables is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ApplyMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cc.Close()
+	response, err := c.ApplyMysqlVariables(ctx, &tabletmanagerdatapb.ApplyMysqlVariablesRequest{
+		Variables:    variables,
+		AllowRestart: allowRestart,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Previous, response.RestartRequired, nil
+}
+
+// RestartMysqld is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RestartMysqld(ctx context.Context, tablet *topodatapb.Tablet, upgradeHook string) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.RestartMysqld(ctx, &tabletmanagerdatapb.RestartMysqldRequest{
+		UpgradeHook: upgradeHook,
+	})
+	return err
+}
+
 // Close is part of the tmclient.TabletManagerClient interface.
 func (client *Client) Close() {
 	client.mu.Lock()
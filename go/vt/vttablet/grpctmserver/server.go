@@ -343,6 +343,13 @@ func (s *server) ResetReplication(ctx context.Context, request *tabletmanagerdat
 	return response, s.agent.ResetReplication(ctx)
 }
 
+func (s *server) InjectEmptyTransactions(ctx context.Context, request *tabletmanagerdatapb.InjectEmptyTransactionsRequest) (response *tabletmanagerdatapb.InjectEmptyTransactionsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "InjectEmptyTransactions", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.InjectEmptyTransactionsResponse{}
+	return response, s.agent.InjectEmptyTransactions(ctx, request.Gtids)
+}
+
 func (s *server) InitMaster(ctx context.Context, request *tabletmanagerdatapb.InitMasterRequest) (response *tabletmanagerdatapb.InitMasterResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "InitMaster", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -474,7 +481,49 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.agent.RestoreFromBackup(ctx, logger)
+	return s.agent.RestoreFromBackup(ctx, logger, logutil.ProtoToTime(request.RestoreToTime), request.RestoreToPos)
+}
+
+func (s *server) SetBackupMaxRate(ctx context.Context, request *tabletmanagerdatapb.SetBackupMaxRateRequest) (response *tabletmanagerdatapb.SetBackupMaxRateResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetBackupMaxRate", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetBackupMaxRateResponse{}
+	previousMaxRate, err := s.agent.SetBackupMaxRate(ctx, request.MaxRate)
+	if err == nil {
+		response.PreviousMaxRate = previousMaxRate
+	}
+	return response, err
+}
+
+func (s *server) GetMysqlVariables(ctx context.Context, request *tabletmanagerdatapb.GetMysqlVariablesRequest) (response *tabletmanagerdatapb.GetMysqlVariablesResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetMysqlVariables", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetMysqlVariablesResponse{}
+	variables, persisted, err := s.agent.GetMysqlVariables(ctx, request.Names)
+	if err == nil {
+		response.Variables = variables
+		response.Persisted = persisted
+	}
+	return response, err
+}
+
+func (s *server) ApplyMysqlVariables(ctx context.Context, request *tabletmanagerdatapb.ApplyMysqlVariablesRequest) (response *tabletmanagerdatapb.ApplyMysqlVariablesResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ApplyMysqlVariables", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ApplyMysqlVariablesResponse{}
+	previous, restartRequired, err := s.agent.ApplyMysqlVariables(ctx, request.Variables, request.AllowRestart)
+	if err == nil {
+		response.Previous = previous
+		response.RestartRequired = restartRequired
+	}
+	return response, err
+}
+
+func (s *server) RestartMysqld(ctx context.Context, request *tabletmanagerdatapb.RestartMysqldRequest) (response *tabletmanagerdatapb.RestartMysqldResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "RestartMysqld", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RestartMysqldResponse{}
+	return response, s.agent.RestartMysqld(ctx, request.UpgradeHook)
 }
 
 // registration glue
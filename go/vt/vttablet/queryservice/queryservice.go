@@ -43,8 +43,10 @@ type QueryService interface {
 	// Begin returns the transaction id to use for further operations
 	Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, error)
 
-	// Commit commits the current transaction
-	Commit(ctx context.Context, target *querypb.Target, transactionID int64) error
+	// Commit commits the current transaction. It returns the session
+	// state changes reported by MySQL for the commit, if
+	// ExecuteOptions.SessionTrackGtids was set for Begin.
+	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error)
 
 	// Rollback aborts the current transaction
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error
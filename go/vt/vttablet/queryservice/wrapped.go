@@ -92,11 +92,13 @@ func (ws *wrappedService) Begin(ctx context.Context, target *querypb.Target, opt
 	return transactionID, err
 }
 
-func (ws *wrappedService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return ws.wrapper(ctx, target, ws.impl, "Commit", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.Commit(ctx, target, transactionID)
+func (ws *wrappedService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (sessionStateChanges string, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "Commit", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		sessionStateChanges, innerErr = conn.Commit(ctx, target, transactionID)
 		return canRetry(ctx, innerErr), innerErr
 	})
+	return sessionStateChanges, err
 }
 
 func (ws *wrappedService) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error {
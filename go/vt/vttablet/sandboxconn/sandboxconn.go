@@ -84,6 +84,9 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// CommitSessionStateChanges is returned by Commit.
+	CommitSessionStateChanges string
+
 	MessageIDs []*querypb.Value
 
 	// vstream expectations.
@@ -187,9 +190,12 @@ func (sbc *SandboxConn) Begin(ctx context.Context, target *querypb.Target, optio
 }
 
 // Commit is part of the QueryService interface.
-func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	sbc.CommitCount.Add(1)
-	return sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return "", err
+	}
+	return sbc.CommitSessionStateChanges, nil
 }
 
 // Rollback is part of the QueryService interface.
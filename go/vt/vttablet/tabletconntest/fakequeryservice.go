@@ -151,10 +151,13 @@ func (f *FakeQueryService) Begin(ctx context.Context, target *querypb.Target, op
 // CommitTransactionID is a test transaction id for Commit.
 const CommitTransactionID int64 = 999044
 
+// CommitSessionStateChanges is the test session state changes returned by Commit.
+const CommitSessionStateChanges = "00010203-0405-0607-0809-0a0b0c0d0e0f:44"
+
 // Commit is part of the queryservice.QueryService interface
-func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	if f.HasError {
-		return f.TabletError
+		return "", f.TabletError
 	}
 	if f.Panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -163,7 +166,7 @@ func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, t
 	if transactionID != CommitTransactionID {
 		f.t.Errorf("Commit: invalid TransactionId: got %v expected %v", transactionID, CommitTransactionID)
 	}
-	return nil
+	return CommitSessionStateChanges, nil
 }
 
 // RollbackTransactionID is a test transactin id for Rollback.
@@ -129,17 +129,21 @@ func testCommit(t *testing.T, conn queryservice.QueryService, f *FakeQueryServic
 	t.Log("testCommit")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+	sessionStateChanges, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
 	if err != nil {
 		t.Fatalf("Commit failed: %v", err)
 	}
+	if sessionStateChanges != CommitSessionStateChanges {
+		t.Errorf("Commit returned session state changes %v, want %v", sessionStateChanges, CommitSessionStateChanges)
+	}
 }
 
 func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testCommitError")
 	f.HasError = true
 	testErrorHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 	f.HasError = false
 }
@@ -147,7 +151,8 @@ func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryS
 func testCommitPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testCommitPanics")
 	testPanicHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 }
 
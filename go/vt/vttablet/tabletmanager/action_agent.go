@@ -224,6 +224,16 @@ type ActionAgent struct {
 	// _masterTermStartTime is the time at which our term as master began.
 	_masterTermStartTime time.Time
 
+	// _durabilityPolicy is the durability policy of our keyspace. It is
+	// read at startup and on every state refresh.
+	_durabilityPolicy string
+
+	// _masterCell is the cell of the master of our shard, which
+	// decides if we acknowledge its semi-sync writes with the
+	// cross_cell durability policy. It is read with the durability
+	// policy, and set when we are reparented.
+	_masterCell string
+
 	// _ignoreHealthErrorExpr can be set by RPC to selectively disable certain
 	// healthcheck errors. It should only be accessed while holding actionMutex.
 	_ignoreHealthErrorExpr *regexp.Regexp
@@ -394,6 +404,9 @@ func NewActionAgent(
 		go agent.orc.DiscoverLoop(agent)
 	}
 
+	// Start periodic binlog archiving, if configured.
+	go agent.binlogArchiveLoop()
+
 	return agent, nil
 }
 
@@ -744,6 +757,7 @@ func (agent *ActionAgent) Start(ctx context.Context, mysqlHost string, mysqlPort
 	startingTablet := proto.Clone(agent.initialTablet).(*topodatapb.Tablet)
 	startingTablet.Type = topodatapb.TabletType_UNKNOWN
 	agent.setTablet(startingTablet)
+	agent.refreshDurabilityPolicy(ctx)
 
 	// Start a background goroutine to watch and update the shard record,
 	// to make sure it and our tablet record are in sync.
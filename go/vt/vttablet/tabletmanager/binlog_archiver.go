@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file archives the binlogs of the master to the BackupStorage,
+// for point in time restores.
+
+var (
+	binlogArchiveInterval  = flag.Duration("binlog_archive_interval", 0, "How often the master flushes its binary logs and archives the closed binlog files to the backup storage, for point in time restores. 0 means never.")
+	binlogArchiveTimeout   = flag.Duration("binlog_archive_timeout", 10*time.Minute, "Timeout to archive the closed binlog files of the master")
+	binlogArchiveRetention = flag.Duration("binlog_archive_retention", 0, "How long the archived binlog files are kept, from the time they were closed. It must be longer than the backups are kept, for point in time restores from the oldest backups. 0 means forever.")
+)
+
+// binlogArchiveLoop periodically flushes the binary logs and archives
+// the closed binlog files while the tablet is the master, until process
+// termination. Flushing closes the current binlog file, so a point in
+// time restore can reach the transactions of the last interval even if
+// the master doesn't write enough to rotate its binlogs.
+// Usually this will be launched as a background goroutine.
+func (agent *ActionAgent) binlogArchiveLoop() {
+	if *binlogArchiveInterval == 0 || agent.Cnf == nil {
+		// 0 means never.
+		return
+	}
+	log.Infof("Starting periodic binlog archiving, interval = %v", *binlogArchiveInterval)
+
+	ticker := timer.NewRandTicker(*binlogArchiveInterval, *binlogArchiveInterval/4)
+	for {
+		// The only way to stop the loop is to terminate the process.
+		<-ticker.C
+
+		tablet := agent.Tablet()
+		if tablet.Type != topodatapb.TabletType_MASTER {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(agent.batchCtx, *binlogArchiveTimeout)
+		if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+			cancel()
+			log.Warningf("Cannot flush the binary logs before archiving them: %v", err)
+			continue
+		}
+		count, err := mysqlctl.ArchiveBinlogs(ctx, mysqlctl.BinlogArchiveParams{
+			Cnf:         agent.Cnf,
+			Mysqld:      agent.MysqlDaemon,
+			Logger:      logutil.NewConsoleLogger(),
+			Keyspace:    tablet.Keyspace,
+			Shard:       tablet.Shard,
+			TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+			Retention:   *binlogArchiveRetention,
+		})
+		cancel()
+		if err != nil {
+			log.Warningf("Binlog archiving failed after archiving %v files: %v", count, err)
+			continue
+		}
+		if count > 0 {
+			log.Infof("Archived %v binlog files", count)
+		}
+	}
+}
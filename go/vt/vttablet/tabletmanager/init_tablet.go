@@ -198,6 +198,7 @@ func (agent *ActionAgent) InitTablet(port, gRPCPort int32) error {
 		Type:           tabletType,
 		DbNameOverride: *initDbNameOverride,
 		Tags:           initTags,
+		MasterDelay:    int32(masterDelay.Seconds()),
 	}
 	if !agent.masterTermStartTime().IsZero() {
 		tablet.MasterTermStartTime = logutil.TimeToProto(agent.masterTermStartTime())
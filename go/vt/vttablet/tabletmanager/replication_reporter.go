@@ -98,8 +98,12 @@ func (r *replicationReporter) Report(isSlaveType, shouldQueryServiceBeRunning bo
 		return elapsed + r.lastKnownValue, nil
 	}
 
-	// we got a real value, save it.
-	r.lastKnownValue = time.Duration(status.SecondsBehindMaster) * time.Second
+	// we got a real value, save it. The lag of a delayed replica
+	// doesn't include its delay.
+	r.lastKnownValue = time.Duration(status.SecondsBehindMaster)*time.Second - *masterDelay
+	if r.lastKnownValue < 0 {
+		r.lastKnownValue = 0
+	}
 	r.lastKnownTime = r.now()
 	return r.lastKnownValue, nil
 }
@@ -41,6 +41,32 @@ func TestBasicMySQLReplicationLag(t *testing.T) {
 	}
 }
 
+func TestDelayedMySQLReplicationLag(t *testing.T) {
+	*masterDelay = time.Hour
+	defer func() { *masterDelay = 0 }()
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	mysqld.Replicating = true
+	mysqld.SecondsBehindMaster = 3610
+	slaveStopped := true
+
+	rep := &replicationReporter{
+		agent: &ActionAgent{MysqlDaemon: mysqld, _slaveStopped: &slaveStopped},
+		now:   time.Now,
+	}
+	dur, err := rep.Report(true, true)
+	if err != nil || dur != 10*time.Second {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+
+	// Catching up with less than the delay is no lag.
+	mysqld.SecondsBehindMaster = 10
+	dur, err = rep.Report(true, true)
+	if err != nil || dur != 0 {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+}
+
 func TestNoKnownMySQLReplicationLag(t *testing.T) {
 	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
 	mysqld.Replicating = false
@@ -58,10 +58,14 @@ func (agent *ActionAgent) RestoreData(ctx context.Context, logger logutil.Logger
 	if agent.Cnf == nil {
 		return fmt.Errorf("cannot perform restore without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
-	return agent.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	return agent.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, time.Time{}, mysql.Position{})
 }
 
-func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the most recent backup. If restoreToTime or
+// restoreToPos is set, the tablet is restored to that point in time from
+// the archived binlogs. It then doesn't replicate, and is DRAINED so it
+// doesn't serve.
+func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToTime time.Time, restoreToPos mysql.Position) error {
 	// change type to RESTORE (using UpdateTabletFields so it's
 	// always authorized)
 	var originalType topodatapb.TabletType
@@ -112,7 +116,10 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToTime:       restoreToTime,
+		RestoreToPos:        restoreToPos,
 	}
+	pointInTime := !restoreToTime.IsZero() || !restoreToPos.IsZero()
 
 	// Loop until a backup exists, unless we were told to give up immediately.
 	var backupManifest *mysqlctl.BackupManifest
@@ -142,7 +149,13 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if pointInTime {
+			// Replicating would bring the tablet past the restore point.
+			if err := agent.stopReplicationAfterPointInTimeRestore(context.Background()); err != nil {
+				return err
+			}
+			originalType = topodatapb.TabletType_DRAINED
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := agent.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
@@ -167,7 +180,7 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 
 	// If we had type BACKUP or RESTORE it's better to set our type to the init_tablet_type to make result of the restore
 	// similar to completely clean start from scratch.
-	if (originalType == topodatapb.TabletType_BACKUP || originalType == topodatapb.TabletType_RESTORE) && *initTabletType != "" {
+	if (originalType == topodatapb.TabletType_BACKUP || originalType == topodatapb.TabletType_RESTORE) && *initTabletType != "" && !pointInTime {
 		initType, err := topoproto.ParseTabletType(*initTabletType)
 		if err == nil {
 			originalType = initType
@@ -190,6 +203,21 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	return nil
 }
 
+// stopReplicationAfterPointInTimeRestore makes sure the tablet
+// doesn't replicate after a point in time restore, even if the
+// restored backup has replication settings.
+func (agent *ActionAgent) stopReplicationAfterPointInTimeRestore(ctx context.Context) error {
+	agent.setSlaveStopped(true)
+	cmds := []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL", // "ALL" makes it forget master host:port.
+	}
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return vterrors.Wrap(err, "failed to reset slave")
+	}
+	return nil
+}
+
 func (agent *ActionAgent) startReplication(ctx context.Context, pos mysql.Position, tabletType topodatapb.TabletType) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -104,6 +104,8 @@ type RPCAgent interface {
 
 	ResetReplication(ctx context.Context) error
 
+	InjectEmptyTransactions(ctx context.Context, gtids string) error
+
 	InitMaster(ctx context.Context) (string, error)
 
 	PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error
@@ -130,7 +132,17 @@ type RPCAgent interface {
 
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error
+
+	SetBackupMaxRate(ctx context.Context, maxRate int64) (int64, error)
+
+	// MySQL configuration related methods
+
+	GetMysqlVariables(ctx context.Context, names []string) (map[string]string, map[string]string, error)
+
+	ApplyMysqlVariables(ctx context.Context, variables map[string]string, allowRestart bool) (map[string]string, []string, error)
+
+	RestartMysqld(ctx context.Context, upgradeHook string) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -143,7 +144,9 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToTime or restoreToPos is set, it restores the tablet to that point
+// in time instead, see restoreDataLocked.
+func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -156,12 +159,18 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 	if tablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot restore from backup, if you really need to do this, restart vttablet in replica mode")
 	}
+	var pos mysql.Position
+	if restoreToPos != "" {
+		if pos, err = mysql.DecodePosition(restoreToPos); err != nil {
+			return err
+		}
+	}
 
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = agent.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = agent.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, restoreToTime, pos)
 
 	// re-run health check to be sure to capture any replication delay
 	agent.runHealthCheckLocked()
@@ -169,6 +178,18 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 	return err
 }
 
+// SetBackupMaxRate changes the rate limit of the backups and restores
+// of the tablet, including the running ones, and returns the previous one.
+// The rates are in bytes per second, 0 means no limit.
+func (agent *ActionAgent) SetBackupMaxRate(ctx context.Context, maxRate int64) (int64, error) {
+	if maxRate < 0 {
+		return 0, fmt.Errorf("invalid backup max rate %v", maxRate)
+	}
+	previousMaxRate := mysqlctl.BackupMaxRate()
+	mysqlctl.SetBackupMaxRate(maxRate)
+	return previousMaxRate, nil
+}
+
 func (agent *ActionAgent) beginBackup(backupMode string) error {
 	agent.mutex.Lock()
 	defer agent.mutex.Unlock()
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+)
+
+// GetMysqlVariables returns the global variables of mysqld with the given
+// names, or all of them, and the variables persisted in its my.cnf by
+// ApplyMysqlVariables.
+func (agent *ActionAgent) GetMysqlVariables(ctx context.Context, names []string) (map[string]string, map[string]string, error) {
+	variables, err := mysqlctl.GetMysqlVariables(ctx, agent.MysqlDaemon, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	persisted := make(map[string]string)
+	if agent.Cnf == nil {
+		return variables, persisted, nil
+	}
+	overrides, err := mysqlctl.ReadMycnfOverrides(agent.Cnf)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range overrides {
+		if _, ok := variables[name]; ok || len(names) == 0 {
+			persisted[name] = value
+		}
+	}
+	return variables, persisted, nil
+}
+
+// ApplyMysqlVariables changes global variables of mysqld, and persists
+// them in its my.cnf. See mysqlctl.ApplyMysqlVariables.
+func (agent *ActionAgent) ApplyMysqlVariables(ctx context.Context, variables map[string]string, allowRestart bool) (map[string]string, []string, error) {
+	if err := agent.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer agent.unlock()
+
+	return mysqlctl.ApplyMysqlVariables(ctx, agent.MysqlDaemon, agent.Cnf, variables, allowRestart)
+}
@@ -41,6 +41,7 @@ import (
 var (
 	enableSemiSync   = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on master and replica tablets only (rdonly tablets will not ack).")
 	setSuperReadOnly = flag.Bool("use_super_read_only", false, "Set super_read_only flag when performing planned failover.")
+	masterDelay      = flag.Duration("master_delay", 0, "If set, the tablet is a delayed replica: its replication applies the transactions of the master this long after they were committed (MASTER_DELAY, in seconds). Delayed replicas don't serve queries, and are never promoted to master.")
 )
 
 // SlaveStatus returns the replication status
@@ -153,6 +154,11 @@ func (agent *ActionAgent) StartSlave(ctx context.Context) error {
 	if err := agent.fixSemiSync(agent.Tablet().Type); err != nil {
 		return err
 	}
+	// The delay may have been changed to stop the replication
+	// at a given time, see wrangler.StopDelayedReplica.
+	if err := agent.fixMasterDelay(ctx); err != nil {
+		return err
+	}
 	return agent.MysqlDaemon.StartSlave(agent.hookExtraEnv())
 }
 
@@ -192,6 +198,49 @@ func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
 	return agent.MysqlDaemon.ResetReplication(ctx)
 }
 
+// maxEmptyTransactions is the maximum number of empty transactions
+// InjectEmptyTransactions commits in one call.
+const maxEmptyTransactions = 10000
+
+// InjectEmptyTransactions commits an empty transaction for each of the
+// GTIDs of a MySQL 5.6+ GTID set, so they are part of the history of
+// the master. MySQL skips the GTIDs it already has.
+func (agent *ActionAgent) InjectEmptyTransactions(ctx context.Context, gtids string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if tabletType := agent.Tablet().Type; tabletType != topodatapb.TabletType_MASTER {
+		return fmt.Errorf("empty transactions can only be injected on a master, not on a %v tablet", tabletType)
+	}
+	set, err := mysql.ParseMysql56GTIDSet(gtids)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot parse the GTID set %v", gtids)
+	}
+	// The set is only expanded once we know it is small enough.
+	if count := set.Count(); count > maxEmptyTransactions {
+		return fmt.Errorf("cannot inject %v empty transactions, the maximum is %v", count, maxEmptyTransactions)
+	}
+
+	// GTID_NEXT is a session variable: the transactions run on a
+	// connection of their own, which is closed even if one fails.
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for _, gtid := range set.GTIDs() {
+		for _, query := range []string{fmt.Sprintf("SET GTID_NEXT = '%v'", gtid), "BEGIN", "COMMIT"} {
+			if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+				return vterrors.Wrapf(err, "cannot inject the empty transaction %v", gtid)
+			}
+		}
+	}
+	_, err = conn.ExecuteFetch("SET GTID_NEXT = 'AUTOMATIC'", 0, false)
+	return err
+}
+
 // InitMaster enables writes and returns the replication position.
 func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
 	if err := agent.lock(ctx); err != nil {
@@ -283,6 +332,7 @@ func (agent *ActionAgent) InitSlave(ctx context.Context, parent *topodatapb.Tabl
 	if tt == topodatapb.TabletType_MASTER {
 		tt = topodatapb.TabletType_REPLICA
 	}
+	agent.setMasterCell(parent.Cell)
 	if err := agent.fixSemiSync(tt); err != nil {
 		return err
 	}
@@ -628,10 +678,14 @@ func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topo
 
 	// If using semi-sync, we need to enable it before connecting to master.
 	// If we are currently MASTER, assume we are about to become REPLICA.
+	// Whether we acknowledge the writes may depend on the cell of the
+	// new master, and it takes effect when replication is restarted
+	// below.
 	tabletType := agent.Tablet().Type
 	if tabletType == topodatapb.TabletType_MASTER {
 		tabletType = topodatapb.TabletType_REPLICA
 	}
+	agent.setMasterCell(parentAlias.Cell)
 	if err := agent.fixSemiSync(tabletType); err != nil {
 		return err
 	}
@@ -661,11 +715,18 @@ func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topo
 		}
 	}
 
+	if err := agent.fixMasterDelay(ctx); err != nil {
+		return err
+	}
+
 	// If needed, wait until we replicate to the specified point, or our context
 	// times out. Callers can specify the point to wait for as either a
 	// GTID-based replication position or a Vitess reparent journal entry,
-	// or both.
-	if shouldbeReplicating {
+	// or both. Delayed replicas would only get there after their delay,
+	// so they don't wait.
+	if shouldbeReplicating && *masterDelay > 0 {
+		log.Infof("delayed replica, not waiting for position %q or reparent journal entry %v", waitPosition, timeCreatedNS)
+	} else if shouldbeReplicating {
 		if waitPosition != "" {
 			pos, err := mysql.DecodePosition(waitPosition)
 			if err != nil {
@@ -782,6 +843,31 @@ func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
 	return mysql.EncodePosition(pos), nil
 }
 
+// fixMasterDelay sets the MASTER_DELAY of the replication to
+// -master_delay, if it is set. The SQL thread is restarted if
+// it was running.
+func (agent *ActionAgent) fixMasterDelay(ctx context.Context) error {
+	if *masterDelay <= 0 {
+		return nil
+	}
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err == mysql.ErrNotSlave {
+		// Replication is not configured, nothing to do.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	cmds := []string{
+		"STOP SLAVE SQL_THREAD",
+		fmt.Sprintf("CHANGE MASTER TO MASTER_DELAY = %d", int64(masterDelay.Seconds())),
+	}
+	if status.SlaveSQLRunning {
+		cmds = append(cmds, "START SLAVE SQL_THREAD")
+	}
+	return agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds)
+}
+
 func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	switch tabletType {
 	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
@@ -791,15 +877,93 @@ func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
+// refreshDurabilityPolicy reads the durability policy of our keyspace
+// from the topology server. If it can't be read, the previous policy
+// is kept. It also reads the cell of the master of our shard if it is
+// not known yet: once known, it is kept up to date by the reparent
+// RPCs, before the shard record is updated.
+func (agent *ActionAgent) refreshDurabilityPolicy(ctx context.Context) {
+	tablet := agent.Tablet()
+	ki, err := agent.TopoServer.GetKeyspace(ctx, tablet.Keyspace)
+	policy := ""
+	switch {
+	case err == nil:
+		policy = ki.DurabilityPolicy
+	case topo.IsErrType(err, topo.NoNode):
+		// No keyspace record, we use -enable_semi_sync.
+	default:
+		log.Warningf("cannot read the durability policy of keyspace %v, keeping the previous one: %v", tablet.Keyspace, err)
+		return
+	}
+	agent.mutex.Lock()
+	agent._durabilityPolicy = policy
+	masterCell := agent._masterCell
+	agent.mutex.Unlock()
+
+	if masterCell != "" {
+		return
+	}
+	si, err := agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			log.Warningf("cannot read the master of shard %v/%v: %v", tablet.Keyspace, tablet.Shard, err)
+		}
+		return
+	}
+	if si.MasterAlias != nil {
+		agent.setMasterCell(si.MasterAlias.Cell)
+	}
+}
+
+// setMasterCell records the cell of the master of our shard.
+func (agent *ActionAgent) setMasterCell(cell string) {
+	agent.mutex.Lock()
+	agent._masterCell = cell
+	agent.mutex.Unlock()
+}
+
+// isSemiSyncAcker returns true if we acknowledge the semi-sync writes
+// of the master as a tablet of the given type under the policy.
+func (agent *ActionAgent) isSemiSyncAcker(policy string, tabletType topodatapb.TabletType) bool {
+	agent.mutex.Lock()
+	masterCell := agent._masterCell
+	agent.mutex.Unlock()
+	return topotools.IsSemiSyncAcker(policy, tabletType, agent.TabletAlias.Cell, masterCell)
+}
+
+// semiSyncPolicy returns the durability policy that drives semi-sync
+// on this tablet: the policy of its keyspace if it has one, or else
+// the policy set by -enable_semi_sync. It returns "" if semi-sync is
+// not handled by the tablet.
+func (agent *ActionAgent) semiSyncPolicy() string {
+	agent.mutex.Lock()
+	policy := agent._durabilityPolicy
+	agent.mutex.Unlock()
+	if policy != "" {
+		return policy
+	}
+	if *enableSemiSync {
+		return topotools.DurabilitySemiSync
+	}
+	return ""
+}
+
 func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
+	if tabletType == topodatapb.TabletType_MASTER {
+		// We are, or are about to be, the master.
+		agent.setMasterCell(agent.TabletAlias.Cell)
+	}
+
+	policy := agent.semiSyncPolicy()
+	if policy == "" {
 		// Semi-sync handling is not enabled.
 		return nil
 	}
 
-	// Only enable if we're eligible for becoming master (REPLICA type).
-	// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
-	if !isMasterEligible(tabletType) {
+	// Only enable if the policy needs it and we're eligible for becoming
+	// master (REPLICA type). Ineligible slaves (RDONLY) shouldn't ACK
+	// because we'll never promote them.
+	if !agent.isSemiSyncAcker(policy, tabletType) {
 		return agent.MysqlDaemon.SetSemiSyncEnabled(false, false)
 	}
 
@@ -809,7 +973,8 @@ func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
 }
 
 func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
+	policy := agent.semiSyncPolicy()
+	if policy == "" {
 		// Semi-sync handling is not enabled.
 		return nil
 	}
@@ -838,7 +1003,7 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 		return nil
 	}
 
-	shouldAck := isMasterEligible(tabletType)
+	shouldAck := agent.isSemiSyncAcker(policy, tabletType)
 	acking, err := agent.MysqlDaemon.SemiSyncSlaveStatus()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to get SemiSyncSlaveStatus")
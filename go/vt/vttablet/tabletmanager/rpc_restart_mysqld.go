@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// RestartMysqld stops and starts mysqld, running the upgrade hook, if
+// any, while it is stopped. Its replication and semi-sync state are
+// restored after the restart. It refuses to restart a master.
+func (agent *ActionAgent) RestartMysqld(ctx context.Context, upgradeHook string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if agent.Tablet().Type == topodatapb.TabletType_MASTER {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "tablet %v is a master, it must be reparented before mysqld is restarted", agent.TabletAlias)
+	}
+	if agent.Cnf == nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "mysqld is not managed by this tablet, it cannot be restarted")
+	}
+
+	// Save the state to restore after the restart.
+	slaveStartRequired := false
+	slaveStatus, err := agent.MysqlDaemon.SlaveStatus()
+	switch err {
+	case nil:
+		slaveStartRequired = slaveStatus.SlaveRunning()
+	case mysql.ErrNotSlave:
+	default:
+		return vterrors.Wrap(err, "can't get the replication status")
+	}
+	semiSyncMaster, semiSyncSlave := agent.MysqlDaemon.SemiSyncEnabled()
+
+	log.Infof("Restarting mysqld, upgrade hook: %q", upgradeHook)
+	if err := agent.MysqlDaemon.Shutdown(ctx, agent.Cnf, true); err != nil {
+		return vterrors.Wrap(err, "can't shutdown mysqld")
+	}
+
+	// mysqld is started even if the hook failed, to not leave the
+	// tablet without it.
+	var hookErr error
+	if upgradeHook != "" {
+		h := hook.NewSimpleHook(upgradeHook)
+		h.ExtraEnv = agent.hookExtraEnv()
+		if hr := h.Execute(); hr.ExitStatus != hook.HOOK_SUCCESS {
+			hookErr = vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "upgrade hook %v failed: %v", upgradeHook, hr.String())
+		}
+	}
+
+	// Use a background context in case ctx expired during the hook.
+	if err := agent.MysqlDaemon.Start(context.Background(), agent.Cnf); err != nil {
+		return vterrors.Wrap(err, "can't restart mysqld")
+	}
+	if err := agent.MysqlDaemon.SetReadOnly(true); err != nil {
+		return err
+	}
+	if semiSyncMaster || semiSyncSlave {
+		// Only do this if one of them was on, since both being off could mean
+		// the plugin isn't even loaded, and the server variables don't exist.
+		if err := agent.MysqlDaemon.SetSemiSyncEnabled(semiSyncMaster, semiSyncSlave); err != nil {
+			return err
+		}
+	}
+	if slaveStartRequired {
+		if err := agent.MysqlDaemon.StartSlave(agent.hookExtraEnv()); err != nil {
+			return vterrors.Wrap(err, "can't restart replication")
+		}
+	}
+	return hookErr
+}
@@ -158,6 +158,8 @@ func (agent *ActionAgent) refreshTablet(ctx context.Context, reason string) erro
 	if tablet.MasterTermStartTime != nil {
 		agent.setMasterTermStartTime(logutil.ProtoToTime(tablet.MasterTermStartTime))
 	}
+	// And the durability policy of the keyspace.
+	agent.refreshDurabilityPolicy(ctx)
 	agent.updateState(ctx, tablet, reason)
 	log.Infof("Done with post-action state refresh")
 	return nil
@@ -234,6 +236,10 @@ func (agent *ActionAgent) changeCallback(ctx context.Context, oldTablet, newTabl
 						disallowQueryReason = "master tablet with filtered replication on"
 						disallowQueryService = disallowQueryReason
 					}
+				} else if *masterDelay > 0 {
+					allowQuery = false
+					disallowQueryReason = "delayed replica"
+					disallowQueryService = disallowQueryReason
 				} else {
 					replicationDelay, healthErr := agent.HealthReporter.Report(true, true)
 					if healthErr != nil {
@@ -59,6 +59,10 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	pool    *Pool
 	current sync2.AtomicString
+
+	// sessionTrackGTIDs is set while session_track_gtids
+	// is enabled on the underlying connection.
+	sessionTrackGTIDs bool
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -230,6 +234,25 @@ var (
 	showBinlog    = "show variables like 'binlog_format'"
 )
 
+// SetSessionTrackGTIDs makes MySQL report, or stop reporting, the
+// GTID of every transaction committed on this connection. Pooled
+// connections are shared by all sessions, so callers set it for
+// every request. It only runs a query if the setting changes.
+func (dbc *DBConn) SetSessionTrackGTIDs(ctx context.Context, enabled bool) error {
+	if dbc.sessionTrackGTIDs == enabled {
+		return nil
+	}
+	value := "OFF"
+	if enabled {
+		value = "OWN_GTID"
+	}
+	if _, err := dbc.Exec(ctx, "set @@session.session_track_gtids = '"+value+"'", 1, false); err != nil {
+		return err
+	}
+	dbc.sessionTrackGTIDs = enabled
+	return nil
+}
+
 // VerifyMode is a helper method to verify mysql is running with
 // sql_mode = STRICT_TRANS_TABLES or STRICT_ALL_TABLES and autocommit=ON.
 // It also returns the current binlog format.
@@ -348,6 +371,7 @@ func (dbc *DBConn) reconnect() error {
 		return err
 	}
 	dbc.conn = newConn
+	dbc.sessionTrackGTIDs = false
 	return nil
 }
 
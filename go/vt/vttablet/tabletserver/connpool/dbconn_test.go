@@ -119,6 +119,40 @@ func TestDBConnExec(t *testing.T) {
 	compareTimingCounts(t, "Exec", 1, startCounts, tabletenv.MySQLStats.Counts())
 }
 
+func TestDBConnSetSessionTrackGTIDs(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	enable := "set @@session.session_track_gtids = 'OWN_GTID'"
+	disable := "set @@session.session_track_gtids = 'OFF'"
+	db.AddQuery(enable, &sqltypes.Result{})
+	db.AddQuery(disable, &sqltypes.Result{})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	ctx := context.Background()
+	dbConn, err := NewDBConn(connPool, db.ConnParams())
+	if dbConn != nil {
+		defer dbConn.Close()
+	}
+	if err != nil {
+		t.Fatalf("should not get an error, err: %v", err)
+	}
+
+	// Tracking is off on a new connection, and is only changed
+	// when a request asks for a different setting.
+	for _, enabled := range []bool{false, true, true, false, false} {
+		if err := dbConn.SetSessionTrackGTIDs(ctx, enabled); err != nil {
+			t.Fatalf("SetSessionTrackGTIDs(%v) failed: %v", enabled, err)
+		}
+	}
+	if got := db.GetQueryCalledNum(enable); got != 1 {
+		t.Errorf("%v called %d times, want 1", enable, got)
+	}
+	if got := db.GetQueryCalledNum(disable); got != 1 {
+		t.Errorf("%v called %d times, want 1", disable, got)
+	}
+}
+
 func TestDBConnDeadline(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
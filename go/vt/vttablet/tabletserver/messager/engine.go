@@ -43,6 +43,7 @@ type TabletService interface {
 	CheckMySQL()
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 }
 
 // Engine is the engine for handling messages.
@@ -208,7 +209,7 @@ func (me *Engine) UpdateCaches(newMessages map[string][]*MessageRow, changedMess
 		if mm == nil {
 			continue
 		}
-		mm.cache.Discard(ids)
+		mm.MessagesChanged(ids)
 	}
 }
 
@@ -260,6 +261,22 @@ func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map
 	return query, bv, nil
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages to the dead letter table.
+func (me *Engine) GenerateDeadLetterQueries(name string, ids []string) ([]string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	queries, bv := mm.GenerateDeadLetterQueries(ids)
+	if queries == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s has no dead letter table", name)
+	}
+	return queries, bv, nil
+}
+
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
@@ -162,6 +162,22 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Ordered delivery
+// If the table specifies a group column, the poller only loads the oldest
+// unacked message of every group. A message is therefore sent only after
+// all the previous ones of its group were acked, and every group has at
+// most one message in flight. Since a batch contains messages of different
+// groups, the groups are spread across the clients, which can process
+// them in parallel. New messages are not added to the cache directly:
+// they are loaded by the poller once they become the oldest of their group.
+// Acks wake up the poller so that the next message of the group is sent
+// without waiting for the poll interval.
+//
+// Dead letters
+// If the table specifies a max epoch, the poller does not resend
+// messages that have already been sent that many times. Instead,
+// they're moved to the dead letter table in a single transaction.
 type messageManager struct {
 	DBLock sync.Mutex
 	tsv    TabletService
@@ -171,6 +187,8 @@ type messageManager struct {
 	ackWaitTime  time.Duration
 	purgeAfter   time.Duration
 	batchSize    int
+	maxEpoch     int64
+	ordered      bool
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
 	conns        *connpool.Pool
@@ -198,6 +216,9 @@ type messageManager struct {
 	ackQuery          *sqlparser.ParsedQuery
 	postponeQuery     *sqlparser.ParsedQuery
 	purgeQuery        *sqlparser.ParsedQuery
+
+	// deadLetterQueries are set only if the table has a max epoch.
+	deadLetterQueries []*sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -213,6 +234,8 @@ func newMessageManager(tsv TabletService, table *schema.Table, conns *connpool.P
 		ackWaitTime:  table.MessageInfo.AckWaitDuration,
 		purgeAfter:   table.MessageInfo.PurgeAfterDuration,
 		batchSize:    table.MessageInfo.BatchSize,
+		maxEpoch:     int64(table.MessageInfo.MaxEpoch),
+		ordered:      !table.MessageInfo.GroupColumn.IsEmpty(),
 		cache:        newCache(table.MessageInfo.CacheSize),
 		pollerTicks:  timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:   timer.NewTimer(table.MessageInfo.PollInterval),
@@ -222,9 +245,20 @@ func newMessageManager(tsv TabletService, table *schema.Table, conns *connpool.P
 	mm.cond.L = &mm.mu
 
 	columnList := buildSelectColumnList(table)
-	mm.readByTimeNext = sqlparser.BuildParsedQuery(
-		"select time_next, epoch, time_created, %s from %v where time_next < %a order by time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+	if mm.ordered {
+		// Only load the messages that have no older unacked message in their group.
+		groupColumn := table.MessageInfo.GroupColumn
+		mm.readByTimeNext = sqlparser.BuildParsedQuery(
+			"select time_next, epoch, time_created, %s from %v as m where time_next < %a and not exists ("+
+				"select 1 from %v as p where p.%v = m.%v and p.time_acked is null and "+
+				"(p.time_created < m.time_created or p.time_created = m.time_created and p.id < m.id)"+
+				") order by time_next desc limit %a",
+			columnList, mm.name, ":time_next", mm.name, groupColumn, groupColumn, ":max")
+	} else {
+		mm.readByTimeNext = sqlparser.BuildParsedQuery(
+			"select time_next, epoch, time_created, %s from %v where time_next < %a order by time_next desc limit %a",
+			columnList, mm.name, ":time_next", ":max")
+	}
 	mm.loadMessagesQuery = sqlparser.BuildParsedQuery(
 		"select time_next, epoch, time_created, %s from %v where %a",
 		columnList, mm.name, ":#pk")
@@ -237,6 +271,19 @@ func newMessageManager(tsv TabletService, table *schema.Table, conns *connpool.P
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_scheduled < %a and time_acked is not null limit 500",
 		mm.name, ":time_scheduled")
+	if mm.maxEpoch > 0 {
+		// The messages are copied to the dead letter table and then
+		// deleted. Both statements are executed in the same transaction.
+		allColumns := buildAllColumnList(table)
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{
+			sqlparser.BuildParsedQuery(
+				"insert into %v(%s) select %s from %v where id in %a and epoch >= %a and time_acked is null for update",
+				sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable), allColumns, allColumns, mm.name, "::ids", ":max_epoch"),
+			sqlparser.BuildParsedQuery(
+				"delete from %v where id in %a and epoch >= %a and time_acked is null",
+				mm.name, "::ids", ":max_epoch"),
+		}
+	}
 	return mm
 }
 
@@ -255,6 +302,20 @@ func buildSelectColumnList(t *schema.Table) string {
 	return buf.String()
 }
 
+// buildAllColumnList builds a column list that contains
+// all the columns of the table, including the reserved ones.
+func buildAllColumnList(t *schema.Table) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, c := range t.Columns {
+		if i == 0 {
+			buf.Myprintf("%v", c.Name)
+		} else {
+			buf.Myprintf(", %v", c.Name)
+		}
+	}
+	return buf.String()
+}
+
 // Open starts the messageManager service.
 func (mm *messageManager) Open() {
 	mm.mu.Lock()
@@ -375,6 +436,12 @@ func (mm *messageManager) Add(mr *MessageRow) bool {
 	if len(mm.receivers) == 0 {
 		return false
 	}
+	if mm.ordered {
+		// The message may not be the oldest of its group.
+		// Let the poller load it when it's its turn.
+		mm.setPendingLocked()
+		return false
+	}
 	if !mm.cache.Add(mr) {
 		// Cache is full. Enter "messagesPending" mode to let the poller
 		// fill the cache with messages from disk as soon as a cache
@@ -388,6 +455,27 @@ func (mm *messageManager) Add(mr *MessageRow) bool {
 	return true
 }
 
+// MessagesChanged must be called when messages of the table are updated.
+// For ordered tables, it wakes up the poller because an ack can make the
+// next message of a group eligible.
+func (mm *messageManager) MessagesChanged(ids []string) {
+	mm.cache.Discard(ids)
+	if !mm.ordered {
+		return
+	}
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	mm.setPendingLocked()
+}
+
+// setPendingLocked marks that there are messages to be loaded
+// by the poller, and wakes up the send loop, which will trigger
+// the poller once the cache is empty. mm.mu must be held.
+func (mm *messageManager) setPendingLocked() {
+	mm.messagesPending = true
+	mm.cond.Broadcast()
+}
+
 func (mm *messageManager) runSend() {
 	defer func() {
 		tabletenv.LogError()
@@ -590,6 +678,14 @@ func (mm *messageManager) runPoller() {
 			// Wake up the sender.
 			defer mm.cond.Broadcast()
 		}
+		var deadIDs []string
+		defer func() {
+			if len(deadIDs) == 0 {
+				return
+			}
+			mm.wg.Add(1)
+			go mm.deadLetter(deadIDs)
+		}()
 		for _, row := range qr.Rows {
 			mr, err := BuildMessageRow(row)
 			if err != nil {
@@ -597,6 +693,10 @@ func (mm *messageManager) runPoller() {
 				log.Errorf("Error reading message row: %v", err)
 				continue
 			}
+			if mm.maxEpoch > 0 && mr.Epoch >= mm.maxEpoch {
+				deadIDs = append(deadIDs, mr.Row[0].ToString())
+				continue
+			}
 			if !mm.cache.Add(mr) {
 				mm.messagesPending = true
 				return
@@ -605,6 +705,31 @@ func (mm *messageManager) runPoller() {
 	}()
 }
 
+// deadLetter moves the messages that exceeded the max epoch
+// to the dead letter table.
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		tabletenv.LogError()
+		mm.wg.Done()
+	}()
+	// Dead letters share the postpone semaphore because
+	// they use the same kind of resources.
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
+	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.name.String(), "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to move messages to the dead letter table: %v", err)
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "DeadLettered"}, count)
+}
+
 func (mm *messageManager) runPurge() {
 	go purge(mm.tsv, mm.name.String(), mm.purgeAfter, mm.purgeTicks.Interval())
 }
@@ -676,6 +801,33 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages to the dead letter table. The queries must be executed in
+// the same transaction. It returns nil if the table has no max epoch.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) ([]string, map[string]*querypb.BindVariable) {
+	if mm.deadLetterQueries == nil {
+		return nil, nil
+	}
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARCHAR,
+			Value: []byte(id),
+		})
+	}
+	queries := make([]string, 0, len(mm.deadLetterQueries))
+	for _, pq := range mm.deadLetterQueries {
+		queries = append(queries, pq.Query)
+	}
+	return queries, map[string]*querypb.BindVariable{
+		"max_epoch": sqltypes.Int64BindVariable(mm.maxEpoch),
+		"ids":       idbvs,
+	}
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	timeNext, err := sqltypes.ToInt64(row[0])
@@ -184,6 +184,48 @@ func TestMessageManagerAdd(t *testing.T) {
 	}
 }
 
+func TestMessageManagerOrdered(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ti := newMMTable()
+	ti.MessageInfo.GroupColumn = sqlparser.NewColIdent("message")
+	mm := newMessageManager(newFakeTabletServer(), ti, newMMConnPool(db), sync2.NewSemaphore(1, 0))
+	wantQuery := "select time_next, epoch, time_created, id, time_scheduled, message from foo as m where time_next < :time_next and not exists (" +
+		"select 1 from foo as p where p.message = m.message and p.time_acked is null and " +
+		"(p.time_created < m.time_created or p.time_created = m.time_created and p.id < m.id)" +
+		") order by time_next desc limit :max"
+	if got := mm.readByTimeNext.Query; got != wantQuery {
+		t.Errorf("readByTimeNext:\n%s, want\n%s", got, wantQuery)
+	}
+
+	mm.Open()
+	defer mm.Close()
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	// New messages are left to the poller.
+	if mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}}) {
+		t.Error("Add(ordered): true, want false")
+	}
+	mm.mu.Lock()
+	pending := mm.messagesPending
+	mm.messagesPending = false
+	mm.mu.Unlock()
+	if !pending {
+		t.Error("messagesPending after Add: false, want true")
+	}
+
+	// Acks make the next message of the group eligible.
+	mm.MessagesChanged([]string{"1"})
+	mm.mu.Lock()
+	pending = mm.messagesPending
+	mm.mu.Unlock()
+	if !pending {
+		t.Error("messagesPending after MessagesChanged: false, want true")
+	}
+}
+
 func TestMessageManagerSend(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -562,6 +604,69 @@ func TestMessageManagerPoller(t *testing.T) {
 	}
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQueryPattern(
+		"select time_next, epoch, time_created, id, time_scheduled, message from foo.*",
+		&sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.VarBinary},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(2),
+				sqltypes.NewInt64(0),
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(10),
+				sqltypes.NewVarBinary("01"),
+			}, {
+				sqltypes.NewInt64(2),
+				sqltypes.NewInt64(3),
+				sqltypes.NewInt64(1),
+				sqltypes.NewInt64(2),
+				sqltypes.NewInt64(20),
+				sqltypes.NewVarBinary("02"),
+			}},
+		},
+	)
+	tsv := newFakeTabletServer()
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	ti.MessageInfo.MaxEpoch = 3
+	ti.MessageInfo.DeadLetterTable = "foo_dlq"
+	mm := newMessageManager(tsv, ti, newMMConnPool(db), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+	mm.pollerTicks.Trigger()
+
+	// The message that reached the max epoch is moved to the
+	// dead letter table, and the other one is sent and postponed.
+	got := map[string]bool{<-ch: true, <-ch: true}
+	if want := map[string]bool{"deadletter": true, "postpone": true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tsv calls: %v, want %v", got, want)
+	}
+	qr := <-r1.ch
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewVarBinary("01"),
+	}}
+	if !reflect.DeepEqual(qr.Rows, want) {
+		t.Errorf("rows:\n%+v, want\n%+v", qr.Rows, want)
+	}
+}
+
 // TestMessagesPending1 tests for the case where you can't
 // add items because the cache is full.
 func TestMessagesPending1(t *testing.T) {
@@ -740,6 +845,11 @@ func TestMMGenerate(t *testing.T) {
 		t.Errorf("gotid: %v, want %v", bv, wantbv)
 	}
 
+	queries, _ := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	if queries != nil {
+		t.Errorf("GenerateDeadLetterQueries: %v, want nil", queries)
+	}
+
 	query, bv = mm.GeneratePurgeQuery(3)
 	wantQuery = "delete from foo where time_scheduled < :time_scheduled and time_acked is not null limit 500"
 	if query != wantQuery {
@@ -753,9 +863,39 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGenerateDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ti := newMMTable()
+	for _, name := range []string{"id", "time_scheduled", "time_next", "epoch", "time_created", "time_acked", "message"} {
+		ti.Columns = append(ti.Columns, schema.TableColumn{Name: sqlparser.NewColIdent(name)})
+	}
+	ti.MessageInfo.MaxEpoch = 5
+	ti.MessageInfo.DeadLetterTable = "foo_dlq"
+	mm := newMessageManager(newFakeTabletServer(), ti, newMMConnPool(db), sync2.NewSemaphore(1, 0))
+	queries, bv := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	wantQueries := []string{
+		"insert into foo_dlq(id, time_scheduled, time_next, epoch, time_created, time_acked, message) " +
+			"select id, time_scheduled, time_next, epoch, time_created, time_acked, message from foo " +
+			"where id in ::ids and epoch >= :max_epoch and time_acked is null for update",
+		"delete from foo where id in ::ids and epoch >= :max_epoch and time_acked is null",
+	}
+	if !reflect.DeepEqual(queries, wantQueries) {
+		t.Errorf("GenerateDeadLetterQueries queries:\n%v, want\n%v", queries, wantQueries)
+	}
+	wantbv := map[string]*querypb.BindVariable{
+		"max_epoch": sqltypes.Int64BindVariable(5),
+		"ids":       sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	if !reflect.DeepEqual(bv, wantbv) {
+		t.Errorf("GenerateDeadLetterQueries bv: %v, want %v", bv, wantbv)
+	}
+}
+
 type fakeTabletServer struct {
-	postponeCount sync2.AtomicInt64
-	purgeCount    sync2.AtomicInt64
+	postponeCount   sync2.AtomicInt64
+	purgeCount      sync2.AtomicInt64
+	deadLetterCount sync2.AtomicInt64
 
 	mu sync.Mutex
 	ch chan string
@@ -793,6 +933,17 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	fts.deadLetterCount.Add(1)
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
 func newMMConnPool(db *fakesqldb.DB) *connpool.Pool {
 	pool := connpool.New("", 20, 0, time.Duration(10*time.Minute), newFakeTabletServer())
 	params, _ := db.ConnParams().MysqlParams()
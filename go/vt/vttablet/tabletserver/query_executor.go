@@ -325,6 +325,9 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltype
 	if err != nil {
 		return nil, err
 	}
+	if conn.SessionStateChanges != "" {
+		reply.SessionStateChanges = conn.SessionStateChanges
+	}
 	return reply, nil
 }
 
@@ -56,8 +56,11 @@ type Engine struct {
 	isOpen     bool
 	tables     map[string]*Table
 	lastChange int64
-	reloadTime time.Duration
-	notifiers  map[string]notifier
+	// reloadTimes contains the MySQL time at which tables were
+	// individually reloaded by ReloadTables since lastChange.
+	reloadTimes map[string]int64
+	reloadTime  time.Duration
+	notifiers   map[string]notifier
 
 	// The following fields have their own synchronization
 	// and do not require locking mu.
@@ -65,7 +68,13 @@ type Engine struct {
 	ticks *timer.Timer
 }
 
-var schemaOnce sync.Once
+var (
+	schemaOnce sync.Once
+
+	// changeLag tracks how long it took to notice a schema change,
+	// by type of reload.
+	changeLag = stats.NewTimings("SchemaChangeLag", "Time between a table being created or altered in MySQL and vttablet reloading its schema", "Reload")
+)
 
 // NewEngine creates a new Engine.
 func NewEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *Engine {
@@ -180,6 +189,7 @@ func (se *Engine) Open() error {
 	}
 	se.tables = tables
 	se.lastChange = curTime
+	se.reloadTimes = make(map[string]int64)
 
 	// register message topics on the engine if necessary
 	// must run after se.tables is set
@@ -274,11 +284,15 @@ func (se *Engine) Reload(ctx context.Context) error {
 		tableName := row[0].ToString()
 		curTables[tableName] = true
 		createTime, _ := sqltypes.ToInt64(row[2])
-		// Check if we know about the table or it has been recreated.
-		if _, ok := se.tables[tableName]; !ok || createTime >= se.lastChange {
+		// Check if we know about the table or it has been recreated
+		// since it was last loaded.
+		if _, ok := se.tables[tableName]; !ok || (createTime >= se.lastChange && createTime >= se.reloadTimes[tableName]) {
 			log.Infof("Reloading schema for table: %s", tableName)
 			wasCreated, err := se.tableWasCreatedOrAltered(ctx, tableName)
 			rec.RecordError(err)
+			if createTime != 0 {
+				changeLag.Add("Reload", time.Duration(curTime-createTime)*time.Second)
+			}
 			if wasCreated {
 				created = append(created, tableName)
 			} else {
@@ -290,6 +304,7 @@ func (se *Engine) Reload(ctx context.Context) error {
 		}
 	}
 	se.lastChange = curTime
+	se.reloadTimes = make(map[string]int64)
 
 	// Handle table drops
 	var dropped []string
@@ -313,6 +328,93 @@ func (se *Engine) Reload(ctx context.Context) error {
 	return rec.Error()
 }
 
+// ReloadTables reloads the schema of the specified tables only.
+// It is used when the tables affected by a DDL are known, and is
+// much cheaper than a full Reload. The tables that don't exist
+// anymore are dropped.
+// This is a no-op if the Engine is closed.
+func (se *Engine) ReloadTables(ctx context.Context, tableNames []string) error {
+	if len(tableNames) == 0 {
+		return nil
+	}
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	if !se.isOpen {
+		return nil
+	}
+	defer tabletenv.LogError()
+
+	// The connection must be returned before the tables are loaded:
+	// tableWasCreatedOrAltered gets its own from the pool.
+	rec := concurrency.AllErrorRecorder{}
+	curTime, tablesData, err := func() (int64, map[string]*sqltypes.Result, error) {
+		conn, err := se.conns.Get(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer conn.Recycle()
+		curTime, err := se.mysqlTime(ctx, conn)
+		if err != nil {
+			return 0, nil, err
+		}
+		tablesData := make(map[string]*sqltypes.Result)
+		for _, tableName := range tableNames {
+			if tableName == "dual" {
+				continue
+			}
+			tableData, err := conn.Exec(ctx, mysql.BaseShowTablesForTable(tableName), 1, false)
+			if err != nil {
+				rec.RecordError(vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "information_schema query failed for table %s: %v", tableName, err))
+				continue
+			}
+			tablesData[tableName] = tableData
+		}
+		return curTime, tablesData, nil
+	}()
+	if err != nil {
+		return vterrors.Wrap(err, "could not get table data for reload")
+	}
+
+	var created, altered, dropped []string
+	for _, tableName := range tableNames {
+		tableData, ok := tablesData[tableName]
+		if !ok {
+			continue
+		}
+		if len(tableData.Rows) == 0 {
+			if table, ok := se.tables[tableName]; ok && !table.IsTopic() {
+				log.Infof("Dropping table: %s", tableName)
+				dropped = append(dropped, tableName)
+				delete(se.tables, tableName)
+			}
+			continue
+		}
+
+		log.Infof("Reloading schema for table: %s", tableName)
+		wasCreated, err := se.tableWasCreatedOrAltered(ctx, tableName)
+		if err != nil {
+			rec.RecordError(err)
+			continue
+		}
+		se.reloadTimes[tableName] = curTime
+		if createTime, _ := sqltypes.ToInt64(tableData.Rows[0][2]); createTime != 0 {
+			changeLag.Add("ReloadTables", time.Duration(curTime-createTime)*time.Second)
+		}
+		if wasCreated {
+			created = append(created, tableName)
+		} else {
+			altered = append(altered, tableName)
+		}
+	}
+
+	// register message topics on the engine if necessary
+	// must run after se.tables is set
+	se.registerTopics()
+
+	se.broadcast(created, altered, dropped)
+	return rec.Error()
+}
+
 // LoadTableBasic loads a table with minimal info. This is used by vstreamer
 // to load _vt.resharding_journal.
 func (se *Engine) LoadTableBasic(ctx context.Context, tableName string) (*Table, error) {
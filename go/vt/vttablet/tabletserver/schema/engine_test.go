@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema/schematest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -228,6 +231,136 @@ func TestReload(t *testing.T) {
 	}
 }
 
+func TestReloadTables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ctx := context.Background()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	se.Open()
+	defer se.Close()
+
+	var created, altered, dropped []string
+	se.RegisterNotifier("test", func(_ map[string]*Table, c, a, d []string) {
+		created, altered, dropped = c, a, d
+	})
+
+	db.AddQuery("select unix_timestamp()", &sqltypes.Result{
+		Fields:       []*querypb.Field{{Type: sqltypes.Uint64}},
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt32(1427325876)}},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_01"), &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+		},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_02"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_04"), &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_04", false, ""),
+		},
+	})
+	db.AddQuery("select * from test_table_04 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}},
+	})
+	db.AddQuery("describe test_table_04", &sqltypes.Result{
+		Fields:       mysql.DescribeTableFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.DescribeTableRow("pk", "int(11)", false, "PRI", "0"),
+		},
+	})
+	db.AddQuery("show index from test_table_04", &sqltypes.Result{
+		Fields:       mysql.ShowIndexFromTableFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowIndexFromTableRow("test_table_04", true, "PRIMARY", 1, "pk", false),
+		},
+	})
+
+	if err := se.ReloadTables(ctx, []string{"test_table_01", "test_table_02", "test_table_04"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"test_table_04"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created: %v, want %v", created, want)
+	}
+	if want := []string{"test_table_01"}; !reflect.DeepEqual(altered, want) {
+		t.Errorf("altered: %v, want %v", altered, want)
+	}
+	if want := []string{"test_table_02"}; !reflect.DeepEqual(dropped, want) {
+		t.Errorf("dropped: %v, want %v", dropped, want)
+	}
+	if se.GetTable(sqlparser.NewTableIdent("test_table_04")) == nil {
+		t.Errorf("test_table_04 should exist")
+	}
+	if se.GetTable(sqlparser.NewTableIdent("test_table_02")) != nil {
+		t.Errorf("test_table_02 should not exist")
+	}
+
+	// Only one pooled connection is used at a time.
+	var conns []*connpool.DBConn
+	for i := int64(1); i < se.conns.Capacity(); i++ {
+		conn, err := se.conns.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn)
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := se.ReloadTables(shortCtx, []string{"test_table_01"}); err != nil {
+		t.Errorf("ReloadTables with one free connection: %v", err)
+	}
+	for _, conn := range conns {
+		conn.Recycle()
+	}
+
+	// A full reload must not reload test_table_01 again.
+	for _, tableName := range []string{"test_table_02", "test_table_03"} {
+		db.AddQuery(mysql.BaseShowTablesForTable(tableName), &sqltypes.Result{
+			Fields:       mysql.BaseShowTablesFields,
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{
+				mysql.BaseShowTablesRow(tableName, false, ""),
+			},
+		})
+	}
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 3,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+			mysql.BaseShowTablesRow("test_table_02", false, ""),
+			mysql.BaseShowTablesRow("test_table_03", false, ""),
+		},
+	})
+	if err := se.Reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"test_table_02"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created: %v, want %v", created, want)
+	}
+	if want := []string{"test_table_03"}; !reflect.DeepEqual(altered, want) {
+		t.Errorf("altered: %v, want %v", altered, want)
+	}
+	sort.Strings(dropped)
+	if want := []string{"msg", "test_table_04"}; !reflect.DeepEqual(dropped, want) {
+		t.Errorf("dropped: %v, want %v", dropped, want)
+	}
+}
+
 func TestCreateOrUpdateTableFailedDuetoExecErr(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -183,6 +183,26 @@ func loadMessageInfo(ta *Table, comment string) error {
 	if ta.MessageInfo.PollInterval, err = getDuration(keyvals, "vt_poller_interval"); err != nil {
 		return err
 	}
+	if keyvals["vt_max_epoch"] != "" {
+		if ta.MessageInfo.MaxEpoch, err = getNum(keyvals, "vt_max_epoch"); err != nil {
+			return err
+		}
+		if ta.MessageInfo.MaxEpoch <= 0 {
+			return fmt.Errorf("vt_max_epoch must be positive for message table: %s", ta.Name.String())
+		}
+		if ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]; ta.MessageInfo.DeadLetterTable == "" {
+			return fmt.Errorf("vt_max_epoch requires vt_dead_letter_table for message table: %s", ta.Name.String())
+		}
+	}
+	if groupColumn := keyvals["vt_group_column"]; groupColumn != "" {
+		ta.MessageInfo.GroupColumn = sqlparser.NewColIdent(groupColumn)
+		if _, ok := findCols[ta.MessageInfo.GroupColumn.Lowered()]; ok {
+			return fmt.Errorf("vt_group_column cannot be %s for message table: %s", groupColumn, ta.Name.String())
+		}
+		if ta.FindColumn(ta.MessageInfo.GroupColumn) == -1 {
+			return fmt.Errorf("vt_group_column %s missing from message table: %s", groupColumn, ta.Name.String())
+		}
+	}
 	for _, col := range orderedColumns {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
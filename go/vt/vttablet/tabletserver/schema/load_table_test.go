@@ -277,6 +277,77 @@ func TestLoadTableMessageTopic(t *testing.T) {
 	}
 }
 
+func TestLoadTableMessageDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=5,vt_dead_letter_table=test_table_dlq", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.MessageInfo.MaxEpoch, 5; got != want {
+		t.Errorf("MaxEpoch: %d, want %d", got, want)
+	}
+	if got, want := table.MessageInfo.DeadLetterTable, "test_table_dlq"; got != want {
+		t.Errorf("DeadLetterTable: %s, want %s", got, want)
+	}
+
+	// vt_max_epoch requires a dead letter table.
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=5", db)
+	wanterr := "vt_max_epoch requires vt_dead_letter_table for message table: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+
+	// vt_max_epoch must be positive.
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=0,vt_dead_letter_table=test_table_dlq", db)
+	wanterr = "vt_max_epoch must be positive for message table: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+}
+
+func TestLoadTableMessageGroupColumn(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_group_column=message", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.MessageInfo.GroupColumn, sqlparser.NewColIdent("message"); !got.Equal(want) {
+		t.Errorf("GroupColumn: %v, want %v", got, want)
+	}
+
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_group_column=user_id", db)
+	wanterr := "vt_group_column user_id missing from message table: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_group_column=id", db)
+	wanterr = "vt_group_column cannot be id for message table: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+}
+
 func TestLoadTableWithBitColumn(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
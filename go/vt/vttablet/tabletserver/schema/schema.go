@@ -134,6 +134,22 @@ type MessageInfo struct {
 	// PollInterval specifies the polling frequency to
 	// look for messages to be sent.
 	PollInterval time.Duration
+
+	// MaxEpoch is the optional number of delivery attempts
+	// after which a message is moved to DeadLetterTable.
+	// Zero means that messages are retried forever.
+	MaxEpoch int
+
+	// DeadLetterTable is the table where messages that
+	// exceeded MaxEpoch are moved to. It must have the
+	// same columns as the message table.
+	DeadLetterTable string
+
+	// GroupColumn is the optional column that contains the
+	// group key of a message. If set, the messages of a group
+	// are sent one at a time, in creation order. A message is
+	// sent only after the previous one of its group is acked.
+	GroupColumn sqlparser.ColIdent
 }
 
 // NewTable creates a new Table.
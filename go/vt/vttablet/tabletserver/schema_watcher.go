@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// VStreamer defines the functions of the vstreamer Engine
+// that the SchemaWatcher uses.
+type VStreamer interface {
+	Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
+}
+
+// SchemaWatcher is a tabletserver service that keeps a vstream open
+// on the local binlog. For every DDL it encounters, the vstreamer
+// reloads the schema of the affected tables. This makes schema changes
+// visible as soon as they're applied, instead of waiting for the next
+// periodic reload.
+//
+// The stream uses an empty filter: row events and DDLs are not sent,
+// so its cost is limited to reading the binlog. The vstreamer reloads
+// the schema for all DDLs, whether they're sent or not.
+type SchemaWatcher struct {
+	vs            VStreamer
+	watchSchema   bool
+	retryInterval time.Duration
+
+	// Life cycle management vars
+	isOpen bool
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSchemaWatcher creates a new SchemaWatcher.
+func NewSchemaWatcher(vs VStreamer, config tabletenv.TabletConfig) *SchemaWatcher {
+	return &SchemaWatcher{
+		vs:            vs,
+		watchSchema:   config.WatchSchemaChanges,
+		retryInterval: 5 * time.Second,
+	}
+}
+
+// Open starts the SchemaWatcher service.
+func (sw *SchemaWatcher) Open() {
+	if sw.isOpen || !sw.watchSchema {
+		return
+	}
+	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
+	sw.cancel = cancel
+	sw.wg.Add(1)
+	go sw.process(ctx)
+	sw.isOpen = true
+}
+
+// Close stops the SchemaWatcher service.
+func (sw *SchemaWatcher) Close() {
+	if !sw.isOpen {
+		return
+	}
+	sw.cancel()
+	sw.wg.Wait()
+	sw.isOpen = false
+}
+
+func (sw *SchemaWatcher) process(ctx context.Context) {
+	defer func() {
+		tabletenv.LogError()
+		sw.wg.Done()
+	}()
+
+	filter := &binlogdatapb.Filter{}
+	for {
+		log.Infof("Starting a vstream from the current position to watch for schema changes")
+		err := sw.vs.Stream(ctx, "current", filter, func(events []*binlogdatapb.VEvent) error {
+			return nil
+		})
+		log.Infof("Schema watcher vstream stopped: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sw.retryInterval):
+		}
+	}
+}
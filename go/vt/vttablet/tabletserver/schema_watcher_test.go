@@ -0,0 +1,71 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+type fakeVStreamer struct {
+	calls chan string
+}
+
+func (f *fakeVStreamer) Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	if !proto.Equal(filter, &binlogdatapb.Filter{}) {
+		return errors.New("unexpected filter")
+	}
+	f.calls <- startPos
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestSchemaWatcher(t *testing.T) {
+	vs := &fakeVStreamer{calls: make(chan string, 10)}
+	config := tabletenv.DefaultQsConfig
+
+	// The watcher is disabled by default.
+	sw := NewSchemaWatcher(vs, config)
+	sw.Open()
+	if sw.isOpen {
+		t.Fatal("schema watcher should not be open")
+	}
+
+	config.WatchSchemaChanges = true
+	sw = NewSchemaWatcher(vs, config)
+	sw.Open()
+	select {
+	case startPos := <-vs.calls:
+		if startPos != "current" {
+			t.Errorf("startPos: %s, want current", startPos)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the vstream to start")
+	}
+	sw.Close()
+	if sw.isOpen {
+		t.Fatal("schema watcher should be closed")
+	}
+}
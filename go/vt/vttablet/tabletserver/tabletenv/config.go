@@ -78,6 +78,7 @@ func init() {
 	flag.BoolVar(&Config.TerseErrors, "queryserver-config-terse-errors", DefaultQsConfig.TerseErrors, "prevent bind vars from escaping in returned errors")
 	flag.StringVar(&Config.PoolNamePrefix, "pool-name-prefix", DefaultQsConfig.PoolNamePrefix, "pool name prefix, vttablet has several pools and each of them has a name. This config specifies the prefix of these pool names")
 	flag.BoolVar(&Config.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to support the include_event_token ExecuteOptions.")
+	flag.BoolVar(&Config.WatchSchemaChanges, "watch_schema_changes", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and reload the schema of the tables affected by a DDL as soon as it is applied. The periodic schema reload remains as a safety net.")
 	flag.BoolVar(&Config.EnableAutoCommit, "enable-autocommit", DefaultQsConfig.EnableAutoCommit, "if the flag is on, a DML outsides a transaction will be auto committed. This flag is deprecated and is unsafe. Instead, use the VTGate provided autocommit feature.")
 	flag.BoolVar(&Config.TwoPCEnable, "twopc_enable", DefaultQsConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&Config.TwoPCCoordinatorAddress, "twopc_coordinator_address", DefaultQsConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -162,6 +163,7 @@ type TabletConfig struct {
 	PoolNamePrefix                string
 	TableACLExemptACL             string
 	WatchReplication              bool
+	WatchSchemaChanges            bool
 	TwoPCEnable                   bool
 	TwoPCCoordinatorAddress       string
 	TwoPCAbandonAge               float64
@@ -240,6 +242,7 @@ var DefaultQsConfig = TabletConfig{
 	PoolNamePrefix:                "",
 	TableACLExemptACL:             "",
 	WatchReplication:              false,
+	WatchSchemaChanges:            false,
 	TwoPCEnable:                   false,
 	TwoPCCoordinatorAddress:       "",
 	TwoPCAbandonAge:               0,
@@ -29,6 +29,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/history"
@@ -172,6 +173,7 @@ type TabletServer struct {
 	messager         *messager.Engine
 	watcher          *ReplicationWatcher
 	vstreamer        *vstreamer.Engine
+	schemaWatcher    *SchemaWatcher
 	updateStreamList *binlog.StreamList
 
 	// checkMySQLThrottler is used to throttle the number of
@@ -244,8 +246,9 @@ type TxPoolController interface {
 	Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error)
 
 	// Commit commits the specified transaction, returning the statement used to execute
-	// the commit or "" in autocommit settings.
-	Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, error)
+	// the commit or "" in autocommit settings, and the session state changes reported
+	// by MySQL for the commit.
+	Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, string, error)
 
 	// Rollback rolls back the specified transaction.
 	Rollback(ctx context.Context, transactionID int64) error
@@ -306,6 +309,7 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	})
 	// TODO(sougou): move this up once the stats naming problem is fixed.
 	tsv.vstreamer = vstreamer.NewEngine(srvTopoServer, tsv.se)
+	tsv.schemaWatcher = NewSchemaWatcher(tsv.vstreamer, config)
 	return tsv
 }
 
@@ -551,6 +555,7 @@ func (tsv *TabletServer) fullStart() (err error) {
 	if err := tsv.qe.Open(); err != nil {
 		return err
 	}
+	tsv.se.RegisterNotifier("health", tsv.schemaChanged)
 	if err := tsv.teCtrl.Init(); err != nil {
 		return err
 	}
@@ -560,6 +565,7 @@ func (tsv *TabletServer) fullStart() (err error) {
 	tsv.hr.Init(tsv.target)
 	tsv.updateStreamList.Init()
 	tsv.vstreamer.Open(tsv.target.Keyspace, tsv.alias.Cell)
+	tsv.schemaWatcher.Open()
 	return tsv.serveNewType()
 }
 
@@ -617,7 +623,9 @@ func (tsv *TabletServer) StopService() {
 
 	log.Infof("Executing complete shutdown.")
 	tsv.waitForShutdown()
+	tsv.schemaWatcher.Close()
 	tsv.vstreamer.Close()
+	tsv.se.UnregisterNotifier("health")
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.hw.Close()
@@ -650,6 +658,7 @@ func (tsv *TabletServer) closeAll() {
 	tsv.teCtrl.StopGently()
 	tsv.watcher.Close()
 	tsv.updateStreamList.Stop()
+	tsv.schemaWatcher.Close()
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.txThrottler.Close()
@@ -799,9 +808,10 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, opti
 	return transactionID, err
 }
 
-// Commit commits the specified transaction.
-func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
-	return tsv.execRequest(
+// Commit commits the specified transaction, and returns the session
+// state changes reported by MySQL for the commit.
+func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (sessionStateChanges string, err error) {
+	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Commit", "commit", nil,
 		target, nil, false /* isBegin */, true, /* allowOnShutdown */
@@ -810,7 +820,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			logStats.TransactionID = transactionID
 
 			var commitSQL string
-			commitSQL, err = tsv.teCtrl.Commit(ctx, transactionID, tsv.messager)
+			commitSQL, sessionStateChanges, err = tsv.teCtrl.Commit(ctx, transactionID, tsv.messager)
 
 			// If nothing was actually executed, don't count the operation in
 			// the tablet metrics, and clear out the logStats Method so that
@@ -823,6 +833,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			return err
 		},
 	)
+	return sessionStateChanges, err
 }
 
 // Rollback rollsback the specified transaction.
@@ -1175,11 +1186,15 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 		results = append(results, *localReply)
 	}
 	if asTransaction {
-		if err = tsv.Commit(ctx, target, transactionID); err != nil {
-			transactionID = 0
+		var sessionStateChanges string
+		sessionStateChanges, err = tsv.Commit(ctx, target, transactionID)
+		transactionID = 0
+		if err != nil {
 			return nil, err
 		}
-		transactionID = 0
+		if sessionStateChanges != "" {
+			results[len(results)-1].SessionStateChanges = sessionStateChanges
+		}
 	}
 	return results, nil
 }
@@ -1349,14 +1364,32 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the list of messages for a given message table
+// to its dead letter table. Only messages that exceeded the max epoch and
+// are not acked are moved. It returns the number of messages moved.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateDeadLetterQueries(name, ids)
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		query, bv, err := queryGenerator()
+		return []string{query}, bv, err
+	})
+}
+
+// execDMLs executes the generated queries in a single transaction.
+// It returns the number of rows affected by the last one.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.startRequest(ctx, target, true /* isBegin */, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.endRequest(true)
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, bv, err := queryGenerator()
 	if err != nil {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 	}
@@ -1372,11 +1405,13 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, nil)
-	if err != nil {
-		return 0, err
+	var qr *sqltypes.Result
+	for _, query := range queries {
+		if qr, err = tsv.Execute(ctx, target, query, bv, transactionID, nil); err != nil {
+			return 0, err
+		}
 	}
-	if err = tsv.Commit(ctx, target, transactionID); err != nil {
+	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
 		return 0, err
 	}
@@ -1979,6 +2014,39 @@ func (tsv *TabletServer) BroadcastHealth(terTimestamp int64, stats *querypb.Real
 	tsv.lastStreamHealthExpiration = time.Now().Add(maxCache)
 }
 
+// schemaChanged is the schema.Engine notifier. It re-sends the last
+// health response with the list of changed tables so that health
+// stream clients can refresh their copy of the schema. The response
+// is not cached: only the clients currently listening need it.
+func (tsv *TabletServer) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
+	changed := make([]string, 0, len(created)+len(altered)+len(dropped))
+	changed = append(changed, created...)
+	changed = append(changed, altered...)
+	changed = append(changed, dropped...)
+	if len(changed) == 0 {
+		return
+	}
+	sort.Strings(changed)
+
+	tsv.streamHealthMutex.Lock()
+	defer tsv.streamHealthMutex.Unlock()
+	if tsv.lastStreamHealthResponse == nil {
+		return
+	}
+	shr := proto.Clone(tsv.lastStreamHealthResponse).(*querypb.StreamHealthResponse)
+	if shr.RealtimeStats == nil {
+		shr.RealtimeStats = &querypb.RealtimeStats{}
+	}
+	shr.RealtimeStats.TableSchemaChanged = changed
+	for _, c := range tsv.streamHealthMap {
+		// Do not block on any write.
+		select {
+		case c <- shr:
+		default:
+		}
+	}
+}
+
 // HeartbeatLag returns the current lag as calculated by the heartbeat
 // package, if heartbeat is enabled. Otherwise returns 0.
 func (tsv *TabletServer) HeartbeatLag() (time.Duration, error) {
@@ -944,7 +944,7 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, transactionID, nil); err != nil {
 		t.Fatalf("failed to execute query: %s: %s", executeSQL, err)
 	}
-	if err := tsv.Commit(ctx, &target, transactionID); err != nil {
+	if _, err := tsv.Commit(ctx, &target, transactionID); err != nil {
 		t.Fatalf("call TabletServer.Commit failed: %v", err)
 	}
 }
@@ -963,7 +963,7 @@ func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	}
 	defer tsv.StopService()
 	ctx := context.Background()
-	err = tsv.Commit(ctx, &target, -1)
+	_, err = tsv.Commit(ctx, &target, -1)
 	want := "transaction -1: not found"
 	if err == nil || err.Error() != want {
 		t.Fatalf("Commit err: %v, want %v", err, want)
@@ -1462,7 +1462,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1482,7 +1482,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		// open a second connection while the request of the first connection is
 		// still pending.
 		<-tx3Finished
-		if err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1497,7 +1497,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 		close(tx3Finished)
@@ -1703,7 +1703,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1722,7 +1722,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q2, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1741,7 +1741,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1841,7 +1841,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -2029,7 +2029,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -2066,7 +2066,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -2087,6 +2087,43 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 	}
 }
 
+func TestSchemaChangedBroadcast(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	id, ch := tsv.streamHealthRegister()
+	defer tsv.streamHealthUnregister(id)
+
+	// Without a previous health response, there is nothing to send.
+	tsv.schemaChanged(nil, []string{"t1"}, nil, nil)
+	select {
+	case shr := <-ch:
+		t.Fatalf("unexpected health response: %v", shr)
+	default:
+	}
+
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{SecondsBehindMaster: 1}, time.Minute)
+	<-ch
+	tsv.schemaChanged(nil, []string{"t3"}, []string{"t1"}, []string{"t2"})
+	shr := <-ch
+	want := &querypb.RealtimeStats{
+		SecondsBehindMaster: 1,
+		TableSchemaChanged:  []string{"t1", "t2", "t3"},
+	}
+	if !proto.Equal(shr.RealtimeStats, want) {
+		t.Errorf("RealtimeStats: %v, want %v", shr.RealtimeStats, want)
+	}
+
+	// The schema change must not be replayed to new clients.
+	tsv.streamHealthMutex.Lock()
+	last := tsv.lastStreamHealthResponse
+	tsv.streamHealthMutex.Unlock()
+	if got := last.RealtimeStats.TableSchemaChanged; got != nil {
+		t.Errorf("cached TableSchemaChanged: %v, want nil", got)
+	}
+}
+
 func TestMessageStream(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -2246,6 +2283,26 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", []string{"1"})
+	want := "message table nonmsg not found in schema"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.DeadLetterMessages(invalid): %v, want %s", err, want)
+	}
+
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", []string{"1"})
+	want = "message table msg has no dead letter table"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.DeadLetterMessages(no dlq): %v, want %s", err, want)
+	}
+}
+
 func TestTabletServerSplitQuery(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -299,7 +299,7 @@ func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions)
 }
 
 // Commit commits the specified transaction.
-func (te *TxEngine) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, error) {
+func (te *TxEngine) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Commit")
 	defer span.Finish()
 	return te.txPool.Commit(ctx, transactionID, mc)
@@ -257,6 +257,10 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 		return 0, "", err
 	}
 
+	if err := conn.SetSessionTrackGTIDs(ctx, options.GetSessionTrackGtids()); err != nil {
+		return 0, "", err
+	}
+
 	autocommitTransaction := false
 	beginQueries := ""
 	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
@@ -295,15 +299,18 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 	return transactionID, beginQueries, nil
 }
 
-// Commit commits the specified transaction.
-func (axp *TxPool) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, error) {
+// Commit commits the specified transaction. It returns the commit
+// statement that was executed, and the session state changes that
+// MySQL reported for it.
+func (axp *TxPool) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
 	defer span.Finish()
 	conn, err := axp.Get(transactionID, "for commit")
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	return axp.LocalCommit(ctx, conn, mc)
+	commitSQL, err := axp.LocalCommit(ctx, conn, mc)
+	return commitSQL, conn.SessionStateChanges, err
 }
 
 // Rollback rolls back the specified transaction.
@@ -355,10 +362,12 @@ func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messa
 		return "", nil
 	}
 
-	if _, err := conn.Exec(ctx, "commit", 1, false); err != nil {
+	qr, err := conn.Exec(ctx, "commit", 1, false)
+	if err != nil {
 		conn.Close()
 		return "", err
 	}
+	conn.SessionStateChanges = qr.SessionStateChanges
 	mc.UpdateCaches(conn.NewMessages, conn.ChangedMessages)
 	return "commit", nil
 }
@@ -435,6 +444,10 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+
+	// SessionStateChanges contains the GTIDs reported by MySQL
+	// when the transaction was committed, if they were tracked.
+	SessionStateChanges string
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
@@ -67,7 +67,7 @@ func TestTxPoolExecuteCommit(t *testing.T) {
 	_, _ = txConn.Exec(ctx, sql, 1, true)
 	txConn.Recycle()
 
-	commitSQL, err := txPool.Commit(ctx, transactionID, &fakeMessageCommitter{})
+	commitSQL, _, err := txPool.Commit(ctx, transactionID, &fakeMessageCommitter{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -335,7 +335,7 @@ func TestTxPoolAutocommit(t *testing.T) {
 	if beginSQL != "" {
 		t.Errorf("beginSQL got %q want ''", beginSQL)
 	}
-	commitSQL, err := txPool.Commit(ctx, txid, &fakeMessageCommitter{})
+	commitSQL, _, err := txPool.Commit(ctx, txid, &fakeMessageCommitter{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -564,7 +564,7 @@ func TestTxPoolGetConnRecentlyRemovedTransaction(t *testing.T) {
 	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
 
 	id, _, err = txPool.Begin(ctx, &querypb.ExecuteOptions{})
-	if _, err := txPool.Commit(ctx, id, &fakeMessageCommitter{}); err != nil {
+	if _, _, err := txPool.Commit(ctx, id, &fakeMessageCommitter{}); err != nil {
 		t.Fatalf("got error: %v", err)
 	}
 
@@ -200,6 +200,40 @@ func tableMatches(table sqlparser.TableName, dbname string, filter *binlogdatapb
 	return false
 }
 
+// ddlTables returns the local tables affected by a DDL. It returns
+// false if the tables could not be determined, in which case the
+// whole schema must be reloaded.
+func ddlTables(query mysql.Query, dbname string) ([]string, bool) {
+	ast, err := sqlparser.Parse(query.SQL)
+	if err != nil {
+		return nil, false
+	}
+	stmt, ok := ast.(*sqlparser.DDL)
+	if !ok {
+		// Database DDLs don't affect tables. Anything else
+		// is unexpected.
+		_, ok = ast.(*sqlparser.DBDDL)
+		return nil, ok
+	}
+	var tables []string
+	for _, table := range append(append(sqlparser.TableNames{stmt.Table}, stmt.FromTables...), stmt.ToTables...) {
+		if table.IsEmpty() {
+			continue
+		}
+		// Unqualified tables belong to the default database
+		// of the statement.
+		database := query.Database
+		if !table.Qualifier.IsEmpty() {
+			database = table.Qualifier.String()
+		}
+		if database != "" && database != dbname {
+			continue
+		}
+		tables = append(tables, table.Name.String())
+	}
+	return tables, true
+}
+
 func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*Plan, error) {
 	for _, rule := range filter.Rules {
 		switch {
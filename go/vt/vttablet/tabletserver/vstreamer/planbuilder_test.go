@@ -170,6 +170,66 @@ func TestMustSendDDL(t *testing.T) {
 	}
 }
 
+func TestDDLTables(t *testing.T) {
+	testcases := []struct {
+		sql    string
+		db     string
+		tables []string
+		ok     bool
+	}{{
+		sql: "create database db",
+		ok:  true,
+	}, {
+		sql:    "create table t1(id int)",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql:    "alter table mydb.t1 add column val int",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql: "create table db.t1(id int)",
+		ok:  true,
+	}, {
+		sql:    "rename table t1 to t2, db.t3 to t4",
+		tables: []string{"t1", "t2", "t4"},
+		ok:     true,
+	}, {
+		sql:    "drop table t1, t2",
+		tables: []string{"t1", "t2"},
+		ok:     true,
+	}, {
+		sql: "create table t1(id int)",
+		db:  "db",
+		ok:  true,
+	}, {
+		sql:    "create table t1(id int)",
+		db:     "mydb",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql:    "alter table mydb.t1 add column val int",
+		db:     "db",
+		tables: []string{"t1"},
+		ok:     true,
+	}, {
+		sql:    "rename table t1 to mydb.t2",
+		db:     "db",
+		tables: []string{"t2"},
+		ok:     true,
+	}, {
+		sql: "bad query",
+		ok:  false,
+	}}
+	for _, tcase := range testcases {
+		q := mysql.Query{SQL: tcase.sql, Database: tcase.db}
+		tables, ok := ddlTables(q, "mydb")
+		if !reflect.DeepEqual(tables, tcase.tables) || ok != tcase.ok {
+			t.Errorf("%v: %v, %v, want %v, %v", q, tables, ok, tcase.tables, tcase.ok)
+		}
+	}
+}
+
 func TestPlanbuilder(t *testing.T) {
 	t1 := &Table{
 		Name: "t1",
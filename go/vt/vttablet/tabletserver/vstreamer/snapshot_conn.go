@@ -100,6 +100,47 @@ func (conn *snapshotConn) startSnapshot(ctx context.Context, table string) (gtid
 	return mysql.EncodePosition(mpos), nil
 }
 
+// startSnapshotAllTables starts transactions with the same snapshot view
+// of all the tables on the connections. It returns the gtid of the time
+// when the snapshot was taken.
+func startSnapshotAllTables(ctx context.Context, cp dbconfigs.Connector, conns []*snapshotConn) (gtid string, err error) {
+	lockConn, err := mysqlConnect(ctx, cp)
+	if err != nil {
+		return "", err
+	}
+	// To be safe, always unlock tables, even if lock tables might fail.
+	defer func() {
+		_, err := lockConn.ExecuteFetch("unlock tables", 0, false)
+		if err != nil {
+			log.Warning("Unlock tables failed: %v", err)
+		} else {
+			log.Infof("All tables unlocked")
+		}
+		lockConn.Close()
+	}()
+
+	log.Infof("Locking all tables for a snapshot")
+	if _, err := lockConn.ExecuteFetch("flush tables with read lock", 1, false); err != nil {
+		return "", err
+	}
+	mpos, err := lockConn.MasterPosition()
+	if err != nil {
+		return "", err
+	}
+
+	// No write can happen while the tables are locked, so all
+	// the transactions see the same snapshot.
+	for _, conn := range conns {
+		if _, err := conn.ExecuteFetch("set transaction isolation level repeatable read", 1, false); err != nil {
+			return "", err
+		}
+		if _, err := conn.ExecuteFetch("start transaction with consistent snapshot, read only", 1, false); err != nil {
+			return "", err
+		}
+	}
+	return mysql.EncodePosition(mpos), nil
+}
+
 // Close rollsback any open transactions and closes the connection.
 func (conn *snapshotConn) Close() {
 	_, _ = conn.ExecuteFetch("rollback", 1, false)
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// SnapshotStreamer streams the rows of tables as of the same
+// consistent snapshot of all the tables. It keeps one snapshot
+// connection for each table streamed concurrently.
+// This is used by logical backups.
+type SnapshotStreamer struct {
+	conns chan *snapshotConn
+	all   []*snapshotConn
+	gtid  string
+}
+
+// NewSnapshotStreamer opens the connections, and takes the snapshot.
+func NewSnapshotStreamer(ctx context.Context, cp dbconfigs.Connector, concurrency int) (*SnapshotStreamer, error) {
+	ss := &SnapshotStreamer{
+		conns: make(chan *snapshotConn, concurrency),
+	}
+	for i := 0; i < concurrency; i++ {
+		conn, err := snapshotConnect(ctx, cp)
+		if err != nil {
+			ss.Close()
+			return nil, err
+		}
+		ss.all = append(ss.all, conn)
+		if _, err := conn.ExecuteFetch("set names binary", 1, false); err != nil {
+			ss.Close()
+			return nil, err
+		}
+	}
+	gtid, err := startSnapshotAllTables(ctx, cp, ss.all)
+	if err != nil {
+		ss.Close()
+		return nil, err
+	}
+	ss.gtid = gtid
+	for _, conn := range ss.all {
+		ss.conns <- conn
+	}
+	return ss, nil
+}
+
+// Gtid returns the GTID position of the snapshot.
+func (ss *SnapshotStreamer) Gtid() string {
+	return ss.gtid
+}
+
+// StreamTable streams the rows of a table as of the snapshot. The first
+// response has the fields, and the next ones the rows. It waits for
+// a connection if all of them are streaming other tables.
+func (ss *SnapshotStreamer) StreamTable(ctx context.Context, dbName, tableName string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	var conn *snapshotConn
+	select {
+	case conn = <-ss.conns:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err := streamSnapshotTable(ctx, conn, dbName, tableName, ss.gtid, send); err != nil {
+		// The connection may be in the middle of a result,
+		// so it is not reused. Close will close it.
+		return err
+	}
+	ss.conns <- conn
+	return nil
+}
+
+func streamSnapshotTable(ctx context.Context, conn *snapshotConn, dbName, tableName, gtid string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	query := fmt.Sprintf("select * from %s.%s", sqlescape.EscapeID(dbName), sqlescape.EscapeID(tableName))
+	if err := conn.ExecuteStreamFetch(query); err != nil {
+		return err
+	}
+
+	// first call the callback with the fields
+	flds, err := conn.Fields()
+	if err != nil {
+		return err
+	}
+	err = send(&binlogdatapb.VStreamResultsResponse{
+		Fields: flds,
+		Gtid:   gtid,
+	})
+	if err != nil {
+		return fmt.Errorf("stream send error: %v", err)
+	}
+
+	response := &binlogdatapb.VStreamResultsResponse{}
+	byteCount := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stream ended: %v", ctx.Err())
+		default:
+		}
+
+		row, err := conn.FetchNext()
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+		response.Rows = append(response.Rows, sqltypes.RowToProto3(row))
+		for _, s := range row {
+			byteCount += s.Len()
+		}
+
+		if byteCount >= *PacketSize {
+			err = send(response)
+			if err != nil {
+				return err
+			}
+			// empty the rows so we start over, but we keep the
+			// same capacity
+			response.Rows = nil
+			byteCount = 0
+		}
+	}
+
+	if len(response.Rows) > 0 {
+		err = send(response)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close rolls back the snapshot transactions and closes the connections.
+func (ss *SnapshotStreamer) Close() {
+	for _, conn := range ss.all {
+		conn.Close()
+	}
+}
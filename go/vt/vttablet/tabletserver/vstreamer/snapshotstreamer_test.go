@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestSnapshotStreamer(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		"create table t2(id int, val varbinary(128), primary key(id))",
+		"insert into t1 values (1, 'aaa')",
+		"insert into t2 values (1, 'bbb')",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		"drop table t2",
+	})
+
+	ctx := context.Background()
+	ss, err := NewSnapshotStreamer(ctx, engine.cp, 2)
+	require.NoError(t, err)
+	defer ss.Close()
+	assert.Equal(t, masterPosition(t), ss.Gtid())
+
+	// These rows should not be in the results.
+	execStatements(t, []string{
+		"insert into t1 values (2, 'ccc')",
+		"insert into t2 values (2, 'ddd')",
+	})
+
+	for table, want := range map[string]string{"t1": "aaa", "t2": "bbb"} {
+		var rows []*binlogdatapb.VStreamResultsResponse
+		err := ss.StreamTable(ctx, "vttest", table, func(response *binlogdatapb.VStreamResultsResponse) error {
+			rows = append(rows, response)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(rows), "%v", rows)
+		assert.Equal(t, 2, len(rows[0].Fields))
+		assert.Equal(t, ss.Gtid(), rows[0].Gtid)
+		wantRow := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt32(1), sqltypes.NewVarBinary(want)})
+		require.Equal(t, 1, len(rows[1].Rows))
+		assert.Equal(t, wantRow.String(), rows[1].Rows[0].String())
+	}
+}
@@ -451,10 +451,14 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 					Type: binlogdatapb.VEventType_OTHER,
 				})
 			}
-			// Proactively reload schema.
+			// Proactively reload the schema of the affected tables.
 			// If the DDL adds a column, comparing with an older snapshot of the
 			// schema will make us think that a column was dropped and error out.
-			vs.se.Reload(vs.ctx)
+			if tables, ok := ddlTables(q, params.DbName); ok {
+				vs.se.ReloadTables(vs.ctx, tables)
+			} else {
+				vs.se.Reload(vs.ctx)
+			}
 		case sqlparser.StmtOther, sqlparser.StmtPriv:
 			// These are either:
 			// 1) DBA statements like REPAIR that can be ignored.
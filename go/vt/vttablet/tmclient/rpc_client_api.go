@@ -156,6 +156,11 @@ type TabletManagerClient interface {
 	// replication positions are reset.
 	ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// InjectEmptyTransactions tells a master tablet to commit an
+	// empty transaction for each of the GTIDs of a MySQL 5.6+ GTID
+	// set, so they are part of its history.
+	InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error
+
 	// InitMaster tells a tablet to make itself the new master,
 	// and return the replication position the slaves should use to
 	// reparent to it.
@@ -206,8 +211,32 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToTime or restoreToPos is set, the archived binlogs are
+	// replayed on top of the backup, until that time or position.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error)
+
+	// SetBackupMaxRate changes the rate limit of the backups and restores
+	// of the tablet, in bytes per second, and returns the previous one.
+	SetBackupMaxRate(ctx context.Context, tablet *topodatapb.Tablet, maxRate int64) (int64, error)
+
+	//
+	// MySQL configuration related methods
+	//
+
+	// GetMysqlVariables returns the global variables of mysqld with the
+	// given names, or all of them, and the ones persisted in its my.cnf.
+	GetMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, names []string) (map[string]string, map[string]string, error)
+
+	// ApplyMysqlVariables changes global variables of mysqld, and persists
+	// them in its my.cnf. The variables which are not dynamic are rejected,
+	// unless allowRestart is set: they are then only persisted, and
+	// returned as requiring a restart. It returns the previous values.
+	ApplyMysqlVariables(ctx context.Context, tablet *topodatapb.Tablet, variables map[string]string, allowRestart bool) (map[string]string, []string, error)
+
+	// RestartMysqld stops and starts mysqld, running the upgrade hook,
+	// if not empty, while it is stopped. The tablet must not be a master.
+	RestartMysqld(ctx context.Context, tablet *topodatapb.Tablet, upgradeHook string) error
 
 	//
 	// Management methods
@@ -0,0 +1,38 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollingrestart
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// RollingRestartWrangler is the interface of the wrangler methods used by
+// the workflow, to replace them in unit tests. It includes a subset of the
+// methods in go/vt/Wrangler.
+type RollingRestartWrangler interface {
+	ChangeSlaveType(ctx context.Context, tabletAlias *topodatapb.TabletAlias, tabletType topodatapb.TabletType) error
+
+	RestartMysqld(ctx context.Context, tabletAlias *topodatapb.TabletAlias, upgradeHook string) error
+
+	WaitForTabletHealthy(ctx context.Context, tabletAlias *topodatapb.TabletAlias, maxReplicationLag time.Duration) error
+
+	PlannedReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollingrestart
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+// GetTasks returns the tasks of a shard from the checkpoint
+// with expected execution order.
+func (rw *rollingRestartWorkflow) GetTasks(shard string) []*workflowpb.Task {
+	var tasks []*workflowpb.Task
+	for _, name := range strings.Split(rw.checkpoint.Settings["tasks/"+shard], ",") {
+		tasks = append(tasks, rw.checkpoint.Tasks[createTaskID(shard, name)])
+	}
+	return tasks
+}
+
+// runTask runs a task, once the workflow is not paused.
+func (rw *rollingRestartWorkflow) runTask(ctx context.Context, t *workflowpb.Task) error {
+	if err := rw.waitUntilRunnable(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		rw.mu.Lock()
+		rw.running = false
+		rw.mu.Unlock()
+	}()
+
+	switch t.Attributes["action"] {
+	case taskRestart:
+		return rw.runRestart(ctx, t)
+	case taskReparent:
+		return rw.runReparent(ctx, t)
+	default:
+		return fmt.Errorf("unknown action %v for task %v", t.Attributes["action"], t.Id)
+	}
+}
+
+// waitUntilRunnable waits until the workflow is not paused, and marks a
+// task as running. It cancels the workflow if it was aborted.
+func (rw *rollingRestartWorkflow) waitUntilRunnable(ctx context.Context) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	for {
+		if rw.aborted {
+			rw.cancel()
+			return errAborted
+		}
+		if !rw.paused {
+			rw.running = true
+			return nil
+		}
+		resumed := rw.resumed
+		rw.mu.Unlock()
+		select {
+		case <-resumed:
+		case <-ctx.Done():
+			rw.mu.Lock()
+			return ctx.Err()
+		}
+		rw.mu.Lock()
+	}
+}
+
+// runRestart drains a tablet, restarts its mysqld, restores its type,
+// and waits for it to be healthy. The tablet may have been drained
+// by a previous run of the task.
+func (rw *rollingRestartWorkflow) runRestart(ctx context.Context, t *workflowpb.Task) error {
+	alias, err := topoproto.ParseTabletAlias(t.Attributes["tablet_alias"])
+	if err != nil {
+		return err
+	}
+	tabletType, err := topoproto.ParseTabletType(t.Attributes["tablet_type"])
+	if err != nil {
+		return err
+	}
+	ti, err := rw.topoServer.GetTablet(ctx, alias)
+	if err != nil {
+		return err
+	}
+	switch ti.Type {
+	case tabletType, topodatapb.TabletType_DRAINED:
+	case topodatapb.TabletType_MASTER:
+		return fmt.Errorf("tablet %v is the master of its shard, it cannot be restarted", t.Attributes["tablet_alias"])
+	default:
+		return fmt.Errorf("tablet %v is %v, expected %v: it changed since the workflow was created", t.Attributes["tablet_alias"], ti.Type, tabletType)
+	}
+
+	if ti.Type != topodatapb.TabletType_DRAINED {
+		if err := rw.wr.ChangeSlaveType(ctx, alias, topodatapb.TabletType_DRAINED); err != nil {
+			return err
+		}
+	}
+	if err := rw.wr.RestartMysqld(ctx, alias, rw.upgradeHook); err != nil {
+		return err
+	}
+	if tabletType != topodatapb.TabletType_DRAINED {
+		if err := rw.wr.ChangeSlaveType(ctx, alias, tabletType); err != nil {
+			return err
+		}
+	}
+	return rw.waitForTabletHealthy(ctx, alias)
+}
+
+// runReparent reparents a shard away from its master, and waits for the
+// new master to be healthy. Nothing is done if the master already changed.
+func (rw *rollingRestartWorkflow) runReparent(ctx context.Context, t *workflowpb.Task) error {
+	keyspace := t.Attributes["keyspace"]
+	shard := t.Attributes["shard"]
+	avoidMaster, err := topoproto.ParseTabletAlias(t.Attributes["avoid_master"])
+	if err != nil {
+		return err
+	}
+	if err := rw.wr.PlannedReparentShard(ctx, keyspace, shard, nil /* masterElectTabletAlias */, avoidMaster, rw.waitReplicasTimeout); err != nil {
+		return err
+	}
+	si, err := rw.topoServer.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() || topoproto.TabletAliasEqual(si.MasterAlias, avoidMaster) {
+		return fmt.Errorf("shard %v/%v was not reparented away from %v", keyspace, shard, t.Attributes["avoid_master"])
+	}
+	return rw.waitForTabletHealthy(ctx, si.MasterAlias)
+}
+
+func (rw *rollingRestartWorkflow) waitForTabletHealthy(ctx context.Context, alias *topodatapb.TabletAlias) error {
+	ctx, cancel := context.WithTimeout(ctx, rw.healthTimeout)
+	defer cancel()
+	return rw.wr.WaitForTabletHealthy(ctx, alias, rw.maxReplicationLag)
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollingrestart contains a workflow restarting the mysqld of all
+// the tablets of a keyspace, shard by shard, to apply a configuration
+// change or to upgrade MySQL. In each shard, the replicas are restarted
+// one at a time, then the master is reparented away and restarted.
+// Each tablet is drained during its restart, and the workflow waits for
+// it to be healthy and caught up on replication before going on.
+package rollingrestart
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/workflow"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	codeVersion               = 1
+	rollingRestartFactoryName = "rolling_restart"
+
+	actionPause  = "Pause"
+	actionResume = "Resume"
+	actionAbort  = "Abort"
+
+	// taskRestart and taskReparent are the values of the "action"
+	// attribute of the tasks.
+	taskRestart  = "restart"
+	taskReparent = "reparent"
+
+	// reparentTaskName is the name of the reparent task in each shard.
+	reparentTaskName = "reparent"
+)
+
+// errAborted is returned by Run when the Abort action stopped the workflow.
+var errAborted = fmt.Errorf("the rolling restart was aborted")
+
+// Register registers the rolling restart workflow as a factory in the
+// workflow framework.
+func Register() {
+	workflow.Register(rollingRestartFactoryName, &Factory{})
+}
+
+// Factory is the factory to create a rolling restart workflow.
+type Factory struct{}
+
+// Init is part of the workflow.Factory interface.
+func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string) error {
+	subFlags := flag.NewFlagSet(rollingRestartFactoryName, flag.ContinueOnError)
+	keyspace := subFlags.String("keyspace", "", "Name of the keyspace whose tablets are restarted")
+	shardsStr := subFlags.String("shards", "", "A comma-separated list of the shards to restart, in order. All the shards of the keyspace if empty")
+	upgradeHook := subFlags.String("upgrade_hook", "", "Name of a hook run on each tablet while its mysqld is stopped, to upgrade MySQL for instance")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 30*time.Second, "Replication lag a restarted tablet must be under before the next one is restarted")
+	healthTimeout := subFlags.Duration("health_timeout", 10*time.Minute, "How long to wait for a restarted tablet to be healthy, before the task fails")
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitSlaveTimeout, "How long to wait for the replicas to catch up when reparenting away from the master")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *keyspace == "" {
+		return fmt.Errorf("keyspace name must be provided for the rolling restart")
+	}
+
+	ctx := context.Background()
+	ts := m.TopoServer()
+	var shards []string
+	if *shardsStr != "" {
+		shards = strings.Split(*shardsStr, ",")
+	} else {
+		var err error
+		shards, err = ts.GetShardNames(ctx, *keyspace)
+		if err != nil {
+			return err
+		}
+		sort.Strings(shards)
+	}
+	if len(shards) == 0 {
+		return fmt.Errorf("no shards in keyspace %v", *keyspace)
+	}
+
+	checkpoint := &workflowpb.WorkflowCheckpoint{
+		CodeVersion: codeVersion,
+		Tasks:       make(map[string]*workflowpb.Task),
+		Settings: map[string]string{
+			"keyspace":              *keyspace,
+			"shards":                strings.Join(shards, ","),
+			"upgrade_hook":          *upgradeHook,
+			"max_replication_lag":   maxReplicationLag.String(),
+			"health_timeout":        healthTimeout.String(),
+			"wait_replicas_timeout": waitReplicasTimeout.String(),
+			"paused":                "false",
+			"aborted":               "false",
+		},
+	}
+	for _, shard := range shards {
+		if err := initShardTasks(ctx, ts, checkpoint, *keyspace, shard); err != nil {
+			return err
+		}
+	}
+
+	w.Name = fmt.Sprintf("Rolling restart of keyspace %v", *keyspace)
+	if *upgradeHook != "" {
+		w.Name += fmt.Sprintf(" with upgrade hook %v", *upgradeHook)
+	}
+	var err error
+	w.Data, err = proto.Marshal(checkpoint)
+	return err
+}
+
+// initShardTasks adds the tasks of a shard to the checkpoint: a restart
+// of each replica, the reparent away from the master, then its restart.
+// The tablets are listed now, so the tablets added later are not
+// restarted, and the type of each tablet is kept to restore it after
+// the restart.
+func initShardTasks(ctx context.Context, ts *topo.Server, checkpoint *workflowpb.WorkflowCheckpoint, keyspace, shard string) error {
+	si, err := ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	tabletMap, err := ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+
+	var replicas []*topo.TabletInfo
+	hasMasterCandidate := false
+	for _, ti := range tabletMap {
+		if topoproto.TabletAliasEqual(ti.Alias, si.MasterAlias) {
+			continue
+		}
+		switch ti.Type {
+		case topodatapb.TabletType_BACKUP, topodatapb.TabletType_RESTORE:
+			return fmt.Errorf("tablet %v is taking or restoring a backup, wait for it to finish", topoproto.TabletAliasString(ti.Alias))
+		case topodatapb.TabletType_REPLICA:
+			hasMasterCandidate = true
+		}
+		replicas = append(replicas, ti)
+	}
+	if !hasMasterCandidate {
+		return fmt.Errorf("shard %v/%v has no replica to reparent to while its master restarts", keyspace, shard)
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return topoproto.TabletAliasString(replicas[i].Alias) < topoproto.TabletAliasString(replicas[j].Alias)
+	})
+
+	masterAlias := topoproto.TabletAliasString(si.MasterAlias)
+	var order []string
+	for _, ti := range replicas {
+		alias := topoproto.TabletAliasString(ti.Alias)
+		addTask(checkpoint, shard, alias, map[string]string{
+			"action":       taskRestart,
+			"keyspace":     keyspace,
+			"shard":        shard,
+			"tablet_alias": alias,
+			"tablet_type":  ti.Type.String(),
+		})
+		order = append(order, alias)
+	}
+	addTask(checkpoint, shard, reparentTaskName, map[string]string{
+		"action":       taskReparent,
+		"keyspace":     keyspace,
+		"shard":        shard,
+		"avoid_master": masterAlias,
+	})
+	// The master is a replica when it restarts.
+	addTask(checkpoint, shard, masterAlias, map[string]string{
+		"action":       taskRestart,
+		"keyspace":     keyspace,
+		"shard":        shard,
+		"tablet_alias": masterAlias,
+		"tablet_type":  topodatapb.TabletType_REPLICA.String(),
+	})
+	order = append(order, reparentTaskName, masterAlias)
+	checkpoint.Settings["tasks/"+shard] = strings.Join(order, ",")
+	return nil
+}
+
+func addTask(checkpoint *workflowpb.WorkflowCheckpoint, shard, name string, attributes map[string]string) {
+	taskID := createTaskID(shard, name)
+	checkpoint.Tasks[taskID] = &workflowpb.Task{
+		Id:         taskID,
+		State:      workflowpb.TaskState_TaskNotStarted,
+		Attributes: attributes,
+	}
+}
+
+func createTaskID(shard, name string) string {
+	return fmt.Sprintf("%s/%s", shard, name)
+}
+
+// Instantiate is part the workflow.Factory interface.
+func (*Factory) Instantiate(m *workflow.Manager, w *workflowpb.Workflow, rootNode *workflow.Node) (workflow.Workflow, error) {
+	rootNode.Message = "This is a workflow to restart the mysqld of all the tablets of a keyspace, shard by shard."
+
+	checkpoint := &workflowpb.WorkflowCheckpoint{}
+	if err := proto.Unmarshal(w.Data, checkpoint); err != nil {
+		return nil, err
+	}
+	settings := checkpoint.Settings
+	maxReplicationLag, err := time.ParseDuration(settings["max_replication_lag"])
+	if err != nil {
+		return nil, err
+	}
+	healthTimeout, err := time.ParseDuration(settings["health_timeout"])
+	if err != nil {
+		return nil, err
+	}
+	waitReplicasTimeout, err := time.ParseDuration(settings["wait_replicas_timeout"])
+	if err != nil {
+		return nil, err
+	}
+
+	rw := &rollingRestartWorkflow{
+		checkpoint:          checkpoint,
+		rootUINode:          rootNode,
+		logger:              logutil.NewMemoryLogger(),
+		wr:                  wrangler.New(logutil.NewConsoleLogger(), m.TopoServer(), tmclient.NewTabletManagerClient()),
+		topoServer:          m.TopoServer(),
+		manager:             m,
+		shards:              strings.Split(settings["shards"], ","),
+		upgradeHook:         settings["upgrade_hook"],
+		maxReplicationLag:   maxReplicationLag,
+		healthTimeout:       healthTimeout,
+		waitReplicasTimeout: waitReplicasTimeout,
+		paused:              settings["paused"] == "true",
+		resumed:             make(chan struct{}),
+		aborted:             settings["aborted"] == "true",
+	}
+
+	for _, shard := range rw.shards {
+		shardUINode := &workflow.Node{
+			Name:     "Shard " + shard,
+			PathName: shard,
+		}
+		for _, task := range rw.GetTasks(shard) {
+			taskUINode := &workflow.Node{
+				PathName: task.Id[len(shard)+1:],
+			}
+			switch task.Attributes["action"] {
+			case taskRestart:
+				taskUINode.Name = "Restart tablet " + task.Attributes["tablet_alias"]
+			case taskReparent:
+				taskUINode.Name = "Reparent away from master " + task.Attributes["avoid_master"]
+			}
+			shardUINode.Children = append(shardUINode.Children, taskUINode)
+		}
+		rootNode.Children = append(rootNode.Children, shardUINode)
+	}
+	return rw, nil
+}
+
+// rollingRestartWorkflow contains meta-information and methods to
+// control the rolling restart workflow.
+type rollingRestartWorkflow struct {
+	ctx        context.Context
+	wr         RollingRestartWrangler
+	manager    *workflow.Manager
+	topoServer *topo.Server
+	wi         *topo.WorkflowInfo
+	// logger is the logger we export UI logs from.
+	logger *logutil.MemoryLogger
+
+	// rootUINode is the root node representing the workflow in the UI.
+	rootUINode *workflow.Node
+
+	checkpoint       *workflowpb.WorkflowCheckpoint
+	checkpointWriter *workflow.CheckpointWriter
+
+	shards              []string
+	upgradeHook         string
+	maxReplicationLag   time.Duration
+	healthTimeout       time.Duration
+	waitReplicasTimeout time.Duration
+
+	// mu protects the fields below, and the actions of rootUINode.
+	// We need it as both Run and Action can be called at the same time.
+	mu sync.Mutex
+	// cancel cancels ctx, to abort the workflow.
+	cancel context.CancelFunc
+	// paused is true if no task should start. It is checkpointed.
+	paused bool
+	// resumed is closed when the workflow is resumed.
+	resumed chan struct{}
+	// aborted is true if the Abort action was called. It is checkpointed.
+	aborted bool
+	// running is true while a task runs.
+	running bool
+}
+
+// Run executes the rolling restart.
+// It implements the workflow.Workflow interface.
+func (rw *rollingRestartWorkflow) Run(ctx context.Context, manager *workflow.Manager, wi *topo.WorkflowInfo) error {
+	rw.mu.Lock()
+	rw.ctx, rw.cancel = context.WithCancel(ctx)
+	rw.wi = wi
+	rw.checkpointWriter = workflow.NewCheckpointWriter(rw.topoServer, rw.checkpoint, rw.wi)
+	rw.rootUINode.Display = workflow.NodeDisplayDeterminate
+	rw.rootUINode.Listener = rw
+	rw.rootUINode.Actions = []*workflow.Action{
+		{
+			Name:  actionPause,
+			State: workflow.ActionStateEnabled,
+			Style: workflow.ActionStyleNormal,
+		},
+		{
+			Name:  actionResume,
+			State: workflow.ActionStateDisabled,
+			Style: workflow.ActionStyleNormal,
+		},
+		{
+			Name:  actionAbort,
+			State: workflow.ActionStateEnabled,
+			Style: workflow.ActionStyleNormal,
+		},
+	}
+	rw.uiUpdateLocked()
+	rw.mu.Unlock()
+	defer rw.cancel()
+	rw.rootUINode.BroadcastChanges(true /* updateChildren */)
+
+	for i, shard := range rw.shards {
+		runner := workflow.NewParallelRunner(rw.ctx, rw.rootUINode, rw.checkpointWriter, rw.GetTasks(shard), rw.runTask, workflow.Sequential, false /* enableApprovals */)
+		if err := runner.Run(); err != nil {
+			return err
+		}
+		// The runner returns early when the context is canceled.
+		rw.mu.Lock()
+		aborted := rw.aborted
+		rw.mu.Unlock()
+		if aborted {
+			rw.setUIMessage("Rolling restart aborted.")
+			return errAborted
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rw.mu.Lock()
+		rw.rootUINode.Progress = 100 * (i + 1) / len(rw.shards)
+		rw.mu.Unlock()
+		rw.setUIMessage(fmt.Sprintf("Shard %v is restarted.", shard))
+	}
+	rw.setUIMessage("Rolling restart is finished successfully.")
+	return nil
+}
+
+// Action handles the pause, resume and abort actions of the root node.
+// It implements the workflow.ActionListener interface.
+func (rw *rollingRestartWorkflow) Action(ctx context.Context, path, name string) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	switch name {
+	case actionPause:
+		if rw.paused || rw.aborted {
+			return nil
+		}
+		rw.paused = true
+		rw.resumed = make(chan struct{})
+		rw.logger.Infof("Paused: no more tablets are restarted until the workflow is resumed")
+	case actionResume:
+		if !rw.paused {
+			return nil
+		}
+		rw.paused = false
+		close(rw.resumed)
+		rw.logger.Infof("Resumed")
+	case actionAbort:
+		if rw.aborted {
+			return nil
+		}
+		rw.aborted = true
+		rw.logger.Infof("Aborted: the workflow stops once the current tablet is restarted")
+		// Without a running task, the runner waits for a retry or for
+		// the workflow to be resumed: it is stopped right away.
+		if !rw.running {
+			rw.cancel()
+		}
+	default:
+		return fmt.Errorf("unknown action: %v", name)
+	}
+
+	rw.uiUpdateLocked()
+	rw.rootUINode.BroadcastChanges(false /* updateChildren */)
+	if name == actionAbort {
+		return rw.checkpointWriter.UpdateSetting("aborted", "true")
+	}
+	return rw.checkpointWriter.UpdateSetting("paused", fmt.Sprintf("%v", rw.paused))
+}
+
+// uiUpdateLocked updates the actions and the log of the root node.
+func (rw *rollingRestartWorkflow) uiUpdateLocked() {
+	rw.rootUINode.Log = rw.logger.String()
+	rw.rootUINode.Actions[0].State = workflow.ActionStateEnabled
+	rw.rootUINode.Actions[1].State = workflow.ActionStateDisabled
+	rw.rootUINode.Actions[2].State = workflow.ActionStateEnabled
+	switch {
+	case rw.aborted:
+		rw.rootUINode.Actions[0].State = workflow.ActionStateDisabled
+		rw.rootUINode.Actions[2].State = workflow.ActionStateDisabled
+		rw.rootUINode.ProgressMessage = "aborted"
+	case rw.paused:
+		rw.rootUINode.Actions[0].State = workflow.ActionStateDisabled
+		rw.rootUINode.Actions[1].State = workflow.ActionStateEnabled
+		rw.rootUINode.ProgressMessage = "paused"
+	default:
+		rw.rootUINode.ProgressMessage = ""
+	}
+}
+
+func (rw *rollingRestartWorkflow) setUIMessage(message string) {
+	log.Infof("Rolling restart: %v", message)
+	rw.mu.Lock()
+	rw.logger.Infof(message)
+	rw.rootUINode.Log = rw.logger.String()
+	rw.rootUINode.Message = message
+	rw.mu.Unlock()
+	rw.rootUINode.BroadcastChanges(false /* updateChildren */)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollingrestart
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/workflow"
+
+	// import the gRPC client implementation for tablet manager
+	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+var testKeyspace = "test_keyspace"
+
+func init() {
+	Register()
+}
+
+// fakeWrangler records the calls, and changes the topology like the
+// wrangler would.
+type fakeWrangler struct {
+	ts *topo.Server
+
+	mu    sync.Mutex
+	calls []string
+	// onRestart is called by RestartMysqld, if set.
+	onRestart func(alias string)
+}
+
+func (fw *fakeWrangler) record(format string, args ...interface{}) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.calls = append(fw.calls, fmt.Sprintf(format, args...))
+}
+
+func (fw *fakeWrangler) getCalls() []string {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	return append([]string(nil), fw.calls...)
+}
+
+func (fw *fakeWrangler) ChangeSlaveType(ctx context.Context, tabletAlias *topodatapb.TabletAlias, tabletType topodatapb.TabletType) error {
+	fw.record("ChangeSlaveType %v %v", topoproto.TabletAliasString(tabletAlias), tabletType)
+	_, err := fw.ts.UpdateTabletFields(ctx, tabletAlias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = tabletType
+		return nil
+	})
+	return err
+}
+
+func (fw *fakeWrangler) RestartMysqld(ctx context.Context, tabletAlias *topodatapb.TabletAlias, upgradeHook string) error {
+	alias := topoproto.TabletAliasString(tabletAlias)
+	fw.record("RestartMysqld %v %v", alias, upgradeHook)
+	if fw.onRestart != nil {
+		fw.onRestart(alias)
+	}
+	return nil
+}
+
+func (fw *fakeWrangler) WaitForTabletHealthy(ctx context.Context, tabletAlias *topodatapb.TabletAlias, maxReplicationLag time.Duration) error {
+	fw.record("WaitForTabletHealthy %v %v", topoproto.TabletAliasString(tabletAlias), maxReplicationLag)
+	return nil
+}
+
+// PlannedReparentShard promotes the first replica.
+func (fw *fakeWrangler) PlannedReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+	fw.record("PlannedReparentShard %v/%v %v", keyspace, shard, topoproto.TabletAliasString(avoidMasterAlias))
+	tabletMap, err := fw.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	var newMaster *topodatapb.TabletAlias
+	for _, ti := range tabletMap {
+		if ti.Type == topodatapb.TabletType_REPLICA && (newMaster == nil || ti.Alias.Uid < newMaster.Uid) {
+			newMaster = ti.Alias
+		}
+	}
+	for alias, tabletType := range map[*topodatapb.TabletAlias]topodatapb.TabletType{
+		avoidMasterAlias: topodatapb.TabletType_REPLICA,
+		newMaster:        topodatapb.TabletType_MASTER,
+	} {
+		if _, err := fw.ts.UpdateTabletFields(ctx, alias, func(tablet *topodatapb.Tablet) error {
+			tablet.Type = tabletType
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	_, err = fw.ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = newMaster
+		return nil
+	})
+	return err
+}
+
+// setupTopology creates a shard with a master, a replica and a rdonly.
+func setupTopology(ctx context.Context, t *testing.T) *topo.Server {
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, testKeyspace, &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateShard(ctx, testKeyspace, "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	for uid, tabletType := range map[uint32]topodatapb.TabletType{
+		100: topodatapb.TabletType_MASTER,
+		101: topodatapb.TabletType_REPLICA,
+		102: topodatapb.TabletType_RDONLY,
+	} {
+		if err := ts.CreateTablet(ctx, &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+			Keyspace: testKeyspace,
+			Shard:    "0",
+			Type:     tabletType,
+		}); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+	}
+	if _, err := ts.UpdateShardFields(ctx, testKeyspace, "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	return ts
+}
+
+// startWorkflow creates and starts the workflow with a fake wrangler.
+// onRestart, if set, is called by the restarts of mysqld.
+func startWorkflow(ctx context.Context, t *testing.T, m *workflow.Manager, fw *fakeWrangler, onRestart func(uuid, alias string)) string {
+	uuid, err := m.Create(ctx, rollingRestartFactoryName, []string{"-keyspace=" + testKeyspace, "-upgrade_hook=upgrade_mysql", "-max_replication_lag=10s"})
+	if err != nil {
+		t.Fatalf("cannot create the rolling restart workflow: %v", err)
+	}
+	w, err := m.WorkflowForTesting(uuid)
+	if err != nil {
+		t.Fatalf("fail to get workflow from manager: %v", err)
+	}
+	w.(*rollingRestartWorkflow).wr = fw
+	if onRestart != nil {
+		fw.onRestart = func(alias string) { onRestart(uuid, alias) }
+	}
+	if err := m.Start(ctx, uuid); err != nil {
+		t.Fatalf("cannot start the rolling restart workflow: %v", err)
+	}
+	return uuid
+}
+
+func restartCalls(alias, tabletType string) []string {
+	return []string{
+		"ChangeSlaveType " + alias + " DRAINED",
+		"RestartMysqld " + alias + " upgrade_mysql",
+		"ChangeSlaveType " + alias + " " + tabletType,
+		"WaitForTabletHealthy " + alias + " 10s",
+	}
+}
+
+func TestRollingRestart(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	fw := &fakeWrangler{ts: ts}
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	uuid := startWorkflow(ctx, t, m, fw, nil)
+	if err := m.Wait(ctx, uuid); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if err := workflow.VerifyAllTasksDone(ctx, ts, uuid); err != nil {
+		t.Fatal(err)
+	}
+
+	// The replicas are restarted first, then the master once reparented.
+	var want []string
+	want = append(want, restartCalls("cell1-0000000101", "REPLICA")...)
+	want = append(want, restartCalls("cell1-0000000102", "RDONLY")...)
+	want = append(want, "PlannedReparentShard test_keyspace/0 cell1-0000000100", "WaitForTabletHealthy cell1-0000000101 10s")
+	want = append(want, restartCalls("cell1-0000000100", "REPLICA")...)
+	if got := fw.getCalls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+}
+
+func TestRollingRestartNoReplica(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	if _, err := ts.UpdateTabletFields(ctx, &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = topodatapb.TabletType_RDONLY
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	m := workflow.NewManager(ts)
+	want := "shard test_keyspace/0 has no replica to reparent to while its master restarts"
+	if _, err := m.Create(ctx, rollingRestartFactoryName, []string{"-keyspace=" + testKeyspace}); err == nil || err.Error() != want {
+		t.Errorf("Create returned %v, want %v", err, want)
+	}
+}
+
+// TestRollingRestartPauseResume pauses the workflow, restarts the
+// manager like a vtctld restart, and resumes it.
+func TestRollingRestartPauseResume(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+
+	fw := &fakeWrangler{ts: ts}
+	uuid := startWorkflow(ctx, t, m, fw, func(uuid, alias string) {
+		if err := m.NodeManager().Action(ctx, &workflow.ActionParameters{Path: "/" + uuid, Name: actionPause}); err != nil {
+			t.Errorf("Pause failed: %v", err)
+		}
+	})
+
+	// The first tablet is restarted, then the workflow waits.
+	for len(fw.getCalls()) < 4 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got, want := fw.getCalls(), restartCalls("cell1-0000000101", "REPLICA"); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls while paused = %v, want %v", got, want)
+	}
+	cancel()
+	wg.Wait()
+
+	// A new manager resumes the workflow where it stopped, still paused.
+	m = workflow.NewManager(ts)
+	wg, _, cancel = workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	m.WaitUntilRunning()
+	w, err := m.WorkflowForTesting(uuid)
+	if err != nil {
+		t.Fatalf("the workflow was not restarted: %v", err)
+	}
+	fw = &fakeWrangler{ts: ts}
+	rw := w.(*rollingRestartWorkflow)
+	rw.mu.Lock()
+	paused := rw.paused
+	rw.wr = fw
+	rw.mu.Unlock()
+	if !paused {
+		t.Errorf("the workflow is not paused after the restart")
+	}
+	// The actions are available once the workflow runs.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		err := m.NodeManager().Action(ctx, &workflow.ActionParameters{Path: "/" + uuid, Name: actionResume})
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Resume failed: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := m.Wait(ctx, uuid); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if err := workflow.VerifyAllTasksDone(ctx, ts, uuid); err != nil {
+		t.Fatal(err)
+	}
+	if got := fw.getCalls(); len(got) == 0 || got[0] != "ChangeSlaveType cell1-0000000102 DRAINED" {
+		t.Errorf("calls after resume = %v, want them to start with the second tablet", got)
+	}
+}
+
+func TestRollingRestartAbort(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	fw := &fakeWrangler{ts: ts}
+	uuid := startWorkflow(ctx, t, m, fw, func(uuid, alias string) {
+		if err := m.NodeManager().Action(ctx, &workflow.ActionParameters{Path: "/" + uuid, Name: actionAbort}); err != nil {
+			t.Errorf("Abort failed: %v", err)
+		}
+	})
+	if err := m.Wait(ctx, uuid); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+
+	// The restart of the first tablet completes, and the workflow stops.
+	if got, want := fw.getCalls(), restartCalls("cell1-0000000101", "REPLICA"); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+	wi, err := ts.GetWorkflow(ctx, uuid)
+	if err != nil {
+		t.Fatalf("GetWorkflow failed: %v", err)
+	}
+	if wi.Error != errAborted.Error() {
+		t.Errorf("workflow error = %q, want %q", wi.Error, errAborted.Error())
+	}
+	cancel()
+	wg.Wait()
+
+	// The abort is checkpointed: if vtctld stopped before the end of the
+	// workflow, the workflow is aborted again when vtctld restarts.
+	wi.State = workflowpb.WorkflowState_Running
+	wi.Error = ""
+	if err := ts.SaveWorkflow(ctx, wi); err != nil {
+		t.Fatalf("SaveWorkflow failed: %v", err)
+	}
+	m = workflow.NewManager(ts)
+	wg, _, cancel = workflow.StartManager(m)
+	m.WaitUntilRunning()
+	w, err := m.WorkflowForTesting(uuid)
+	if err != nil {
+		t.Fatalf("the workflow was not restarted: %v", err)
+	}
+	fw = &fakeWrangler{ts: ts}
+	rw := w.(*rollingRestartWorkflow)
+	rw.mu.Lock()
+	aborted := rw.aborted
+	rw.wr = fw
+	rw.mu.Unlock()
+	if !aborted {
+		t.Errorf("the workflow is not aborted after the restart")
+	}
+	waitCtx, waitCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer waitCancel()
+	if err := m.Wait(waitCtx, uuid); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if got := fw.getCalls(); len(got) != 0 {
+		t.Errorf("calls after the restart = %v, want none", got)
+	}
+	if wi, err = ts.GetWorkflow(ctx, uuid); err != nil {
+		t.Fatalf("GetWorkflow failed: %v", err)
+	}
+	if wi.Error != errAborted.Error() {
+		t.Errorf("workflow error after the restart = %q, want %q", wi.Error, errAborted.Error())
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file stops the replication of the delayed replicas before a
+given transaction, to recover the data a bad transaction destroyed
+on the master.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	// delayedReplicaPollInterval is how often StopDelayedReplica
+	// checks the replication of the delayed replica.
+	delayedReplicaPollInterval = time.Second
+	// delayedReplicaStopMargin is the time StopDelayedReplica has
+	// to check the replication of the delayed replica and stop it,
+	// when it stops at a given time.
+	delayedReplicaStopMargin = 2 * time.Second
+)
+
+// StopDelayedReplica stops the replication of a delayed replica, either
+// just before the transaction with the GTID untilGTID, or after it
+// applied the transactions committed on the master until untilTime.
+// Exactly one of them must be set. It returns the position of the
+// replica once stopped. StartSlave resumes the replication, with the
+// delay of the tablet.
+func (wr *Wrangler) StopDelayedReplica(ctx context.Context, tabletAlias *topodatapb.TabletAlias, untilGTID string, untilTime time.Time) (string, error) {
+	if (untilGTID == "") == untilTime.IsZero() {
+		return "", fmt.Errorf("exactly one of the GTID or the time to stop at must be set")
+	}
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return "", err
+	}
+	if ti.MasterDelay <= 0 {
+		return "", fmt.Errorf("tablet %v is not a delayed replica", topoproto.TabletAliasString(tabletAlias))
+	}
+	status, err := wr.tmc.SlaveStatus(ctx, ti.Tablet)
+	if err != nil {
+		return "", err
+	}
+	pos, err := mysql.DecodePosition(status.Position)
+	if err != nil {
+		return "", err
+	}
+
+	var queries []string
+	if untilGTID != "" {
+		gtid, err := mysql.ParseGTID(pos.GTIDSet.Flavor(), untilGTID)
+		if err != nil {
+			return "", err
+		}
+		if pos.GTIDSet.ContainsGTID(gtid) {
+			return "", fmt.Errorf("tablet %v already applied the transaction %v", topoproto.TabletAliasString(tabletAlias), untilGTID)
+		}
+		queries = []string{
+			"START SLAVE IO_THREAD",
+			"CHANGE MASTER TO MASTER_DELAY = 0",
+			fmt.Sprintf("START SLAVE SQL_THREAD UNTIL SQL_BEFORE_GTIDS = '%v'", gtid),
+		}
+	} else {
+		if !untilTime.Before(time.Now()) {
+			return "", fmt.Errorf("cannot stop tablet %v at %v, which is in the future", topoproto.TabletAliasString(tabletAlias), untilTime)
+		}
+		if time.Since(untilTime) > time.Duration(ti.MasterDelay)*time.Second {
+			return "", fmt.Errorf("tablet %v already applied the transactions committed until %v, as its delay is %vs", topoproto.TabletAliasString(tabletAlias), untilTime, ti.MasterDelay)
+		}
+		queries = []string{"START SLAVE IO_THREAD"}
+	}
+
+	// Stopping the replication through the tablet keeps it from
+	// restarting it on its own.
+	wr.logger.Infof("stopping the replication of %v", topoproto.TabletAliasString(tabletAlias))
+	if err := wr.tmc.StopSlave(ctx, ti.Tablet); err != nil {
+		return "", err
+	}
+	if err := wr.executeFetchAsDbaList(ctx, ti.Tablet, queries); err != nil {
+		return "", err
+	}
+	if untilGTID != "" {
+		wr.logger.Infof("waiting for %v to stop before %v", topoproto.TabletAliasString(tabletAlias), untilGTID)
+		err = wr.waitForSQLThreadStopped(ctx, ti.Tablet)
+	} else {
+		wr.logger.Infof("waiting for %v to apply the transactions committed until %v", topoproto.TabletAliasString(tabletAlias), untilTime)
+		err = wr.applyUntilTime(ctx, ti.Tablet, untilTime)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	status, err = wr.tmc.SlaveStatus(ctx, ti.Tablet)
+	if err != nil {
+		return "", err
+	}
+	return status.Position, nil
+}
+
+// waitForSQLThreadStopped waits until the replication SQL thread of
+// the tablet reached its UNTIL condition.
+func (wr *Wrangler) waitForSQLThreadStopped(ctx context.Context, tablet *topodatapb.Tablet) error {
+	for {
+		status, err := wr.tmc.SlaveStatus(ctx, tablet)
+		if err != nil {
+			return err
+		}
+		if !status.SlaveSqlRunning {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the replication of %v to stop: %v", topoproto.TabletAliasString(tablet.Alias), ctx.Err())
+		case <-time.After(delayedReplicaPollInterval):
+		}
+	}
+}
+
+// applyUntilTime has the SQL thread of the tablet apply the transactions
+// committed until untilTime, and stops it. MySQL can't stop at a given
+// time: the SQL thread applies the transactions committed until now
+// minus the delay of the replication. So the delay is set to keep this
+// before untilTime for a little while, during which the SQL thread
+// runs, and the SQL thread is stopped before the delay runs out. This
+// is repeated until the SQL thread waits on a transaction committed
+// after untilTime, or applied all its relay logs.
+func (wr *Wrangler) applyUntilTime(ctx context.Context, tablet *topodatapb.Tablet, untilTime time.Time) error {
+	for {
+		// Past deadline, the SQL thread can apply transactions
+		// committed after untilTime.
+		delay := (time.Since(untilTime) + delayedReplicaPollInterval + delayedReplicaStopMargin + time.Second - 1) / time.Second
+		deadline := untilTime.Add(delay * time.Second)
+		queries := []string{
+			fmt.Sprintf("CHANGE MASTER TO MASTER_DELAY = %d", delay),
+			"START SLAVE SQL_THREAD",
+		}
+		if err := wr.executeFetchAsDbaList(ctx, tablet, queries); err != nil {
+			return wr.stopSQLThread(tablet, deadline, err)
+		}
+		select {
+		case <-ctx.Done():
+			return wr.stopSQLThread(tablet, deadline, fmt.Errorf("timed out waiting for the replication of %v to reach %v: %v", topoproto.TabletAliasString(tablet.Alias), untilTime, ctx.Err()))
+		case <-time.After(delayedReplicaPollInterval):
+		}
+
+		waiting := false
+		qr, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false /* usePool */, []byte("SHOW SLAVE STATUS"), 1, false /* disableBinlogs */, false /* reloadSchema */)
+		if err == nil {
+			waiting, err = sqlThreadWaiting(sqltypes.Proto3ToResult(qr))
+		}
+		if err := wr.stopSQLThread(tablet, deadline, err); err != nil {
+			return err
+		}
+		if waiting {
+			return nil
+		}
+	}
+}
+
+// stopSQLThread stops the SQL thread of the tablet, even if the
+// context of the command is done, and returns err. It returns an
+// error if the SQL thread was not stopped before deadline, since it
+// may have applied transactions committed after the time to stop at.
+func (wr *Wrangler) stopSQLThread(tablet *topodatapb.Tablet, deadline time.Time, err error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	if _, stopErr := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false /* usePool */, []byte("STOP SLAVE SQL_THREAD"), 0, false /* disableBinlogs */, false /* reloadSchema */); stopErr != nil {
+		return fmt.Errorf("cannot stop the replication of %v, it may apply transactions past the time to stop at: %v", topoproto.TabletAliasString(tablet.Alias), stopErr)
+	}
+	if time.Now().After(deadline) {
+		return fmt.Errorf("the replication of %v was stopped too late, it may have applied transactions past the time to stop at", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return err
+}
+
+// executeFetchAsDbaList runs the queries on the tablet, one at a time.
+// The replication statements don't depend on the session, so they
+// don't need to run on the same connection.
+func (wr *Wrangler) executeFetchAsDbaList(ctx context.Context, tablet *topodatapb.Tablet, queries []string) error {
+	for _, query := range queries {
+		if _, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet, false /* usePool */, []byte(query), 0, false /* disableBinlogs */, false /* reloadSchema */); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqlThreadWaiting returns true if the SHOW SLAVE STATUS result says
+// the SQL thread waits for the delay of a transaction, or for more
+// transactions.
+func sqlThreadWaiting(qr *sqltypes.Result) (bool, error) {
+	if len(qr.Rows) != 1 {
+		return false, fmt.Errorf("replication is not configured")
+	}
+	for i, field := range qr.Fields {
+		value := qr.Rows[0][i]
+		switch field.Name {
+		case "SQL_Remaining_Delay":
+			if !value.IsNull() {
+				return true, nil
+			}
+		case "Slave_SQL_Running_State":
+			if strings.Contains(value.ToString(), "read all relay log") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// delayedReplicaTMClient simulates the replication of a delayed
+// replica, and records the queries.
+type delayedReplicaTMClient struct {
+	tmclient.TabletManagerClient
+
+	status  *replicationdatapb.Status
+	stopped bool
+	queries []string
+	checks  int
+}
+
+func (tmc *delayedReplicaTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return tmc.status, nil
+}
+
+func (tmc *delayedReplicaTMClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	tmc.stopped = true
+	return nil
+}
+
+func (tmc *delayedReplicaTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.queries = append(tmc.queries, string(query))
+	if strings.Contains(string(query), "UNTIL SQL_BEFORE_GTIDS") {
+		tmc.status.SlaveSqlRunning = false
+	}
+	if string(query) == "SHOW SLAVE STATUS" {
+		// The SQL thread waits for the delay of a transaction
+		// from the second check.
+		tmc.checks++
+		remainingDelay := sqltypes.NULL
+		if tmc.checks > 1 {
+			remainingDelay = sqltypes.NewInt64(5)
+		}
+		return sqltypes.ResultToProto3(&sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Name: "Slave_SQL_Running_State", Type: sqltypes.VarChar},
+				{Name: "SQL_Remaining_Delay", Type: sqltypes.Int64},
+			},
+			Rows: [][]sqltypes.Value{{sqltypes.NewVarChar("Reading event from the relay log"), remainingDelay}},
+		}), nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func newDelayedReplicaTestEnv(t *testing.T, masterDelay int32) (*Wrangler, *delayedReplicaTMClient, *topodatapb.TabletAlias) {
+	t.Helper()
+	delayedReplicaPollInterval = time.Millisecond
+	ts := memorytopo.NewServer("cell1")
+	tablet := &topodatapb.Tablet{
+		Alias:       &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace:    "ks",
+		Shard:       "0",
+		Type:        topodatapb.TabletType_REPLICA,
+		MasterDelay: masterDelay,
+	}
+	if err := ts.CreateTablet(context.Background(), tablet); err != nil {
+		t.Fatal(err)
+	}
+	tmc := &delayedReplicaTMClient{
+		status: &replicationdatapb.Status{
+			Position:        replicationTestPosition(t, replicationTestSID1+":1-90"),
+			SlaveIoRunning:  true,
+			SlaveSqlRunning: true,
+		},
+	}
+	return New(logutil.NewConsoleLogger(), ts, tmc), tmc, tablet.Alias
+}
+
+func TestStopDelayedReplicaUntilGTID(t *testing.T) {
+	wr, tmc, alias := newDelayedReplicaTestEnv(t, 3600)
+	ctx := context.Background()
+
+	if _, err := wr.StopDelayedReplica(ctx, alias, replicationTestSID1+":50", time.Time{}); err == nil || !strings.Contains(err.Error(), "already applied") {
+		t.Errorf("StopDelayedReplica(applied GTID) = %v, want already applied", err)
+	}
+
+	position, err := wr.StopDelayedReplica(ctx, alias, replicationTestSID1+":95", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if position != tmc.status.Position {
+		t.Errorf("StopDelayedReplica() = %v, want %v", position, tmc.status.Position)
+	}
+	if !tmc.stopped {
+		t.Errorf("StopSlave was not called")
+	}
+	want := []string{
+		"START SLAVE IO_THREAD",
+		"CHANGE MASTER TO MASTER_DELAY = 0",
+		"START SLAVE SQL_THREAD UNTIL SQL_BEFORE_GTIDS = '" + replicationTestSID1 + ":95'",
+	}
+	if !reflect.DeepEqual(tmc.queries, want) {
+		t.Errorf("queries = %q, want %q", tmc.queries, want)
+	}
+}
+
+func TestStopDelayedReplicaUntilTime(t *testing.T) {
+	wr, tmc, alias := newDelayedReplicaTestEnv(t, 3600)
+	ctx := context.Background()
+
+	if _, err := wr.StopDelayedReplica(ctx, alias, "", time.Now().Add(-2*time.Hour)); err == nil || !strings.Contains(err.Error(), "already applied") {
+		t.Errorf("StopDelayedReplica(old time) = %v, want already applied", err)
+	}
+
+	if _, err := wr.StopDelayedReplica(ctx, alias, "", time.Now().Add(-10*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	// The SQL thread is stopped after each check.
+	want := []string{
+		"START SLAVE IO_THREAD",
+		"CHANGE MASTER TO MASTER_DELAY = 60",
+		"START SLAVE SQL_THREAD",
+		"SHOW SLAVE STATUS",
+		"STOP SLAVE SQL_THREAD",
+		"CHANGE MASTER TO MASTER_DELAY = 60",
+		"START SLAVE SQL_THREAD",
+		"SHOW SLAVE STATUS",
+		"STOP SLAVE SQL_THREAD",
+	}
+	if len(tmc.queries) != len(want) {
+		t.Fatalf("queries = %q, want %q", tmc.queries, want)
+	}
+	for i, query := range tmc.queries {
+		// The delay is about 10 minutes.
+		if !strings.HasPrefix(query, want[i]) {
+			t.Errorf("query %v = %q, want %q", i, query, want[i])
+		}
+	}
+}
+
+func TestStopDelayedReplicaUntilTimeStopsInTime(t *testing.T) {
+	wr, tmc, alias := newDelayedReplicaTestEnv(t, 3600)
+	defer func() {
+		delayedReplicaStopMargin = 2 * time.Second
+	}()
+
+	// The SQL thread is stopped past the delay, so it may have applied
+	// transactions committed after the time to stop at.
+	delayedReplicaStopMargin = -2 * time.Second
+	_, err := wr.StopDelayedReplica(context.Background(), alias, "", time.Now().Add(-10*time.Minute))
+	if err == nil || !strings.Contains(err.Error(), "stopped too late") {
+		t.Errorf("StopDelayedReplica() = %v, want stopped too late", err)
+	}
+	if got := tmc.queries[len(tmc.queries)-1]; got != "STOP SLAVE SQL_THREAD" {
+		t.Errorf("last query = %q, want the SQL thread stopped", got)
+	}
+}
+
+func TestStopDelayedReplicaNotDelayed(t *testing.T) {
+	wr, _, alias := newDelayedReplicaTestEnv(t, 0)
+	if _, err := wr.StopDelayedReplica(context.Background(), alias, replicationTestSID1+":95", time.Time{}); err == nil {
+		t.Errorf("StopDelayedReplica() on a replica without delay should have failed")
+	}
+}
@@ -90,6 +90,53 @@ func (wr *Wrangler) SetKeyspaceShardingInfo(ctx context.Context, keyspace, shard
 	return wr.ts.UpdateKeyspace(ctx, ki)
 }
 
+// SetKeyspaceDurabilityPolicy locks a keyspace and sets its
+// DurabilityPolicy. The shards that violate the new policy are logged.
+func (wr *Wrangler) SetKeyspaceDurabilityPolicy(ctx context.Context, keyspace, policy string) (err error) {
+	if err := topotools.ValidateDurabilityPolicy(policy); err != nil {
+		return err
+	}
+
+	// Lock the keyspace
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceDurabilityPolicy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// and change it
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.DurabilityPolicy = policy
+	if err := wr.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return err
+	}
+
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	for _, si := range shards {
+		if !si.HasMaster() {
+			continue
+		}
+		tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, si.ShardName())
+		if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+			return err
+		}
+		master, ok := tabletMap[topoproto.TabletAliasString(si.MasterAlias)]
+		if !ok {
+			continue
+		}
+		if err := topotools.CheckPromotable(policy, master.Tablet, tabletMap); err != nil {
+			wr.Logger().Warningf("shard %v/%v violates the new durability policy: %v", keyspace, si.ShardName(), err)
+		}
+	}
+	return nil
+}
+
 // validateNewWorkflow ensures that the specified workflow doesn't already exist
 // in the keyspace.
 func (wr *Wrangler) validateNewWorkflow(ctx context.Context, keyspace, workflow string) error {
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ApplyMysqlVariables changes global variables of the mysqld of all the
+// tablets of a shard, or of all the shards of a keyspace if shard is
+// empty, and persists them in their my.cnf. The tablets are changed one
+// at a time, the masters last, and it stops at the first error.
+// It returns the aliases of the tablets which need a restart of mysqld
+// to apply some of the variables, with the names of these variables.
+func (wr *Wrangler) ApplyMysqlVariables(ctx context.Context, keyspace, shard string, variables map[string]string, allowRestart bool) (map[string][]string, error) {
+	shards := []string{shard}
+	if shard == "" {
+		var err error
+		shards, err = wr.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(shards)
+	}
+
+	var tablets []*topo.TabletInfo
+	for _, shard := range shards {
+		tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot read the tablets of %v/%v", keyspace, shard)
+		}
+		for _, ti := range tabletMap {
+			tablets = append(tablets, ti)
+		}
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		iMaster := tablets[i].Type == topodatapb.TabletType_MASTER
+		jMaster := tablets[j].Type == topodatapb.TabletType_MASTER
+		if iMaster != jMaster {
+			return jMaster
+		}
+		return topoproto.TabletAliasString(tablets[i].Alias) < topoproto.TabletAliasString(tablets[j].Alias)
+	})
+
+	restartRequired := make(map[string][]string)
+	for _, ti := range tablets {
+		alias := topoproto.TabletAliasString(ti.Alias)
+		previous, names, err := wr.tmc.ApplyMysqlVariables(ctx, ti.Tablet, variables, allowRestart)
+		if err != nil {
+			return restartRequired, vterrors.Wrapf(err, "cannot apply the MySQL variables to %v", alias)
+		}
+		wr.Logger().Infof("Applied the MySQL variables to %v, previous values: %v", alias, previous)
+		if len(names) > 0 {
+			restartRequired[alias] = names
+		}
+	}
+	return restartRequired, nil
+}
@@ -49,6 +49,15 @@ const (
 	tabletExternallyReparentedOperation = "TabletExternallyReparented"
 )
 
+// durabilityPolicy returns the durability policy of a keyspace.
+func (wr *Wrangler) durabilityPolicy(ctx context.Context, keyspace string) (string, error) {
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the durability policy of keyspace %v: %v", keyspace, err)
+	}
+	return ki.DurabilityPolicy, nil
+}
+
 // ShardReplicationStatuses returns the ReplicationStatus for each tablet in a shard.
 func (wr *Wrangler) ShardReplicationStatuses(ctx context.Context, keyspace, shard string) ([]*topo.TabletInfo, []*replicationdatapb.Status, error) {
 	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
@@ -175,6 +184,9 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 		return fmt.Errorf("master-elect tablet %v is not in the shard", topoproto.TabletAliasString(masterElectTabletAlias))
 	}
 	ev.NewMaster = *masterElectTabletInfo.Tablet
+	if masterElectTabletInfo.MasterDelay > 0 {
+		return fmt.Errorf("master-elect tablet %v is a delayed replica, it cannot be promoted", masterElectTabletAliasStr)
+	}
 
 	// Check the master is the only master is the shard, or -force was used.
 	_, masterTabletMap := topotools.SortedTabletMap(tabletMap)
@@ -378,6 +390,11 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 		return err
 	}
 
+	policy, err := wr.durabilityPolicy(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+
 	// Check invariants we're going to depend on.
 	if topoproto.TabletAliasEqual(masterElectTabletAlias, avoidMasterTabletAlias) {
 		return fmt.Errorf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias))
@@ -388,7 +405,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 			return nil
 		}
 		event.DispatchUpdate(ev, "searching for master candidate")
-		masterElectTabletAlias, err = wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterTabletAlias, waitReplicasTimeout)
+		masterElectTabletAlias, err = wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterTabletAlias, policy, waitReplicasTimeout)
 		if err != nil {
 			return err
 		}
@@ -407,6 +424,9 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 	if topoproto.TabletAliasIsZero(shardInfo.MasterAlias) {
 		return fmt.Errorf("the shard has no master, use EmergencyReparentShard")
 	}
+	if err := topotools.CheckPromotable(policy, masterElectTabletInfo.Tablet, tabletMap); err != nil {
+		return err
+	}
 
 	// Find the current master (if any) based on the tablet states. We no longer
 	// trust the shard record for this, because it is updated asynchronously.
@@ -780,7 +800,8 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 // chooseNewMaster finds a tablet that is going to become master after reparent. The criteria
 // for the new master-elect are (preferably) to be in the same cell as the current master, and
 // to be different from avoidMasterTabletAlias. The tablet with the largest replication
-// position is chosen to minimize the time of catching up with the master. Note that the search
+// position is chosen to minimize the time of catching up with the master. The tablets that
+// can't be promoted under the durability policy of the keyspace are skipped. Note that the search
 // for largest replication position will race with transactions being executed on the master at
 // the same time, so when all tablets are roughly at the same position then the choice of the
 // new master-elect will be somewhat unpredictable.
@@ -789,6 +810,7 @@ func (wr *Wrangler) chooseNewMaster(
 	shardInfo *topo.ShardInfo,
 	tabletMap map[string]*topo.TabletInfo,
 	avoidMasterTabletAlias *topodatapb.TabletAlias,
+	policy string,
 	waitReplicasTimeout time.Duration) (*topodatapb.TabletAlias, error) {
 
 	if avoidMasterTabletAlias == nil {
@@ -809,7 +831,8 @@ func (wr *Wrangler) chooseNewMaster(
 	for _, tabletInfo := range tabletMap {
 		if (masterCell != "" && tabletInfo.Alias.Cell != masterCell) ||
 			topoproto.TabletAliasEqual(tabletInfo.Alias, avoidMasterTabletAlias) ||
-			tabletInfo.Tablet.Type != topodatapb.TabletType_REPLICA {
+			tabletInfo.Tablet.Type != topodatapb.TabletType_REPLICA ||
+			topotools.CheckPromotable(policy, tabletInfo.Tablet, tabletMap) != nil {
 			continue
 		}
 		maxPosSearch.waitGroup.Add(1)
@@ -869,6 +892,21 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
 		return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
 	}
+	policy, err := wr.durabilityPolicy(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	// The old master is gone, it won't acknowledge the writes of the
+	// master-elect.
+	ackers := make(map[string]*topo.TabletInfo, len(tabletMap))
+	for alias, ti := range tabletMap {
+		if !topoproto.TabletAliasEqual(ti.Alias, shardInfo.MasterAlias) {
+			ackers[alias] = ti
+		}
+	}
+	if err := topotools.CheckPromotable(policy, masterElectTabletInfo.Tablet, ackers); err != nil {
+		return err
+	}
 
 	// Deal with the old master: try to remote-scrap it, if it's
 	// truly dead we force-scrap it. Remove it from our map in any case.
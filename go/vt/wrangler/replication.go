@@ -0,0 +1,322 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file finds and repairs the replicas that don't replicate properly
+from the master of their shard.
+*/
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The replication problems found by ValidateReplication.
+const (
+	// ReplicationUnreachable is a tablet that can't return its
+	// replication status.
+	ReplicationUnreachable = "unreachable"
+	// ReplicationWrongMaster is a tablet that replicates from
+	// another server than the master of its shard.
+	ReplicationWrongMaster = "wrong_master"
+	// ReplicationNotRunning is a tablet with a stopped
+	// replication thread.
+	ReplicationNotRunning = "not_replicating"
+	// ReplicationErrantGTIDs is a tablet that executed
+	// transactions the master doesn't have.
+	ReplicationErrantGTIDs = "errant_gtids"
+)
+
+// maxInjectedGTIDs is the maximum number of empty transactions
+// RepairReplication injects on the master for a tablet.
+const maxInjectedGTIDs = 10000
+
+// ReplicationProblem is a problem with the replication of a tablet.
+type ReplicationProblem struct {
+	Tablet     string
+	TabletType string
+	Problem    string
+	Details    string
+	// ErrantGTIDs are the errant transactions of the tablet, for
+	// the errant_gtids problem. It is only set for the MySQL 5.6+
+	// GTID flavor, the errant transactions can't be listed for the
+	// other flavors.
+	ErrantGTIDs string `json:",omitempty"`
+	// errant is ErrantGTIDs, for RepairReplication.
+	errant mysql.Mysql56GTIDSet
+	// Repair is the action RepairReplication took, if any.
+	Repair string `json:",omitempty"`
+}
+
+// ValidateReplication checks that all the replicas of a shard
+// replicate from its master, and don't have errant transactions.
+// The delayed replicas are not checked: their replication is stopped
+// on purpose to recover data, see StopDelayedReplica.
+func (wr *Wrangler) ValidateReplication(ctx context.Context, keyspace, shard string) ([]*ReplicationProblem, error) {
+	_, _, problems, err := wr.findReplicationProblems(ctx, keyspace, shard)
+	return problems, err
+}
+
+// RepairReplication fixes the problems found by ValidateReplication:
+//   - the tablets that replicate from the wrong server, or don't
+//     replicate, are pointed at the master and their replication is
+//     started.
+//   - if injectEmptyTransactions is set, the errant transactions of the
+//     tablets are injected as empty transactions on the master, so they
+//     are part of the history of the shard. The changes made by these
+//     transactions stay on the tablets. Only the MySQL 5.6+ GTID flavor
+//     supports it.
+//   - otherwise, if quarantine is set, the tablets with errant
+//     transactions are changed to DRAINED, so they stop serving
+//     until they are restored from a backup.
+//
+// It returns the problems found, with the repair made for each.
+// The problems that have no repair are not fixed.
+func (wr *Wrangler) RepairReplication(ctx context.Context, keyspace, shard string, injectEmptyTransactions, quarantine bool) (problems []*ReplicationProblem, err error) {
+	// Lock the shard, so a reparent doesn't change the master
+	// while we point the tablets at it.
+	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, "RepairReplication")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	master, tabletMap, problems, err := wr.findReplicationProblems(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fix the errant transactions first, so the tablets that are
+	// quarantined are not pointed at the master.
+	quarantined := make(map[string]bool)
+	injected := mysql.Mysql56GTIDSet{}
+	for _, p := range problems {
+		if p.Problem != ReplicationErrantGTIDs {
+			continue
+		}
+		ti := tabletMap[p.Tablet]
+		switch {
+		case injectEmptyTransactions:
+			if p.errant == nil {
+				wr.logger.Warningf("cannot inject the errant transactions of %v, they are only known with MySQL 5.6+ GTIDs", p.Tablet)
+				continue
+			}
+			// Several tablets can have the same errant transactions.
+			pending := p.errant.Difference(injected)
+			count, err := wr.injectEmptyTransactions(ctx, master.Tablet, pending)
+			if err != nil {
+				wr.logger.Errorf("cannot inject the errant transactions of %v on master %v: %v", p.Tablet, master.AliasString(), err)
+				continue
+			}
+			injected = injected.Union(pending)
+			p.Repair = fmt.Sprintf("injected %v empty transactions on master %v", count, master.AliasString())
+		case quarantine:
+			if err := wr.tmc.ChangeType(ctx, ti.Tablet, topodatapb.TabletType_DRAINED); err != nil {
+				wr.logger.Errorf("cannot change %v to DRAINED: %v", p.Tablet, err)
+				continue
+			}
+			quarantined[p.Tablet] = true
+			p.Repair = "changed to DRAINED"
+		}
+	}
+
+	// Point the tablets at the master, once per tablet.
+	repointed := make(map[string]string)
+	for _, p := range problems {
+		if (p.Problem != ReplicationWrongMaster && p.Problem != ReplicationNotRunning) || quarantined[p.Tablet] {
+			continue
+		}
+		if repair, ok := repointed[p.Tablet]; ok {
+			p.Repair = repair
+			continue
+		}
+		repointed[p.Tablet] = ""
+		ti := tabletMap[p.Tablet]
+		wr.logger.Infof("pointing %v at master %v", p.Tablet, master.AliasString())
+		if err := wr.tmc.SetMaster(ctx, ti.Tablet, master.Alias, 0, "", true /* forceStartSlave */); err != nil {
+			wr.logger.Errorf("cannot point %v at master %v: %v", p.Tablet, master.AliasString(), err)
+			continue
+		}
+		p.Repair = fmt.Sprintf("replicating from master %v", master.AliasString())
+		repointed[p.Tablet] = p.Repair
+	}
+	return problems, nil
+}
+
+// findReplicationProblems returns the master and the tablets of a
+// shard, and the replication problems of the tablets.
+func (wr *Wrangler) findReplicationProblems(ctx context.Context, keyspace, shard string) (*topo.TabletInfo, map[string]*topo.TabletInfo, []*ReplicationProblem, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !si.HasMaster() {
+		return nil, nil, nil, fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return nil, nil, nil, err
+	}
+	master, ok := tabletMap[topoproto.TabletAliasString(si.MasterAlias)]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("master %v of shard %v/%v is not in the topology", topoproto.TabletAliasString(si.MasterAlias), keyspace, shard)
+	}
+
+	// Get the status of the replicas before the position of the
+	// master, so the replicas can't be ahead of the master.
+	var problems []*ReplicationProblem
+	statuses := make(map[string]*replicationdatapb.Status)
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for alias, ti := range tabletMap {
+		if !ti.IsSlaveType() || ti.MasterDelay > 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(alias string, ti *topo.TabletInfo) {
+			defer wg.Done()
+			status, err := wr.tmc.SlaveStatus(ctx, ti.Tablet)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				problems = append(problems, newReplicationProblem(ti, ReplicationUnreachable, fmt.Sprintf("cannot get the replication status: %v", err)))
+				return
+			}
+			statuses[alias] = status
+		}(alias, ti)
+	}
+	wg.Wait()
+
+	masterPosStr, err := wr.tmc.MasterPosition(ctx, master.Tablet)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot get the position of master %v: %v", master.AliasString(), err)
+	}
+	masterPos, err := mysql.DecodePosition(masterPosStr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot decode the position %v of master %v: %v", masterPosStr, master.AliasString(), err)
+	}
+
+	for alias, status := range statuses {
+		ti := tabletMap[alias]
+		if !sameMysqlServer(status.MasterHost, status.MasterPort, master.Tablet) {
+			problems = append(problems, newReplicationProblem(ti, ReplicationWrongMaster, fmt.Sprintf("replicates from %v:%v instead of master %v (%v)", status.MasterHost, status.MasterPort, master.AliasString(), topoproto.MysqlAddr(master.Tablet))))
+		}
+		if !status.SlaveIoRunning || !status.SlaveSqlRunning {
+			problems = append(problems, newReplicationProblem(ti, ReplicationNotRunning, fmt.Sprintf("io thread running: %v, sql thread running: %v", status.SlaveIoRunning, status.SlaveSqlRunning)))
+		}
+		pos, err := mysql.DecodePosition(status.Position)
+		if err != nil {
+			problems = append(problems, newReplicationProblem(ti, ReplicationUnreachable, fmt.Sprintf("cannot decode the position %v: %v", status.Position, err)))
+			continue
+		}
+		if p := errantProblem(ti, pos, masterPos); p != nil {
+			problems = append(problems, p)
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		if problems[i].Tablet != problems[j].Tablet {
+			return problems[i].Tablet < problems[j].Tablet
+		}
+		return problems[i].Problem < problems[j].Problem
+	})
+	return master, tabletMap, problems, nil
+}
+
+func newReplicationProblem(ti *topo.TabletInfo, problem, details string) *ReplicationProblem {
+	return &ReplicationProblem{
+		Tablet:     ti.AliasString(),
+		TabletType: ti.Type.String(),
+		Problem:    problem,
+		Details:    details,
+	}
+}
+
+// errantProblem returns the errant_gtids problem of a tablet,
+// or nil if the master has all the transactions of the tablet.
+func errantProblem(ti *topo.TabletInfo, pos, masterPos mysql.Position) *ReplicationProblem {
+	gtids, ok := pos.GTIDSet.(mysql.Mysql56GTIDSet)
+	masterGTIDs, masterOK := masterPos.GTIDSet.(mysql.Mysql56GTIDSet)
+	if ok && masterOK {
+		errant := gtids.Difference(masterGTIDs)
+		if len(errant) == 0 {
+			return nil
+		}
+		p := newReplicationProblem(ti, ReplicationErrantGTIDs, fmt.Sprintf("has transactions the master doesn't have: %v", errant))
+		p.ErrantGTIDs = errant.String()
+		p.errant = errant
+		return p
+	}
+	if masterPos.AtLeast(pos) {
+		return nil
+	}
+	return newReplicationProblem(ti, ReplicationErrantGTIDs, fmt.Sprintf("position %v is not contained in the master position %v", pos, masterPos))
+}
+
+// injectEmptyTransactions has the master commit an empty transaction
+// for each of the GTIDs of the set. It returns the number of
+// transactions it injected. The set is not expanded if it has too
+// many transactions.
+func (wr *Wrangler) injectEmptyTransactions(ctx context.Context, master *topodatapb.Tablet, set mysql.Mysql56GTIDSet) (int64, error) {
+	count := set.Count()
+	if count > maxInjectedGTIDs {
+		return 0, fmt.Errorf("%v errant transactions, more than the %v that can be injected", count, maxInjectedGTIDs)
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	if err := wr.tmc.InjectEmptyTransactions(ctx, master, set.String()); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// sameMysqlServer returns true if host:port is the MySQL server of
+// the tablet. The host can be a name or an IP address.
+func sameMysqlServer(host string, port int32, tablet *topodatapb.Tablet) bool {
+	if port != topoproto.MysqlPort(tablet) {
+		return false
+	}
+	if host == topoproto.MysqlHostname(tablet) {
+		return true
+	}
+	ip, err := topoproto.MySQLIP(tablet)
+	if err != nil {
+		return false
+	}
+	addrs, err := net.LookupHost(host)
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if normalizeIP(addr) == normalizeIP(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	replicationTestSID1 = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	replicationTestSID2 = "00010203-0405-0607-0809-0a0b0c0d0e10"
+)
+
+// replicationTMClient returns the replication status of the tablets,
+// and records the repairs.
+type replicationTMClient struct {
+	tmclient.TabletManagerClient
+
+	masterPosition string
+	statuses       map[string]*replicationdatapb.Status
+
+	mu         sync.Mutex
+	setMasters []string
+	drained    []string
+	injected   []string
+}
+
+func (tmc *replicationTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	status, ok := tmc.statuses[topoproto.TabletAliasString(tablet.Alias)]
+	if !ok {
+		return nil, fmt.Errorf("tablet is down")
+	}
+	return status, nil
+}
+
+func (tmc *replicationTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return tmc.masterPosition, nil
+}
+
+func (tmc *replicationTMClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartSlave bool) error {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.setMasters = append(tmc.setMasters, topoproto.TabletAliasString(tablet.Alias))
+	return nil
+}
+
+func (tmc *replicationTMClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, tabletType topodatapb.TabletType) error {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.drained = append(tmc.drained, topoproto.TabletAliasString(tablet.Alias))
+	return nil
+}
+
+func (tmc *replicationTMClient) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.injected = append(tmc.injected, topoproto.TabletAliasString(tablet.Alias)+" "+gtids)
+	return nil
+}
+
+func replicationTestPosition(t *testing.T, gtids string) string {
+	t.Helper()
+	pos, err := mysql.ParsePosition("MySQL56", gtids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mysql.EncodePosition(pos)
+}
+
+func newReplicationTestEnv(t *testing.T) (*Wrangler, *replicationTMClient) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	types := []topodatapb.TabletType{topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA, topodatapb.TabletType_REPLICA}
+	for i, tabletType := range types {
+		tablet := &topodatapb.Tablet{
+			Alias:         &topodatapb.TabletAlias{Cell: "cell1", Uid: uint32(100 + i)},
+			Hostname:      fmt.Sprintf("host%v", i),
+			MysqlHostname: fmt.Sprintf("host%v", i),
+			MysqlPort:     3306,
+			Keyspace:      "ks",
+			Shard:         "0",
+			Type:          tabletType,
+		}
+		if i == 5 {
+			tablet.MasterDelay = 3600
+		}
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tmc := &replicationTMClient{
+		masterPosition: replicationTestPosition(t, replicationTestSID1+":1-100"),
+		statuses: map[string]*replicationdatapb.Status{
+			// Healthy, and a bit behind.
+			"cell1-0000000101": {
+				Position:        replicationTestPosition(t, replicationTestSID1+":1-90"),
+				SlaveIoRunning:  true,
+				SlaveSqlRunning: true,
+				MasterHost:      "host0",
+				MasterPort:      3306,
+			},
+			// Replicates from another tablet, and has errant transactions.
+			"cell1-0000000102": {
+				Position:        replicationTestPosition(t, replicationTestSID1+":1-100,"+replicationTestSID2+":1-3"),
+				SlaveIoRunning:  true,
+				SlaveSqlRunning: true,
+				MasterHost:      "host4",
+				MasterPort:      3306,
+			},
+			// Stopped replication.
+			"cell1-0000000103": {
+				Position:        replicationTestPosition(t, replicationTestSID1+":1-50"),
+				SlaveIoRunning:  false,
+				SlaveSqlRunning: true,
+				MasterHost:      "host0",
+				MasterPort:      3306,
+			},
+			// cell1-0000000104 is unreachable.
+			// Delayed replica stopped to recover data.
+			"cell1-0000000105": {
+				Position:        replicationTestPosition(t, replicationTestSID1+":1-50"),
+				SlaveIoRunning:  true,
+				SlaveSqlRunning: false,
+				MasterHost:      "host0",
+				MasterPort:      3306,
+			},
+		},
+	}
+	return New(logutil.NewConsoleLogger(), ts, tmc), tmc
+}
+
+func problemSummary(problems []*ReplicationProblem) []string {
+	var result []string
+	for _, p := range problems {
+		result = append(result, fmt.Sprintf("%v %v %v %v", p.Tablet, p.Problem, p.ErrantGTIDs, p.Repair))
+	}
+	return result
+}
+
+func TestValidateReplication(t *testing.T) {
+	wr, _ := newReplicationTestEnv(t)
+	problems, err := wr.ValidateReplication(context.Background(), "ks", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"cell1-0000000102 errant_gtids " + replicationTestSID2 + ":1-3 ",
+		"cell1-0000000102 wrong_master  ",
+		"cell1-0000000103 not_replicating  ",
+		"cell1-0000000104 unreachable  ",
+	}
+	if got := problemSummary(problems); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateReplication() = %q, want %q", got, want)
+	}
+}
+
+func TestRepairReplicationInject(t *testing.T) {
+	wr, tmc := newReplicationTestEnv(t)
+	problems, err := wr.RepairReplication(context.Background(), "ks", "0", true /* injectEmptyTransactions */, false /* quarantine */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"cell1-0000000102 errant_gtids " + replicationTestSID2 + ":1-3 injected 3 empty transactions on master cell1-0000000100",
+		"cell1-0000000102 wrong_master  replicating from master cell1-0000000100",
+		"cell1-0000000103 not_replicating  replicating from master cell1-0000000100",
+		"cell1-0000000104 unreachable  ",
+	}
+	if got := problemSummary(problems); !reflect.DeepEqual(got, want) {
+		t.Errorf("RepairReplication() = %q, want %q", got, want)
+	}
+	if got, want := tmc.setMasters, []string{"cell1-0000000102", "cell1-0000000103"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SetMaster calls = %v, want %v", got, want)
+	}
+	if got, want := tmc.injected, []string{"cell1-0000000100 " + replicationTestSID2 + ":1-3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InjectEmptyTransactions calls = %v, want %v", got, want)
+	}
+}
+
+func TestRepairReplicationInjectTooMany(t *testing.T) {
+	wr, tmc := newReplicationTestEnv(t)
+	// The errant transactions are counted without being listed.
+	tmc.statuses["cell1-0000000102"].Position = replicationTestPosition(t, replicationTestSID1+":1-100,"+replicationTestSID2+":1-1000000000000")
+	problems, err := wr.RepairReplication(context.Background(), "ks", "0", true /* injectEmptyTransactions */, false /* quarantine */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := problemSummary(problems)[0], "cell1-0000000102 errant_gtids "+replicationTestSID2+":1-1000000000000 "; got != want {
+		t.Errorf("RepairReplication() = %q, want %q", got, want)
+	}
+	if len(tmc.injected) != 0 {
+		t.Errorf("InjectEmptyTransactions calls = %v, want none", tmc.injected)
+	}
+}
+
+func TestRepairReplicationQuarantine(t *testing.T) {
+	wr, tmc := newReplicationTestEnv(t)
+	if _, err := wr.RepairReplication(context.Background(), "ks", "0", false /* injectEmptyTransactions */, true /* quarantine */); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tmc.drained, []string{"cell1-0000000102"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangeType calls = %v, want %v", got, want)
+	}
+	// The quarantined tablet is not pointed at the master.
+	if got, want := tmc.setMasters, []string{"cell1-0000000103"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SetMaster calls = %v, want %v", got, want)
+	}
+	if len(tmc.injected) != 0 {
+		t.Errorf("InjectEmptyTransactions calls = %v, want none", tmc.injected)
+	}
+}
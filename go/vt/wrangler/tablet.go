@@ -18,14 +18,17 @@ package wrangler
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -209,6 +212,70 @@ func (wr *Wrangler) VReplicationExec(ctx context.Context, tabletAlias *topodatap
 	return wr.tmc.VReplicationExec(ctx, ti.Tablet, query)
 }
 
+// RestartMysqld stops and starts the mysqld of a tablet, running the
+// upgrade hook, if not empty, while it is stopped.
+func (wr *Wrangler) RestartMysqld(ctx context.Context, tabletAlias *topodatapb.TabletAlias, upgradeHook string) error {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return wr.tmc.RestartMysqld(ctx, ti.Tablet, upgradeHook)
+}
+
+// WaitForTabletHealthy waits until a tablet reports itself healthy,
+// serving if its type serves queries, and replicating with a lag of
+// at most maxReplicationLag. Delayed replicas never serve queries, so
+// they only need to be healthy. It waits until ctx is done.
+func (wr *Wrangler) WaitForTabletHealthy(ctx context.Context, tabletAlias *topodatapb.TabletAlias, maxReplicationLag time.Duration) error {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	wantServing := topo.IsRunningQueryService(ti.Type) && ti.MasterDelay <= 0
+
+	// The health records are not final while mysqld starts, or the
+	// tablet server changes its state: they are streamed until one
+	// passes, and the stream is opened again if it breaks.
+	lastSeen := "no health record"
+	for {
+		// Run an explicit healthcheck first to not see an outdated record.
+		if err := wr.tmc.RunHealthCheck(ctx, ti.Tablet); err != nil {
+			lastSeen = fmt.Sprintf("explicit healthcheck failed: %v", err)
+		} else if conn, err := tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false)); err != nil {
+			lastSeen = fmt.Sprintf("cannot connect: %v", err)
+		} else {
+			err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+				stats := shr.RealtimeStats
+				switch {
+				case stats == nil:
+					lastSeen = "health record does not include RealtimeStats message"
+				case stats.HealthError != "":
+					lastSeen = fmt.Sprintf("tablet is not healthy: %v", stats.HealthError)
+				case wantServing && !shr.Serving:
+					lastSeen = "tablet is not serving"
+				case time.Duration(stats.SecondsBehindMaster)*time.Second > maxReplicationLag:
+					lastSeen = fmt.Sprintf("replication lag is %v seconds", stats.SecondsBehindMaster)
+				default:
+					return io.EOF
+				}
+				return nil
+			})
+			conn.Close(ctx)
+			if err == nil {
+				wr.Logger().Infof("Tablet %v is healthy", topoproto.TabletAliasString(tabletAlias))
+				return nil
+			}
+		}
+
+		wr.Logger().Infof("Waiting for tablet %v to be healthy: %v", topoproto.TabletAliasString(tabletAlias), lastSeen)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("tablet %v is not healthy: %v: %v", topoproto.TabletAliasString(tabletAlias), lastSeen, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
 // isMasterTablet is a shortcut way to determine whether the current tablet
 // is a master before we allow its tablet record to be deleted. The canonical
 // way to determine the only true master in a shard is to list all the tablets
@@ -0,0 +1,61 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+// TestAuditVtctl checks the changes made by remote vtctl commands
+// are recorded with their caller and command.
+func TestAuditVtctl(t *testing.T) {
+	if err := flag.Set("topo_audit_sink", "topo"); err != nil {
+		t.Fatalf("cannot set -topo_audit_sink: %v", err)
+	}
+	defer flag.Set("topo_audit_sink", "")
+
+	ts := memorytopo.NewServer("cell1")
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	if err := vp.Run([]string{"CreateKeyspace", "-sharding_column_name", "id", "ks"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+
+	records, err := ts.GetAuditRecords(context.Background(), "ks", "", time.Time{})
+	if err != nil || len(records) == 0 {
+		t.Fatalf("GetAuditRecords(ks): %v, %v, want records", records, err)
+	}
+	record := records[0]
+	if record.Kind != topo.AuditKeyspace || record.Action != topo.AuditCreate {
+		t.Errorf("got %v %v first, want the creation of the keyspace", record.Action, record.Kind)
+	}
+	// The caller is the address of the vtctl client.
+	if record.Caller == "" {
+		t.Errorf("no caller in %v", record)
+	}
+	if want := "CreateKeyspace -sharding_column_name"; record.Command != want {
+		t.Errorf("got command %q, want %q", record.Command, want)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
@@ -650,3 +651,57 @@ func TestPlannedReparentShardRelayLogErrorStartSlave(t *testing.T) {
 	}
 
 }
+
+func TestPlannedReparentShardDurabilityPolicy(t *testing.T) {
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Create a master, a replica in the same cell and an rdonly
+	// in another cell.
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	replica := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_RDONLY, nil)
+
+	if _, err := ts.UpdateShardFields(context.Background(), master.Tablet.Keyspace, master.Tablet.Shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Tablet.Alias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", master.Tablet.Keyspace, "semi_sync"}); err != nil {
+		t.Fatalf("SetKeyspaceDurabilityPolicy failed: %v", err)
+	}
+
+	// The old master is demoted to REPLICA and acks the writes of the
+	// replica, so the durability policy doesn't refuse the reparent.
+	// It fails later on, since the replica doesn't run its action loop.
+	master.StartActionLoop(t, wr)
+	defer master.StopActionLoop(t)
+	err := vp.Run([]string{"PlannedReparentShard", "-wait_slave_timeout", "1s", "-keyspace_shard", master.Tablet.Keyspace + "/" + master.Tablet.Shard, "-new_master", topoproto.TabletAliasString(replica.Tablet.Alias)})
+	if err == nil || !strings.Contains(err.Error(), "did not catch up in time") {
+		t.Errorf("PlannedReparentShard returned %v, want a replication error", err)
+	}
+
+	// With cross_cell, the old master is in the same cell as the
+	// replica, and the rdonly doesn't ack.
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", master.Tablet.Keyspace, "cross_cell"}); err != nil {
+		t.Fatalf("SetKeyspaceDurabilityPolicy failed: %v", err)
+	}
+	err = vp.Run([]string{"PlannedReparentShard", "-wait_slave_timeout", "1s", "-keyspace_shard", master.Tablet.Keyspace + "/" + master.Tablet.Shard, "-new_master", topoproto.TabletAliasString(replica.Tablet.Alias)})
+	if err == nil || !strings.Contains(err.Error(), "cannot be promoted with the cross_cell durability policy") {
+		t.Errorf("PlannedReparentShard returned %v, want a durability policy error", err)
+	}
+
+	// The master has no REPLICA tablet in another cell to ack its
+	// writes either, which ValidateShard reports.
+	err = vp.Run([]string{"ValidateShard", "-ping-tablets=false", master.Tablet.Keyspace + "/" + master.Tablet.Shard})
+	if err == nil {
+		t.Errorf("ValidateShard succeeded, want a durability policy error")
+	}
+
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", master.Tablet.Keyspace, "unknown"}); err == nil {
+		t.Errorf("SetKeyspaceDurabilityPolicy(unknown) succeeded")
+	}
+}
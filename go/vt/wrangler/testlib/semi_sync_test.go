@@ -20,7 +20,16 @@ import (
 	"flag"
 	"testing"
 
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func init() {
@@ -38,3 +47,48 @@ func checkSemiSyncEnabled(t *testing.T, master, slave bool, tablets ...*FakeTabl
 		}
 	}
 }
+
+func TestSemiSyncCrossCell(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	master := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_MASTER, nil)
+	replica1 := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, nil)
+	replica2 := NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_REPLICA, nil)
+	if _, err := ts.UpdateShardFields(ctx, master.Tablet.Keyspace, master.Tablet.Shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Tablet.Alias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	if err := wr.SetKeyspaceDurabilityPolicy(ctx, master.Tablet.Keyspace, "cross_cell"); err != nil {
+		t.Fatalf("SetKeyspaceDurabilityPolicy failed: %v", err)
+	}
+
+	master.StartActionLoop(t, wr)
+	defer master.StopActionLoop(t)
+	for _, replica := range []*FakeTablet{replica1, replica2} {
+		replica.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(master.Tablet)
+		replica.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+			"FAKE SET MASTER",
+			"FAKE SET MASTER",
+		}
+		replica.StartActionLoop(t, wr)
+		defer replica.StopActionLoop(t)
+		if err := wr.TabletManagerClient().SetMaster(ctx, replica.Tablet, master.Tablet.Alias, 0, "", false); err != nil {
+			t.Fatalf("SetMaster failed: %v", err)
+		}
+	}
+
+	// Only the replica in another cell than the master's acks.
+	checkSemiSyncEnabled(t, false, false, replica1)
+	checkSemiSyncEnabled(t, false, true, replica2)
+
+	// Once replica2 is the master, replica1 is in another cell.
+	replica1.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(replica2.Tablet)
+	if err := wr.TabletManagerClient().SetMaster(ctx, replica1.Tablet, replica2.Tablet.Alias, 0, "", false); err != nil {
+		t.Fatalf("SetMaster failed: %v", err)
+	}
+	checkSemiSyncEnabled(t, false, true, replica1)
+}
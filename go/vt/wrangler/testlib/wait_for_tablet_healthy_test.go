@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/grpcqueryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestWaitForTabletHealthy(t *testing.T) {
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	replica := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_REPLICA, nil)
+	delayed := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	if _, err := ts.UpdateTabletFields(context.Background(), delayed.Tablet.Alias, func(tablet *topodatapb.Tablet) error {
+		tablet.MasterDelay = 3600
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+
+	// Both tablets are healthy, but don't serve queries.
+	for _, ft := range []*FakeTablet{replica, delayed} {
+		fqs := fakes.NewStreamHealthQueryService(querypb.Target{
+			Keyspace:   ft.Tablet.Keyspace,
+			Shard:      ft.Tablet.Shard,
+			TabletType: topodatapb.TabletType_REPLICA,
+		})
+		fqs.AddHealthResponseWithNotServing()
+		grpcqueryservice.Register(ft.RPCServer, fqs)
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+
+	// A delayed replica never serves queries.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := wr.WaitForTabletHealthy(ctx, delayed.Tablet.Alias, 10*time.Second); err != nil {
+		t.Errorf("WaitForTabletHealthy(delayed replica) failed: %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := wr.WaitForTabletHealthy(ctx, replica.Tablet.Alias, 10*time.Second); err == nil || !strings.Contains(err.Error(), "tablet is not serving") {
+		t.Errorf("WaitForTabletHealthy(replica) returned %v, want a not serving error", err)
+	}
+}
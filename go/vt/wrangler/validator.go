@@ -25,6 +25,7 @@ import (
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -155,6 +156,8 @@ func (wr *Wrangler) validateShard(ctx context.Context, keyspace, shard string, p
 		results <- fmt.Errorf("no master for shard %v/%v", keyspace, shard)
 	} else if !topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterAlias) {
 		results <- fmt.Errorf("master mismatch for shard %v/%v: found %v, expected %v", keyspace, shard, topoproto.TabletAliasString(masterAlias), topoproto.TabletAliasString(shardInfo.MasterAlias))
+	} else {
+		wr.validateDurability(ctx, keyspace, shard, tabletMap[topoproto.TabletAliasString(masterAlias)], tabletMap, results)
 	}
 
 	for _, alias := range aliases {
@@ -175,6 +178,19 @@ func (wr *Wrangler) validateShard(ctx context.Context, keyspace, shard string, p
 	}
 }
 
+// validateDurability checks that the master of a shard satisfies the
+// durability policy of its keyspace.
+func (wr *Wrangler) validateDurability(ctx context.Context, keyspace, shard string, master *topo.TabletInfo, tabletMap map[string]*topo.TabletInfo, results chan<- error) {
+	policy, err := wr.durabilityPolicy(ctx, keyspace)
+	if err != nil {
+		results <- err
+		return
+	}
+	if err := topotools.CheckPromotable(policy, master.Tablet, tabletMap); err != nil {
+		results <- fmt.Errorf("shard %v/%v violates its durability policy: %v", keyspace, shard, err)
+	}
+}
+
 func normalizeIP(ip string) string {
 	// Normalize loopback to avoid spurious validation errors.
 	if parsedIP := net.ParseIP(ip); parsedIP != nil && parsedIP.IsLoopback() {
@@ -228,8 +244,9 @@ func (wr *Wrangler) validateReplication(ctx context.Context, shardInfo *topo.Sha
 	}
 
 	// See if every entry in the replication graph is connected to the master.
+	// The delayed replicas can be stopped on purpose to recover data.
 	for _, tablet := range tabletMap {
-		if !tablet.IsSlaveType() {
+		if !tablet.IsSlaveType() || tablet.MasterDelay > 0 {
 			continue
 		}
 